@@ -148,6 +148,7 @@ var Pi_network_name string
 var Pi_cloud_instance_id string
 var Pi_instance_name string
 var Pi_dhcp_id string
+var Pi_workspace_datacenter string
 var PiCloudConnectionName string
 var PiSAPProfileID string
 var Pi_placement_group_name string
@@ -242,6 +243,13 @@ var IesApiKey string
 var IngestionKey string
 var COSApiKey string
 
+// Schematics agent
+var SchematicsAgentProfileID string
+
+// App Configuration git config
+var AppConfigGitURL string
+var AppConfigGitToken string
+
 func init() {
 	testlogger := os.Getenv("TF_LOG")
 	if testlogger != "" {
@@ -784,6 +792,12 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable PI_DHCP_ID for testing ibm_pi_dhcp resource else it is set to default value 'terraform-test-power'")
 	}
 
+	Pi_workspace_datacenter = os.Getenv("PI_WORKSPACE_DATACENTER")
+	if Pi_workspace_datacenter == "" {
+		Pi_workspace_datacenter = "dal12"
+		fmt.Println("[INFO] Set the environment variable PI_WORKSPACE_DATACENTER for testing ibm_pi_workspace resource else it is set to default value 'dal12'")
+	}
+
 	PiCloudConnectionName = os.Getenv("PI_CLOUD_CONNECTION_NAME")
 	if PiCloudConnectionName == "" {
 		PiCloudConnectionName = "terraform-test-power"
@@ -1289,6 +1303,21 @@ func init() {
 		IesApiKey = "xxxxxxxxxxxx" // pragma: allowlist secret
 		fmt.Println("[WARN] Set the environment variable IES_API_KEY for testing Event streams targets, the tests will fail if this is not set")
 	}
+
+	SchematicsAgentProfileID = os.Getenv("SCHEMATICS_AGENT_PROFILE_ID")
+	if SchematicsAgentProfileID == "" {
+		fmt.Println("[WARN] Set the environment variable SCHEMATICS_AGENT_PROFILE_ID for testing ibm_schematics_agent resource, the tests will fail if this is not set")
+	}
+
+	AppConfigGitURL = os.Getenv("IBM_APPCONFIG_GIT_URL")
+	if AppConfigGitURL == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_APPCONFIG_GIT_URL for testing ibm_app_config_snapshot_promote resource, the tests will fail if this is not set")
+	}
+
+	AppConfigGitToken = os.Getenv("IBM_APPCONFIG_GIT_TOKEN")
+	if AppConfigGitToken == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_APPCONFIG_GIT_TOKEN for testing ibm_app_config_snapshot_promote resource, the tests will fail if this is not set")
+	}
 }
 
 var TestAccProviders map[string]*schema.Provider
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// apiCallStats are the statistics of the API calls to a service endpoint
+type apiCallStats struct {
+	calls    int
+	retries  int
+	failures int
+	latency  time.Duration
+}
+
+// apiCalls records the API calls of the service clients by service endpoint, once the API call
+// summary of a provider configuration is enabled. It is shared by the provider configurations,
+// so that the summary covers the whole run of Terraform.
+var apiCalls = struct {
+	sync.Mutex
+	enabled bool
+	hosts   map[string]*apiCallStats
+}{hosts: make(map[string]*apiCallStats)}
+
+func enableAPICallSummary() {
+	apiCalls.Lock()
+	defer apiCalls.Unlock()
+	apiCalls.enabled = true
+}
+
+func recordAPICall(host string, record func(stats *apiCallStats)) {
+	apiCalls.Lock()
+	defer apiCalls.Unlock()
+	stats, ok := apiCalls.hosts[host]
+	if !ok {
+		stats = &apiCallStats{}
+		apiCalls.hosts[host] = stats
+	}
+	record(stats)
+}
+
+// apiCallLogHook counts the API calls and their retries, the retryable client calls it before
+// each attempt of a request.
+func apiCallLogHook(_ retryablehttp.Logger, request *http.Request, attempt int) {
+	recordAPICall(request.URL.Host, func(stats *apiCallStats) {
+		if attempt == 0 {
+			stats.calls++
+		} else {
+			stats.retries++
+		}
+	})
+}
+
+// apiCallTransport adds up the latency of the attempts of the requests, and counts the attempts
+// that fail
+type apiCallTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *apiCallTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := t.transport.RoundTrip(request)
+	latency := time.Since(start)
+	recordAPICall(request.URL.Host, func(stats *apiCallStats) {
+		stats.latency += latency
+		if err != nil || response.StatusCode >= 400 {
+			stats.failures++
+		}
+	})
+	return response, err
+}
+
+// LogAPICallSummary logs the number of API calls, of retries and of failed attempts, and the
+// cumulative latency of the API calls of each service endpoint, the slowest endpoints first. It
+// logs nothing unless the API call summary is enabled.
+func LogAPICallSummary() {
+	apiCalls.Lock()
+	defer apiCalls.Unlock()
+	if !apiCalls.enabled {
+		return
+	}
+
+	hosts := make([]string, 0, len(apiCalls.hosts))
+	for host := range apiCalls.hosts {
+		hosts = append(hosts, host)
+	}
+	sort.Slice(hosts, func(i, j int) bool {
+		return apiCalls.hosts[hosts[i]].latency > apiCalls.hosts[hosts[j]].latency
+	})
+
+	var summary strings.Builder
+	summary.WriteString("[INFO] Summary of the API calls by service endpoint:")
+	for _, host := range hosts {
+		stats := apiCalls.hosts[host]
+		fmt.Fprintf(&summary, "\n  %s: %d calls, %d retries, %d failed attempts, %s",
+			host, stats.calls, stats.retries, stats.failures, stats.latency.Round(time.Millisecond))
+	}
+	log.Print(summary.String())
+}
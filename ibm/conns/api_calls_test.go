@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAPICallSummary(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first request is throttled, then retried
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := &Config{RetryCount: 2, RetryDelay: time.Second, RetryMinDelay: time.Millisecond, APICallSummary: true}
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	c.enableRetries(service)
+	c.enableRetries(service)
+
+	for i := 0; i < 2; i++ {
+		response, err := service.Client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		response.Body.Close()
+	}
+
+	serverURL, _ := url.Parse(server.URL)
+	apiCalls.Lock()
+	stats := *apiCalls.hosts[serverURL.Host]
+	apiCalls.Unlock()
+	if stats.calls != 2 || stats.retries != 1 || stats.failures != 1 {
+		t.Fatalf("Expected 2 calls, 1 retry and 1 failed attempt, got %+v", stats)
+	}
+	if stats.latency <= 0 {
+		t.Fatalf("Expected the latency of the calls to be recorded")
+	}
+}
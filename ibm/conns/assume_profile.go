@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// assumeProfileGrantType is the IAM grant type that exchanges an access token for an access token
+// of a trusted profile
+const assumeProfileGrantType = "urn:ibm:params:oauth:grant-type:assume"
+
+// assumeProfileAuthenticator authenticates requests with an access token of a trusted profile,
+// which it gets by assuming the profile with the access token of an API key. The profile is
+// assumed again when its access token is about to expire.
+type assumeProfileAuthenticator struct {
+	apiKeyAuthenticator *core.IamAuthenticator
+	profileID           string
+	url                 string
+	client              *http.Client
+
+	mutex       sync.Mutex
+	accessToken string
+	expiration  int64
+}
+
+func newAssumeProfileAuthenticator(apiKeyAuthenticator *core.IamAuthenticator, profileID, url string) *assumeProfileAuthenticator {
+	return &assumeProfileAuthenticator{
+		apiKeyAuthenticator: apiKeyAuthenticator,
+		profileID:           profileID,
+		url:                 url,
+		client:              &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (authenticator *assumeProfileAuthenticator) AuthenticationType() string {
+	return "iamAssume"
+}
+
+func (authenticator *assumeProfileAuthenticator) Validate() error {
+	if authenticator.profileID == "" {
+		return fmt.Errorf("[ERROR] The ID of the trusted profile to assume is empty")
+	}
+	return authenticator.apiKeyAuthenticator.Validate()
+}
+
+func (authenticator *assumeProfileAuthenticator) Authenticate(request *http.Request) error {
+	token, err := authenticator.GetToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// GetToken returns the current access token of the trusted profile, after the profile is assumed
+// again when the token expires within a minute.
+func (authenticator *assumeProfileAuthenticator) GetToken() (string, error) {
+	authenticator.mutex.Lock()
+	defer authenticator.mutex.Unlock()
+
+	if authenticator.accessToken != "" && time.Now().Add(time.Minute).Unix() < authenticator.expiration {
+		return authenticator.accessToken, nil
+	}
+
+	apiKeyToken, err := authenticator.apiKeyAuthenticator.GetToken()
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type":   {assumeProfileGrantType},
+		"access_token": {apiKeyToken},
+	}
+	// The profile can be set by its ID or by its CRN
+	if strings.HasPrefix(authenticator.profileID, "crn:") {
+		form.Set("profile_crn", authenticator.profileID)
+	} else {
+		form.Set("profile_id", authenticator.profileID)
+	}
+	request, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(authenticator.url, "/")+"/identity/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	response, err := authenticator.client.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error occured while assuming the trusted profile %s: %q", authenticator.profileID, err)
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("[ERROR] Error occured while assuming the trusted profile %s: %s %s", authenticator.profileID, response.Status, body)
+	}
+
+	tokenResponse := &core.IamTokenServerResponse{}
+	if err := json.Unmarshal(body, tokenResponse); err != nil {
+		return "", fmt.Errorf("[ERROR] Error occured while reading the access token of the trusted profile %s: %q", authenticator.profileID, err)
+	}
+	authenticator.accessToken = tokenResponse.AccessToken
+	authenticator.expiration = tokenResponse.Expiration
+	return authenticator.accessToken, nil
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAssumeProfileAuthenticator(t *testing.T) {
+	var assumed int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		response := core.IamTokenServerResponse{
+			ExpiresIn:  3600,
+			Expiration: time.Now().Add(time.Hour).Unix(),
+		}
+		switch r.Form.Get("grant_type") {
+		case "urn:ibm:params:oauth:grant-type:apikey":
+			response.AccessToken = "api-key-token"
+		case assumeProfileGrantType:
+			if r.Form.Get("access_token") != "api-key-token" || r.Form.Get("profile_id") != "Profile-1234" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			atomic.AddInt32(&assumed, 1)
+			response.AccessToken = "profile-token"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	apiKeyAuthenticator := &core.IamAuthenticator{ApiKey: "my-api-key", URL: server.URL}
+	authenticator := newAssumeProfileAuthenticator(apiKeyAuthenticator, "Profile-1234", server.URL)
+
+	request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	if err := authenticator.Authenticate(request); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if header := request.Header.Get("Authorization"); header != "Bearer profile-token" {
+		t.Fatalf("Expected the access token of the trusted profile, got %s", header)
+	}
+
+	// The access token is reused until it expires
+	if _, err := authenticator.GetToken(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if assumed != 1 {
+		t.Fatalf("Expected the profile to be assumed once, got %d", assumed)
+	}
+	authenticator.expiration = time.Now().Unix()
+	if _, err := authenticator.GetToken(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if assumed != 2 {
+		t.Fatalf("Expected the profile to be assumed again, got %d", assumed)
+	}
+
+	other := newAssumeProfileAuthenticator(apiKeyAuthenticator, "Profile-5678", server.URL)
+	if _, err := other.GetToken(); err == nil {
+		t.Fatalf("Expected an error for a profile that can't be assumed")
+	}
+}
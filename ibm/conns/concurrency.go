@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// ConcurrencyLimitedServices lists the services of the service_concurrency block of the provider.
+// All the service clients of a service, such as the IAM Identity, IAM Policy Management and IAM
+// Access Groups clients of IAM, share its limit.
+var ConcurrencyLimitedServices = []string{
+	"cis",
+	"cloud_databases",
+	"container_registry",
+	"direct_link",
+	"event_notifications",
+	"global_search",
+	"global_tagging",
+	"iam",
+	"private_dns",
+	"resource_controller",
+	"resource_manager",
+	"satellite",
+	"schematics",
+	"secrets_manager",
+	"transit_gateway",
+	"vpc",
+}
+
+// newServiceSemaphores returns the semaphores that limit the number of concurrent requests of the
+// services, a service without a limit has no semaphore.
+func newServiceSemaphores(serviceConcurrency map[string]int) map[string]chan struct{} {
+	semaphores := make(map[string]chan struct{})
+	for service, concurrency := range serviceConcurrency {
+		if concurrency > 0 {
+			semaphores[service] = make(chan struct{}, concurrency)
+		}
+	}
+	return semaphores
+}
+
+// limitConcurrency limits the number of concurrent requests of a service client to the
+// concurrency of its service. The retries of the API calls must be enabled first, so that a
+// request only holds its slot while it is sent and not while it waits for its next retry.
+func (c *Config) limitConcurrency(name string, service *core.BaseService) {
+	semaphore := c.serviceSemaphores[name]
+	if semaphore == nil {
+		return
+	}
+	httpClient := service.Client
+	if tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		httpClient = tr.Client.HTTPClient
+	}
+	if _, limited := httpClient.Transport.(*concurrencyLimitedTransport); limited {
+		return
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpClient.Transport = &concurrencyLimitedTransport{
+		semaphore: semaphore,
+		transport: transport,
+	}
+}
+
+// concurrencyLimitedTransport acquires a slot of the semaphore of its service for each request,
+// and releases it once the response is received.
+type concurrencyLimitedTransport struct {
+	semaphore chan struct{}
+	transport http.RoundTripper
+}
+
+func (t *concurrencyLimitedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	select {
+	case t.semaphore <- struct{}{}:
+	case <-request.Context().Done():
+		return nil, request.Context().Err()
+	}
+	defer func() { <-t.semaphore }()
+	return t.transport.RoundTrip(request)
+}
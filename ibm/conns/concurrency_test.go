@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestLimitConcurrency(t *testing.T) {
+	var concurrent, maxConcurrent int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&concurrent, 1)
+		defer atomic.AddInt32(&concurrent, -1)
+		for {
+			max := atomic.LoadInt32(&maxConcurrent)
+			if current <= max || atomic.CompareAndSwapInt32(&maxConcurrent, max, current) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		RetryCount:        1,
+		RetryDelay:        time.Second,
+		serviceSemaphores: newServiceSemaphores(map[string]int{"iam": 2}),
+	}
+
+	// The requests of two service clients of IAM share the limit of 2 concurrent requests
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		service, err := core.NewBaseService(&core.ServiceOptions{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		config.enableRetries(service)
+		config.limitConcurrency("iam", service)
+		config.limitConcurrency("iam", service)
+
+		for j := 0; j < 4; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				response, err := service.Client.Get(server.URL)
+				if err != nil {
+					t.Errorf("Unexpected error: %s", err)
+					return
+				}
+				response.Body.Close()
+			}()
+		}
+	}
+	wg.Wait()
+
+	if maxConcurrent != 2 {
+		t.Fatalf("Expected at most 2 concurrent requests, got %d", maxConcurrent)
+	}
+}
@@ -74,6 +74,7 @@ import (
 	ibmcloudshellv1 "github.com/IBM/platform-services-go-sdk/ibmcloudshellv1"
 	resourcecontroller "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	resourcemanager "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
 	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
 	"github.com/IBM/scc-go-sdk/v3/adminserviceapiv1"
 	"github.com/IBM/scc-go-sdk/v3/configurationgovernancev1"
@@ -84,6 +85,7 @@ import (
 	"github.com/apache/openwhisk-client-go/whisk"
 	jwt "github.com/golang-jwt/jwt"
 	slsession "github.com/softlayer/softlayer-go/session"
+	"golang.org/x/time/rate"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	"github.com/IBM-Cloud/bluemix-go/api/account/accountv1"
@@ -170,6 +172,11 @@ type Config struct {
 	RetryCount int
 	//Constant Retry Delay for API calls
 	RetryDelay time.Duration
+	//Minimum wait time between the retries of API calls
+	RetryMinDelay time.Duration
+	//Maximum rate of the requests of all the service clients, unlimited when 0
+	RequestsPerSecond int
+	requestLimiter    *rate.Limiter
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -186,13 +193,44 @@ type Config struct {
 	//TrustedProfileToken Token
 	IAMTrustedProfileID string
 
+	//Compute resource token file of the trusted profile
+	IAMCRTokenFile string
+
+	//Command that prints the IAM API key or access token
+	CredentialProcess string
+
+	//Access token that the credential process printed
+	credentialProcessOutput *credentialProcessOutput
+
+	//Trusted profile that is assumed with the API key for all the API calls
+	AssumeProfileID string
+	assumeProfile   *assumeProfileAuthenticator
+
 	//IAM Refresh Token
 	IAMRefreshToken string
 
+	//Endpoints of the services by the key of the endpoint, they override the endpoints file
+	Endpoints map[string]string
+
+	//Tags that are attached to every resource that supports Global Tagging
+	DefaultTags       []string
+	DefaultAccessTags []string
+
 	// Zone
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	//Refuse the requests to the endpoints that are not private
+	PrivateEndpointsOnly bool
+	privateEndpointHosts []string
+
+	//Maximum number of concurrent requests by service
+	ServiceConcurrency map[string]int
+	serviceSemaphores  map[string]chan struct{}
+
+	//Record the API calls of the service clients for the API call summary
+	APICallSummary bool
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -279,6 +317,7 @@ type ClientSession interface {
 	ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error)
 	CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error)
 	EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error)
+	UsageReportsV4() (*usagereportsv4.UsageReportsV4, error)
 	ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error)
 	SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error)
 	SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error)
@@ -297,6 +336,9 @@ type ClientSession interface {
 	PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error)
 	CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error)
 	CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error)
+	DefaultTags() []string
+	DefaultAccessTags() []string
+	IAMAuthenticator() (core.Authenticator, error)
 }
 
 type clientSession struct {
@@ -534,6 +576,9 @@ type clientSession struct {
 	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
 	enterpriseManagementClientErr error
 
+	usageReportsClient    *usagereportsv4.UsageReportsV4
+	usageReportsClientErr error
+
 	//Resource Controller Option
 	resourceControllerErr   error
 	resourceControllerAPI   *resourcecontroller.ResourceControllerV2
@@ -614,6 +659,13 @@ type clientSession struct {
 	// CD Tekton Pipeline
 	cdTektonPipelineClient    *cdtektonpipelinev2.CdTektonPipelineV2
 	cdTektonPipelineClientErr error
+
+	// Default tags of the provider configuration
+	defaultTags       []string
+	defaultAccessTags []string
+
+	// Authenticator that is shared by the service clients
+	iamAuthenticator core.Authenticator
 }
 
 // AppIDAPI provides AppID Service APIs ...
@@ -1060,6 +1112,11 @@ func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.E
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
+// Usage Reports
+func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	return session.usageReportsClient, session.usageReportsClientErr
+}
+
 // ResourceController Session
 func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
 	return sess.resourceControllerAPI, sess.resourceControllerErr
@@ -1180,15 +1237,44 @@ func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonP
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
+// DefaultTags returns the user tags that are attached to every resource that supports Global Tagging
+func (session clientSession) DefaultTags() []string {
+	return session.defaultTags
+}
+
+// DefaultAccessTags returns the access tags that are attached to every resource that supports Global Tagging
+func (session clientSession) DefaultAccessTags() []string {
+	return session.defaultAccessTags
+}
+
+// IAMAuthenticator returns the authenticator that is shared by the service clients, so that
+// clients that are created for a resource reuse its access token
+func (session clientSession) IAMAuthenticator() (core.Authenticator, error) {
+	if session.iamAuthenticator == nil {
+		return nil, errEmptyBluemixCredentials
+	}
+	return session.iamAuthenticator, nil
+}
+
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
+	if c.PrivateEndpointsOnly && c.Visibility != "private" {
+		return nil, fmt.Errorf("[ERROR] private_endpoints_only requires the private visibility, the visibility is %s", c.Visibility)
+	}
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
+	c.requestLimiter = newRequestLimiter(c.RequestsPerSecond)
+	c.serviceSemaphores = newServiceSemaphores(c.ServiceConcurrency)
+	if c.APICallSummary {
+		enableAPICallSummary()
+	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:           sess,
+		defaultTags:       c.DefaultTags,
+		defaultAccessTags: c.DefaultAccessTags,
 	}
 
 	if sess.BluemixSession == nil {
@@ -1219,6 +1305,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerConfigErr = errEmptyBluemixCredentials
 		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
 		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
+		session.usageReportsClientErr = errEmptyBluemixCredentials
 		session.resourceControllerErr = errEmptyBluemixCredentials
 		session.catalogManagementClientErr = errEmptyBluemixCredentials
 		session.ibmpiConfigErr = errEmptyBluemixCredentials
@@ -1309,7 +1396,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 	}
 
-	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
+	if c.IAMTrustedProfileID == "" && c.credentialProcessOutput == nil && c.assumeProfile == nil && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
 		err := RefreshToken(sess.BluemixSession)
 		if err != nil {
 			for count := c.RetryCount; count >= 0; count-- {
@@ -1357,6 +1444,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
 		}
 	}
+	c.setPrivateEndpointHosts(fileMap)
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
@@ -1397,9 +1485,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
-	}
+	kpurl = c.endpointFallBack(fileMap, "IBMCLOUD_KP_API_ENDPOINT", kpurl)
 	var options kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		options = kp.ClientConfig{
@@ -1431,18 +1517,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
+	iamURL = c.endpointFallBack(fileMap, "IBMCLOUD_IAM_API_ENDPOINT", iamURL)
 
 	// KEY MANAGEMENT Service
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
+	kmsurl = c.endpointFallBack(fileMap, "IBMCLOUD_KP_API_ENDPOINT", kmsurl)
 	var kmsOptions kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		kmsOptions = kp.ClientConfig{
@@ -1470,21 +1552,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	var authenticator core.Authenticator
 
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
+	if c.IAMTrustedProfileID != "" && c.IAMCRTokenFile != "" {
+		// The compute resource token is exchanged again when the access token expires
+		authenticator = containerAuthenticator(c, iamURL)
+	} else if c.assumeProfile != nil {
+		// The trusted profile is assumed again when its access token expires
+		authenticator = c.assumeProfile
+	} else if c.credentialProcessOutput != nil {
+		// The credential process is run again when the access token expires
+		authenticator = newCredentialProcessAuthenticator(c.CredentialProcess, c.credentialProcessOutput)
+	} else if c.BluemixAPIKey != "" {
+		authenticator = sharedIamAuthenticator(c.BluemixAPIKey, "", EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL))
+	} else if sess.BluemixSession.Config.IAMRefreshToken != "" {
+		authenticator = sharedIamAuthenticator("", sess.BluemixSession.Config.IAMRefreshToken, EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL))
 	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
 		authenticator = &core.BearerTokenAuthenticator{
 			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
@@ -1494,6 +1574,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
 		}
 	}
+	session.iamAuthenticator = authenticator
 
 	// Construct an "options" struct for creating the service client.
 	ukoClientOptions := &ukov4.UkoV4Options{
@@ -1504,7 +1585,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.ukoClient.Service)
 		// Add custom header for analytics
 		session.ukoClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1518,9 +1599,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
+	appIDEndpoint = c.endpointFallBack(fileMap, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", appIDEndpoint)
 	appIDClientOptions := &appid.AppIDManagementV4Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
@@ -1530,7 +1609,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(appIDClient.Service)
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1546,9 +1625,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			cbrURL = ContructEndpoint("private.cbr", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
+	cbrURL = c.endpointFallBack(fileMap, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", cbrURL)
 	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
@@ -1558,7 +1635,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.contextBasedRestrictionsClient.Service)
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1572,9 +1649,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
+	catalogManagementURL = c.endpointFallBack(fileMap, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", catalogManagementURL)
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
 		Authenticator: authenticator,
@@ -1586,7 +1661,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.catalogManagementClient.Service)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1605,9 +1680,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 	}
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientURL)
-	}
+	atrackerClientURL = c.endpointFallBack(fileMap, "IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientURL)
 	atrackerClientOptions := &atrackerv1.AtrackerV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientURL),
@@ -1623,7 +1696,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.atrackerClient != nil && session.atrackerClient.Service != nil {
 		// Enable retries for API calls
-		session.atrackerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.atrackerClient.Service)
 		// Add custom header for analytics
 		session.atrackerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1644,9 +1717,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if atrackerURLV2Err != nil {
 		atrackerClientV2URL = atrackerv2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
-	}
+	atrackerClientV2URL = c.endpointFallBack(fileMap, "IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientV2URL)
 	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
@@ -1659,7 +1730,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
 	if err == nil {
 		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.atrackerClientV2.Service)
 		// Add custom header for analytics
 		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1690,7 +1761,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.adminServiceApiClient, err = adminserviceapiv1.NewAdminServiceApiV1(adminServiceApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.adminServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.adminServiceApiClient.Service)
 		// Add custom header for analytics
 		session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1705,9 +1776,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
-	}
+	schematicsEndpoint = c.endpointFallBack(fileMap, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", schematicsEndpoint)
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
@@ -1719,7 +1788,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(schematicsClient.Service)
+		c.limitConcurrency("schematics", schematicsClient.Service)
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1731,9 +1801,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
+	vpcurl = c.endpointFallBack(fileMap, "IBMCLOUD_IS_NG_API_ENDPOINT", vpcurl)
 	vpcoptions := &vpc.VpcV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
 		Authenticator: authenticator,
@@ -1743,7 +1811,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(vpcclient.Service)
+		c.limitConcurrency("vpc", vpcclient.Service)
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1755,9 +1824,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
+	pnurl = c.endpointFallBack(fileMap, "IBMCLOUD_PUSH_API_ENDPOINT", pnurl)
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
 		Authenticator: authenticator,
@@ -1768,7 +1835,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(pnclient.Service)
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1779,9 +1846,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" {
 		session.eventNotificationsApiClientErr = fmt.Errorf("Event Notifications Service does not support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
+	enurl = c.endpointFallBack(fileMap, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", enurl)
 	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
@@ -1794,7 +1859,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.eventNotificationsApiClient.Service)
+		c.limitConcurrency("event_notifications", session.eventNotificationsApiClient.Service)
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1805,9 +1871,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
-	}
+	appconfigurl = c.endpointFallBack(fileMap, "IBMCLOUD_APP_CONFIG_ENDPOINT", appconfigurl)
 	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
 		Authenticator: authenticator,
@@ -1816,7 +1880,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(appConfigClient.Service)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
@@ -1834,9 +1898,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
+	containerRegistryClientURL = c.endpointFallBack(fileMap, "IBMCLOUD_CR_API_ENDPOINT", containerRegistryClientURL)
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
@@ -1849,7 +1911,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.containerRegistryClient.Service)
+		c.limitConcurrency("container_registry", session.containerRegistryClient.Service)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1858,9 +1921,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// OBJECT STORAGE Service
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
-	}
+	cosconfigurl = c.endpointFallBack(fileMap, "IBMCLOUD_COS_CONFIG_ENDPOINT", cosconfigurl)
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
@@ -1894,9 +1955,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
-	}
+	globalTaggingEndpoint = c.endpointFallBack(fileMap, "IBMCLOUD_GT_API_ENDPOINT", globalTaggingEndpoint)
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
 		Authenticator: authenticator,
@@ -1907,7 +1966,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.globalTaggingServiceAPIV1.Service)
+		c.limitConcurrency("global_tagging", session.globalTaggingServiceAPIV1.Service)
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1920,6 +1980,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
 	}
+	if endpoint := c.Endpoints["IBMCLOUD_GS_API_ENDPOINT"]; endpoint != "" {
+		globalSearchEndpoint = endpoint
+	}
 	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
 		Authenticator: authenticator,
@@ -1930,7 +1993,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
 		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.globalSearchServiceAPIV2.Service)
+		c.limitConcurrency("global_search", session.globalSearchServiceAPIV2.Service)
 		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1960,7 +2024,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cloudDatabasesClient.Service)
+		c.limitConcurrency("cloud_databases", session.cloudDatabasesClient.Service)
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2016,9 +2081,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
-	}
+	apicurl = c.endpointFallBack(fileMap, "IBMCLOUD_API_GATEWAY_ENDPOINT", apicurl)
 	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
 		Authenticator: &core.NoAuthAuthenticator{},
@@ -2050,9 +2113,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
-	}
+	pdnsURL = c.endpointFallBack(fileMap, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", pdnsURL)
 	dnsOptions := &dns.DnsSvcsV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
 		Authenticator: authenticator,
@@ -2062,7 +2123,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.pDNSClient.Service)
+		c.limitConcurrency("private_dns", session.pDNSClient.Service)
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2074,9 +2136,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
+	dlURL = c.endpointFallBack(fileMap, "IBMCLOUD_DL_API_ENDPOINT", dlURL)
 	directlinkOptions := &dl.DirectLinkV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
 		Authenticator: authenticator,
@@ -2087,7 +2147,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.directlinkAPI.Service)
+		c.limitConcurrency("direct_link", session.directlinkAPI.Service)
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2098,9 +2159,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
-	}
+	dlproviderURL = c.endpointFallBack(fileMap, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", dlproviderURL)
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
 		Authenticator: authenticator,
@@ -2111,7 +2170,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.dlProviderAPI.Service)
+		c.limitConcurrency("direct_link", session.dlProviderAPI.Service)
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2122,9 +2182,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
-	}
+	tgURL = c.endpointFallBack(fileMap, "IBMCLOUD_TG_API_ENDPOINT", tgURL)
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
 		Authenticator: authenticator,
@@ -2135,7 +2193,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.transitgatewayAPI.Service)
+		c.limitConcurrency("transit_gateway", session.transitgatewayAPI.Service)
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
@@ -2171,9 +2230,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
+	cisURL = c.endpointFallBack(fileMap, "IBMCLOUD_CIS_API_ENDPOINT", cisURL)
 	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
 
 	// IBM Network CIS Zones service
@@ -2189,7 +2246,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisZonesV1Client.Service)
+		c.limitConcurrency("cis", session.cisZonesV1Client.Service)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2207,7 +2265,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisDNSRecordsClient.Service)
+		c.limitConcurrency("cis", session.cisDNSRecordsClient.Service)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2227,7 +2286,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisDNSRecordBulkClient.Service)
+		c.limitConcurrency("cis", session.cisDNSRecordBulkClient.Service)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2247,7 +2307,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisGLBPoolClient.Service)
+		c.limitConcurrency("cis", session.cisGLBPoolClient.Service)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2267,7 +2328,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisGLBClient.Service)
+		c.limitConcurrency("cis", session.cisGLBClient.Service)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2287,7 +2349,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisGLBHealthCheckClient.Service)
+		c.limitConcurrency("cis", session.cisGLBHealthCheckClient.Service)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2304,7 +2367,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisIPClient.Service)
+		c.limitConcurrency("cis", session.cisIPClient.Service)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2324,7 +2388,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisRLClient.Service)
+		c.limitConcurrency("cis", session.cisRLClient.Service)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2342,7 +2407,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisAlertsClient.Service)
+		c.limitConcurrency("cis", session.cisAlertsClient.Service)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2362,7 +2428,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisPageRuleClient.Service)
+		c.limitConcurrency("cis", session.cisPageRuleClient.Service)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2383,7 +2450,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisEdgeFunctionClient.Service)
+		c.limitConcurrency("cis", session.cisEdgeFunctionClient.Service)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2404,7 +2472,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisSSLClient.Service)
+		c.limitConcurrency("cis", session.cisSSLClient.Service)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2425,7 +2494,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisWAFPackageClient.Service)
+		c.limitConcurrency("cis", session.cisWAFPackageClient.Service)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2446,7 +2516,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisDomainSettingsClient.Service)
+		c.limitConcurrency("cis", session.cisDomainSettingsClient.Service)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2467,7 +2538,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisRoutingClient.Service)
+		c.limitConcurrency("cis", session.cisRoutingClient.Service)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2488,7 +2560,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisWAFGroupClient.Service)
+		c.limitConcurrency("cis", session.cisWAFGroupClient.Service)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2509,7 +2582,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisCacheClient.Service)
+		c.limitConcurrency("cis", session.cisCacheClient.Service)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2531,7 +2605,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisCustomPageClient.Service)
+		c.limitConcurrency("cis", session.cisCustomPageClient.Service)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2552,7 +2627,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisAccessRuleClient.Service)
+		c.limitConcurrency("cis", session.cisAccessRuleClient.Service)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2573,7 +2649,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisUARuleClient.Service)
+		c.limitConcurrency("cis", session.cisUARuleClient.Service)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2594,7 +2671,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisLockdownClient.Service)
+		c.limitConcurrency("cis", session.cisLockdownClient.Service)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2615,7 +2693,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisRangeAppClient.Service)
+		c.limitConcurrency("cis", session.cisRangeAppClient.Service)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2636,7 +2715,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisWAFRuleClient.Service)
+		c.limitConcurrency("cis", session.cisWAFRuleClient.Service)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2657,7 +2737,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisLogpushJobsClient.Service)
+		c.limitConcurrency("cis", session.cisLogpushJobsClient.Service)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2676,7 +2757,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisMtlsErr)
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisMtlsClient.Service)
+		c.limitConcurrency("cis", session.cisMtlsClient.Service)
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2695,7 +2777,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisWebhooksClient.Service)
+		c.limitConcurrency("cis", session.cisWebhooksClient.Service)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2712,7 +2795,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisFiltersClient.Service)
+		c.limitConcurrency("cis", session.cisFiltersClient.Service)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2730,7 +2814,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisFirewallRulesClient.Service)
+		c.limitConcurrency("cis", session.cisFirewallRulesClient.Service)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2752,7 +2837,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisOriginAuthPullErr)
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cisOriginAuthClient.Service)
+		c.limitConcurrency("cis", session.cisOriginAuthClient.Service)
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2768,9 +2854,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
+	iamIdenityURL = c.endpointFallBack(fileMap, "IBMCLOUD_IAM_API_ENDPOINT", iamIdenityURL)
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
@@ -2780,7 +2864,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(iamIdentityClient.Service)
+		c.limitConcurrency("iam", iamIdentityClient.Service)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2796,9 +2881,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
-	}
+	iamPolicyManagementURL = c.endpointFallBack(fileMap, "IBMCLOUD_IAM_API_ENDPOINT", iamPolicyManagementURL)
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
@@ -2808,7 +2891,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(iamPolicyManagementClient.Service)
+		c.limitConcurrency("iam", iamPolicyManagementClient.Service)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2824,9 +2908,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
-	}
+	iamAccessGroupsURL = c.endpointFallBack(fileMap, "IBMCLOUD_IAM_API_ENDPOINT", iamAccessGroupsURL)
 	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
@@ -2836,7 +2918,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(iamAccessGroupsClient.Service)
+		c.limitConcurrency("iam", iamAccessGroupsClient.Service)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2860,9 +2943,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rmURL = resourcemanager.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
-	}
+	rmURL = c.endpointFallBack(fileMap, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", rmURL)
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
@@ -2872,7 +2953,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(resourceManagerClient.Service)
+		c.limitConcurrency("resource_manager", resourceManagerClient.Service)
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2881,9 +2963,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	//CLOUD SHELL Service
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
-	}
+	cloudShellUrl = c.endpointFallBack(fileMap, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", cloudShellUrl)
 	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
@@ -2893,7 +2973,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.ibmCloudShellClient.Service)
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2917,9 +2997,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
-	}
+	enterpriseURL = c.endpointFallBack(fileMap, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", enterpriseURL)
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
@@ -2929,13 +3007,32 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(enterpriseManagementClient.Service)
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
 
+	// USAGE REPORTS Service
+	usageReportsURL := usagereportsv4.DefaultServiceURL
+	usageReportsURL = c.endpointFallBack(fileMap, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", usageReportsURL)
+	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
+	}
+	usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
+	if err != nil {
+		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
+	}
+	if usageReportsClient != nil && usageReportsClient.Service != nil {
+		c.enableRetries(usageReportsClient.Service)
+		usageReportsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.usageReportsClient = usageReportsClient
+
 	// RESOURCE CONTROLLER Service
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
@@ -2953,9 +3050,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rcURL = resourcecontroller.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
-	}
+	rcURL = c.endpointFallBack(fileMap, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", rcURL)
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
@@ -2965,7 +3060,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(resourceControllerClient.Service)
+		c.limitConcurrency("resource_controller", resourceControllerClient.Service)
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2983,7 +3079,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.secretsManagerClient.Service)
+		c.limitConcurrency("secrets_manager", session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2998,6 +3095,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		smBaseUrl = ContructEndpoint(fmt.Sprintf("secrets-manager.%s", c.Region), cloudEndpoint)
 	}
+	smBaseUrl = c.endpointFallBack(fileMap, "IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT", smBaseUrl)
 
 	secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
 		Authenticator: authenticator,
@@ -3008,7 +3106,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
 	if err == nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.secretsManagerClient.Service)
+		c.limitConcurrency("secrets_manager", session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3022,9 +3121,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
+	containerEndpoint = c.endpointFallBack(fileMap, "IBMCLOUD_SATELLITE_API_ENDPOINT", containerEndpoint)
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
 		Authenticator: authenticator,
@@ -3036,7 +3133,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.satelliteClient.Service)
+		c.limitConcurrency("satellite", session.satelliteClient.Service)
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3048,9 +3146,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
+	satelliteLinkEndpoint = c.endpointFallBack(fileMap, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", satelliteLinkEndpoint)
 	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
 		Authenticator: authenticator,
@@ -3061,7 +3157,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.satelliteLinkClient.Service)
+		c.limitConcurrency("satellite", session.satelliteLinkClient.Service)
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3076,7 +3173,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.esSchemaRegistryClient.Service)
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3102,7 +3199,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.configServiceApiClient, err = configurationgovernancev1.NewConfigurationGovernanceV1(configServiceApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.configServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.configServiceApiClient.Service)
 		// Add custom header for analytics
 		session.configServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3122,9 +3219,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		postureManagementClientURL = posturemanagementv1.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURL)
-	}
+	postureManagementClientURL = c.endpointFallBack(fileMap, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURL)
 	postureManagementClientOptions := &posturemanagementv1.PostureManagementV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURL),
@@ -3138,7 +3233,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.postureManagementClient.Service)
 		// Add custom header for analytics
 		session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3156,9 +3251,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Posture Management API service:  `%s` region not supported", c.Region)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURLv2 = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURLv2)
-	}
+	postureManagementClientURLv2 = c.endpointFallBack(fileMap, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURLv2)
 	postureManagementClientOptionsv2 := &posturemanagementv2.PostureManagementV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURLv2),
@@ -3171,7 +3264,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClientv2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.postureManagementClientv2.Service)
 		// Add custom header for analytics
 		session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3191,9 +3284,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		cdToolchainClientURL = cdtoolchainv2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
-	}
+	cdToolchainClientURL = c.endpointFallBack(fileMap, "IBMCLOUD_TOOLCHAIN_ENDPOINT", cdToolchainClientURL)
 	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
@@ -3203,7 +3294,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cdToolchainClient.Service)
 		// Add custom header for analytics
 		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3225,9 +3316,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
-	}
+	cdTektonPipelineClientURL = c.endpointFallBack(fileMap, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", cdTektonPipelineClientURL)
 	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
@@ -3236,7 +3325,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(session.cdTektonPipelineClient.Service)
 		// Add custom header for analytics
 		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3246,6 +3335,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	if os.Getenv("TF_LOG") != "" {
+		redactDebugLogs()
 		logDestination := log.Writer()
 		goLogger := log.New(logDestination, "", log.LstdFlags)
 		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
@@ -3288,11 +3378,52 @@ func newSession(c *Config) (*Session, error) {
 	softlayerSession.AppendUserAgent(fmt.Sprintf("terraform-provider-ibm/%s", version.Version))
 	ibmSession.SoftLayerSession = softlayerSession
 
-	if c.IAMTrustedProfileID == "" && (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
+	if c.CredentialProcess != "" && c.BluemixAPIKey == "" && c.IAMToken == "" && c.IAMTrustedProfileID == "" {
+		output, err := runCredentialProcess(c.CredentialProcess)
+		if err != nil {
+			return nil, err
+		}
+		if output.ApiKey != "" {
+			c.BluemixAPIKey = output.ApiKey
+		} else {
+			c.IAMToken = "Bearer " + output.AccessToken
+			c.credentialProcessOutput = output
+		}
+	}
+
+	if c.AssumeProfileID != "" {
+		if c.BluemixAPIKey == "" || c.IAMTrustedProfileID != "" {
+			return nil, fmt.Errorf("ibmcloud_api_key must be provided with assume_profile_id, without iam_profile_id")
+		}
+		log.Println("Assuming the trusted profile with the API key")
+		iamURL := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, c.endpointFallBack(nil, "IBMCLOUD_IAM_API_ENDPOINT", iamidentity.DefaultServiceURL))
+		c.assumeProfile = newAssumeProfileAuthenticator(sharedIamAuthenticator(c.BluemixAPIKey, "", iamURL), c.AssumeProfileID, iamURL)
+		token, err := c.assumeProfile.GetToken()
+		if err != nil {
+			return nil, err
+		}
+		// All the clients use the access token of the trusted profile instead of the API key
+		c.BluemixAPIKey = ""
+		c.IAMToken = "Bearer " + token
+	}
+
+	if c.IAMTrustedProfileID != "" && c.IAMCRTokenFile != "" && c.IAMToken == "" {
+		log.Println("Fetching IAM token for the trusted profile with the compute resource token")
+		token, err := containerAuthenticator(c, EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, c.endpointFallBack(nil, "IBMCLOUD_IAM_API_ENDPOINT", iamidentity.DefaultServiceURL))).GetToken()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error occured while fetching the IAM token of the trusted profile %s with the compute resource token: %q", c.IAMTrustedProfileID, err)
+		}
+		c.IAMToken = "Bearer " + token
+	}
+	if c.IAMCRTokenFile != "" && c.IAMTrustedProfileID == "" {
+		return nil, fmt.Errorf("iam_cr_token_file and iam_profile_id must be provided")
+	}
+
+	if c.IAMTrustedProfileID == "" && c.credentialProcessOutput == nil && c.assumeProfile == nil && (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
 		return nil, fmt.Errorf("iam_token and iam_refresh_token must be provided")
 	}
 	if c.IAMTrustedProfileID != "" && c.IAMToken == "" {
-		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
+		return nil, fmt.Errorf("iam_token or iam_cr_token_file must be provided with iam_profile_id")
 	}
 
 	if c.IAMToken != "" {
@@ -3312,6 +3443,9 @@ func newSession(c *Config) (*Session, error) {
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
+		if endpoint := c.Endpoints["IBMCLOUD_IAM_API_ENDPOINT"]; endpoint != "" {
+			bmxConfig.TokenProviderEndpoint = &endpoint
+		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
@@ -3335,6 +3469,9 @@ func newSession(c *Config) (*Session, error) {
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
+		if endpoint := c.Endpoints["IBMCLOUD_IAM_API_ENDPOINT"]; endpoint != "" {
+			bmxConfig.TokenProviderEndpoint = &endpoint
+		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
@@ -3345,6 +3482,16 @@ func newSession(c *Config) (*Session, error) {
 	return ibmSession, nil
 }
 
+// containerAuthenticator returns an authenticator that exchanges the compute resource token of
+// an IKS or Code Engine workload for an IAM access token of the trusted profile.
+func containerAuthenticator(c *Config, iamURL string) *core.ContainerAuthenticator {
+	return &core.ContainerAuthenticator{
+		CRTokenFilename: c.IAMCRTokenFile,
+		IAMProfileID:    c.IAMTrustedProfileID,
+		URL:             iamURL,
+	}
+}
+
 func authenticateAPIKey(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// credentialProcessOutput is the JSON object that a credential process prints on its standard
+// output. It has either an IAM API key, or an IAM access token and the time when it expires.
+type credentialProcessOutput struct {
+	ApiKey      string `json:"api_key"`
+	AccessToken string `json:"access_token"`
+	// Expiration is the time when the access token expires, in seconds since the Unix epoch
+	Expiration int64 `json:"expiration"`
+}
+
+// runCredentialProcess runs the credential process command with the shell of the platform and
+// returns the credentials that it prints.
+func runCredentialProcess(command string) (*credentialProcessOutput, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", command)
+	} else {
+		cmd = exec.Command("/bin/sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Println("Running the credential process")
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("[ERROR] The credential process failed: %s: %s", err, stderr.String())
+	}
+
+	output := &credentialProcessOutput{}
+	if err := json.Unmarshal(stdout.Bytes(), output); err != nil {
+		return nil, fmt.Errorf("[ERROR] The output of the credential process is not a JSON object: %s", err)
+	}
+	if (output.ApiKey == "") == (output.AccessToken == "") {
+		return nil, fmt.Errorf("[ERROR] The output of the credential process must have either api_key or access_token")
+	}
+	return output, nil
+}
+
+// credentialProcessAuthenticator authenticates requests with the access token of a credential
+// process, and runs the process again when the token is about to expire.
+type credentialProcessAuthenticator struct {
+	command string
+
+	mutex       sync.Mutex
+	accessToken string
+	expiration  int64
+}
+
+func newCredentialProcessAuthenticator(command string, output *credentialProcessOutput) *credentialProcessAuthenticator {
+	return &credentialProcessAuthenticator{
+		command:     command,
+		accessToken: output.AccessToken,
+		expiration:  output.Expiration,
+	}
+}
+
+func (authenticator *credentialProcessAuthenticator) AuthenticationType() string {
+	return "credentialProcess"
+}
+
+func (authenticator *credentialProcessAuthenticator) Validate() error {
+	if authenticator.command == "" {
+		return fmt.Errorf("[ERROR] The credential process command is empty")
+	}
+	return nil
+}
+
+func (authenticator *credentialProcessAuthenticator) Authenticate(request *http.Request) error {
+	token, err := authenticator.token()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// token returns the current access token, after it is refreshed when it expires within a minute.
+// A token without an expiration is never refreshed.
+func (authenticator *credentialProcessAuthenticator) token() (string, error) {
+	authenticator.mutex.Lock()
+	defer authenticator.mutex.Unlock()
+
+	if authenticator.expiration == 0 || time.Now().Add(time.Minute).Unix() < authenticator.expiration {
+		return authenticator.accessToken, nil
+	}
+
+	output, err := runCredentialProcess(authenticator.command)
+	if err != nil {
+		return "", err
+	}
+	if output.AccessToken == "" {
+		return "", fmt.Errorf("[ERROR] The credential process returned an API key instead of a new access token")
+	}
+	authenticator.accessToken = output.AccessToken
+	authenticator.expiration = output.Expiration
+	return authenticator.accessToken, nil
+}
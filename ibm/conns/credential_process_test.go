@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestRunCredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands need a POSIX shell")
+	}
+
+	output, err := runCredentialProcess(`echo '{"api_key": "my-api-key"}'`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if output.ApiKey != "my-api-key" {
+		t.Fatalf("Expected the API key, got %q", output.ApiKey)
+	}
+
+	for _, command := range []string{
+		`exit 1`,
+		`echo not-json`,
+		`echo '{}'`,
+		`echo '{"api_key": "my-api-key", "access_token": "my-token"}'`,
+	} {
+		if _, err := runCredentialProcess(command); err == nil {
+			t.Fatalf("Expected an error for %s", command)
+		}
+	}
+}
+
+func TestCredentialProcessAuthenticatorRefresh(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands need a POSIX shell")
+	}
+
+	// The process prints a token that is valid for an hour and counts how often it runs
+	counter := filepath.Join(t.TempDir(), "counter")
+	command := fmt.Sprintf(`echo x >> %s; echo "{\"access_token\": \"token-$(wc -l < %s | tr -d ' ')\", \"expiration\": $(( $(date +%%s) + 3600 ))}"`, counter, counter)
+
+	authenticator := newCredentialProcessAuthenticator(command, &credentialProcessOutput{
+		AccessToken: "expired-token",
+		Expiration:  time.Now().Add(-time.Minute).Unix(),
+	})
+
+	for i := 0; i < 2; i++ {
+		request, _ := http.NewRequest("GET", "https://cloud.ibm.com", nil)
+		if err := authenticator.Authenticate(request); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if header := request.Header.Get("Authorization"); header != "Bearer token-1" {
+			t.Fatalf("Expected the refreshed token, got %q", header)
+		}
+	}
+
+	runs, err := os.ReadFile(counter)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if string(runs) != "x\n" {
+		t.Fatalf("Expected the credential process to run once, got %q", runs)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Properties of the profiles of the credentials file
+const (
+	ProfileAPIKey          = "APIKEY"
+	ProfileRegion          = "REGION"
+	ProfileZone            = "ZONE"
+	ProfileResourceGroup   = "RESOURCE_GROUP"
+	ProfileAssumeProfileID = "ASSUME_PROFILE_ID"
+)
+
+// credentialsFilePath returns the path of the credentials file of the IBM Cloud SDKs, which is
+// IBM_CREDENTIALS_FILE, or else ibm-credentials.env in the working directory or in the home
+// directory.
+func credentialsFilePath() string {
+	paths := []string{os.Getenv(core.IBM_CREDENTIAL_FILE_ENVVAR)}
+	if dir, err := os.Getwd(); err == nil {
+		paths = append(paths, filepath.Join(dir, core.DEFAULT_CREDENTIAL_FILE_NAME))
+	}
+	paths = append(paths, filepath.Join(core.UserHomeDir(), core.DEFAULT_CREDENTIAL_FILE_NAME))
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// CredentialsProfile returns the properties of a named profile of the credentials file. Like the
+// properties of a service for the IBM Cloud SDKs, the properties of a profile are prefixed with
+// the name of the profile in upper case, such as PROD_APIKEY and PROD_REGION for the prod profile.
+func CredentialsProfile(profile string) (map[string]string, error) {
+	path := credentialsFilePath()
+	if path == "" {
+		return nil, fmt.Errorf("[ERROR] The credentials file of the profile %s is not found, set IBM_CREDENTIALS_FILE to its path", profile)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occured while opening the credentials file %s: %q", path, err)
+	}
+	defer file.Close()
+
+	prefix := strings.ReplaceAll(strings.ToUpper(profile), "-", "_") + "_"
+	properties := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tokens := strings.SplitN(line, "=", 2)
+		if len(tokens) != 2 {
+			continue
+		}
+		if key := strings.TrimSpace(tokens[0]); strings.HasPrefix(key, prefix) && len(key) > len(prefix) {
+			properties[key[len(prefix):]] = strings.TrimSpace(tokens[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occured while reading the credentials file %s: %q", path, err)
+	}
+	if len(properties) == 0 {
+		return nil, fmt.Errorf("[ERROR] The profile %s is not found in the credentials file %s", profile, path)
+	}
+	return properties, nil
+}
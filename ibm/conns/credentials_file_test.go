@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCredentialsProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ibm-credentials.env")
+	content := `# Production account
+PROD_APIKEY=prod-api-key
+PROD_REGION = eu-de
+PROD_RESOURCE_GROUP=prod-group
+
+MY_DEV_APIKEY=dev-api-key
+MY_DEV_REGION=us-south
+`
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	t.Setenv("IBM_CREDENTIALS_FILE", path)
+
+	profile, err := CredentialsProfile("prod")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if profile[ProfileAPIKey] != "prod-api-key" || profile[ProfileRegion] != "eu-de" || profile[ProfileResourceGroup] != "prod-group" {
+		t.Fatalf("Expected the properties of the prod profile, got %v", profile)
+	}
+	if _, ok := profile[ProfileZone]; ok {
+		t.Fatalf("Expected no zone, got %v", profile)
+	}
+
+	profile, err = CredentialsProfile("my-dev")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if profile[ProfileAPIKey] != "dev-api-key" || len(profile) != 2 {
+		t.Fatalf("Expected the properties of the my-dev profile, got %v", profile)
+	}
+
+	if _, err := CredentialsProfile("test"); err == nil {
+		t.Fatalf("Expected an error for a missing profile")
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+// ServiceEndpointKeys maps the services of the endpoints block of the provider to the keys of
+// their endpoints in the endpoints file and in the environment variables.
+var ServiceEndpointKeys = map[string]string{
+	"api_gateway":                "IBMCLOUD_API_GATEWAY_ENDPOINT",
+	"app_configuration":          "IBMCLOUD_APP_CONFIG_ENDPOINT",
+	"appid":                      "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"atracker":                   "IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"catalog_management":         "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"cis":                        "IBMCLOUD_CIS_API_ENDPOINT",
+	"cloud_shell":                "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"compliance":                 "IBMCLOUD_COMPLIANCE_API_ENDPOINT",
+	"container_registry":         "IBMCLOUD_CR_API_ENDPOINT",
+	"context_based_restrictions": "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"cos_config":                 "IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"direct_link":                "IBMCLOUD_DL_API_ENDPOINT",
+	"direct_link_provider":       "IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"enterprise":                 "IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"event_notifications":        "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"global_search":              "IBMCLOUD_GS_API_ENDPOINT",
+	"global_tagging":             "IBMCLOUD_GT_API_ENDPOINT",
+	"iam":                        "IBMCLOUD_IAM_API_ENDPOINT",
+	"kms":                        "IBMCLOUD_KP_API_ENDPOINT",
+	"private_dns":                "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"push_notifications":         "IBMCLOUD_PUSH_API_ENDPOINT",
+	"resource_controller":        "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"resource_manager":           "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"satellite":                  "IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"satellite_link":             "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"schematics":                 "IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"secrets_manager":            "IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT",
+	"tekton_pipeline":            "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"toolchain":                  "IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"transit_gateway":            "IBMCLOUD_TG_API_ENDPOINT",
+	"usage_reports":              "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT",
+	"vpc":                        "IBMCLOUD_IS_NG_API_ENDPOINT",
+}
+
+// endpointFallBack returns the endpoint of a service from the endpoints block of the provider,
+// or else from the endpoints file for the visibility and the region of the provider, or else the
+// default endpoint. The endpoints of the endpoints block are used whatever the visibility.
+func (c *Config) endpointFallBack(fileMap map[string]interface{}, key, defaultValue string) string {
+	if endpoint := c.Endpoints[key]; endpoint != "" {
+		return endpoint
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		return fileFallBack(fileMap, c.Visibility, key, c.Region, defaultValue)
+	}
+	return defaultValue
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestEndpointFallBack(t *testing.T) {
+	fileMap := map[string]interface{}{
+		"IBMCLOUD_IAM_API_ENDPOINT": map[string]interface{}{
+			"private": map[string]interface{}{
+				"us-south": "https://private.us-south.iam.cloud.ibm.com",
+			},
+		},
+		"IBMCLOUD_KP_API_ENDPOINT": map[string]interface{}{
+			"private": map[string]interface{}{
+				"us-south": "https://private.us-south.kms.cloud.ibm.com",
+			},
+		},
+	}
+	config := &Config{
+		Region:     "us-south",
+		Visibility: "private",
+		Endpoints: map[string]string{
+			"IBMCLOUD_KP_API_ENDPOINT": "https://kms.example.com",
+		},
+	}
+
+	if endpoint := config.endpointFallBack(fileMap, "IBMCLOUD_KP_API_ENDPOINT", "https://default"); endpoint != "https://kms.example.com" {
+		t.Fatalf("Expected the endpoint of the endpoints block, got %s", endpoint)
+	}
+	if endpoint := config.endpointFallBack(fileMap, "IBMCLOUD_IAM_API_ENDPOINT", "https://default"); endpoint != "https://private.us-south.iam.cloud.ibm.com" {
+		t.Fatalf("Expected the endpoint of the endpoints file, got %s", endpoint)
+	}
+	if endpoint := config.endpointFallBack(fileMap, "IBMCLOUD_IS_NG_API_ENDPOINT", "https://default"); endpoint != "https://default" {
+		t.Fatalf("Expected the default endpoint, got %s", endpoint)
+	}
+
+	// The endpoints file has no endpoints for public-and-private, the endpoints block is used anyway
+	config.Visibility = "public-and-private"
+	if endpoint := config.endpointFallBack(fileMap, "IBMCLOUD_IAM_API_ENDPOINT", "https://default"); endpoint != "https://default" {
+		t.Fatalf("Expected the default endpoint, got %s", endpoint)
+	}
+	if endpoint := config.endpointFallBack(fileMap, "IBMCLOUD_KP_API_ENDPOINT", "https://default"); endpoint != "https://kms.example.com" {
+		t.Fatalf("Expected the endpoint of the endpoints block, got %s", endpoint)
+	}
+}
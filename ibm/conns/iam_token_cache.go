@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// iamAuthenticatorKey identifies the credentials of an IAM authenticator. The credential is hashed
+// so that the API keys and refresh tokens are not kept as map keys.
+type iamAuthenticatorKey struct {
+	credential string
+	url        string
+}
+
+// iamAuthenticators caches the IAM authenticators of the provider configurations. The service
+// clients of all the configurations with the same credentials, such as the provider aliases of
+// several regions, share one authenticator and so one access token, which the authenticator
+// refreshes in the background before it expires.
+var iamAuthenticators = struct {
+	sync.Mutex
+	authenticators map[iamAuthenticatorKey]*core.IamAuthenticator
+}{authenticators: make(map[iamAuthenticatorKey]*core.IamAuthenticator)}
+
+// sharedIamAuthenticator returns the cached IAM authenticator of an API key or a refresh token,
+// and creates it when it is not cached yet.
+func sharedIamAuthenticator(apiKey, refreshToken, url string) *core.IamAuthenticator {
+	credential := sha256.Sum256([]byte(apiKey + "\x00" + refreshToken))
+	key := iamAuthenticatorKey{
+		credential: hex.EncodeToString(credential[:]),
+		url:        url,
+	}
+
+	iamAuthenticators.Lock()
+	defer iamAuthenticators.Unlock()
+
+	if authenticator, ok := iamAuthenticators.authenticators[key]; ok {
+		return authenticator
+	}
+
+	var authenticator *core.IamAuthenticator
+	if apiKey != "" {
+		authenticator = &core.IamAuthenticator{
+			ApiKey: apiKey,
+			URL:    url,
+		}
+	} else {
+		// Construct the IamAuthenticator with the IAM refresh token.
+		authenticator = &core.IamAuthenticator{
+			RefreshToken: refreshToken,
+			ClientId:     "bx",
+			ClientSecret: "bx",
+			URL:          url,
+		}
+	}
+	iamAuthenticators.authenticators[key] = authenticator
+	return authenticator
+}
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestSharedIamAuthenticator(t *testing.T) {
+	authenticator := sharedIamAuthenticator("my-api-key", "", "https://iam.cloud.ibm.com")
+	if authenticator.ApiKey != "my-api-key" {
+		t.Fatalf("Expected an API key authenticator, got %+v", authenticator)
+	}
+	if other := sharedIamAuthenticator("my-api-key", "", "https://iam.cloud.ibm.com"); other != authenticator {
+		t.Fatalf("Expected the same credentials to share the authenticator")
+	}
+	if other := sharedIamAuthenticator("my-api-key", "", "https://private.iam.cloud.ibm.com"); other == authenticator {
+		t.Fatalf("Expected another IAM endpoint to have its own authenticator")
+	}
+	if other := sharedIamAuthenticator("other-api-key", "", "https://iam.cloud.ibm.com"); other == authenticator {
+		t.Fatalf("Expected another API key to have its own authenticator")
+	}
+
+	refreshTokenAuthenticator := sharedIamAuthenticator("", "my-refresh-token", "https://iam.cloud.ibm.com")
+	if refreshTokenAuthenticator.RefreshToken != "my-refresh-token" || refreshTokenAuthenticator.ClientId != "bx" {
+		t.Fatalf("Expected a refresh token authenticator, got %+v", refreshTokenAuthenticator)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// publicEndpointError is returned instead of sending a request to a public endpoint when the
+// provider only allows private endpoints
+type publicEndpointError struct {
+	host string
+}
+
+func (e *publicEndpointError) Error() string {
+	return fmt.Sprintf("[ERROR] %s is not a private endpoint and private_endpoints_only is set, "+
+		"set the private endpoint of the service in the endpoints block or in the endpoints file", e.host)
+}
+
+// setPrivateEndpointHosts sets the hosts of the endpoints of the endpoints block, of the
+// environment variables and of the private endpoints of the endpoints file. These endpoints are
+// private endpoints that the user chose, such as virtual private endpoints, so their hosts are
+// allowed even if they are not private endpoints of IBM Cloud.
+func (c *Config) setPrivateEndpointHosts(fileMap map[string]interface{}) {
+	c.privateEndpointHosts = nil
+	addEndpoint := func(endpoint string) {
+		// The instance and the region of templated endpoints match any host label
+		endpoint = strings.NewReplacer("{instance_id}", "*", "{region}", "*").Replace(endpoint)
+		if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+			c.privateEndpointHosts = append(c.privateEndpointHosts, u.Host)
+		}
+	}
+	for _, endpoint := range c.Endpoints {
+		addEndpoint(endpoint)
+	}
+	for _, key := range ServiceEndpointKeys {
+		addEndpoint(os.Getenv(key))
+	}
+	for _, val := range fileMap {
+		if v, ok := val.(map[string]interface{})["private"]; ok {
+			for _, endpoint := range v.(map[string]interface{}) {
+				if e, ok := endpoint.(string); ok {
+					addEndpoint(e)
+				}
+			}
+		}
+	}
+}
+
+// isPrivateEndpoint returns whether a host is a private endpoint of IBM Cloud, such as
+// private.us-south.iam.cloud.ibm.com, or a host of an endpoint that the user set.
+func (c *Config) isPrivateEndpoint(host string) bool {
+	for _, label := range strings.Split(host, ".") {
+		if label == "private" {
+			return true
+		}
+	}
+	for _, pattern := range c.privateEndpointHosts {
+		if matched, _ := path.Match(pattern, host); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// privateEndpointTransport refuses the requests to the endpoints that are not private, so that
+// the service clients never fall back to a public endpoint.
+type privateEndpointTransport struct {
+	config    *Config
+	transport http.RoundTripper
+}
+
+func (t *privateEndpointTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.config.isPrivateEndpoint(req.URL.Host) {
+		return nil, &publicEndpointError{host: req.URL.Host}
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// privateEndpointRetryPolicy does not retry the requests that were refused because of their
+// public endpoint, and otherwise retries the requests like the SDK does.
+func privateEndpointRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	var publicEndpointErr *publicEndpointError
+	if errors.As(err, &publicEndpointErr) {
+		return false, err
+	}
+	return core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+}
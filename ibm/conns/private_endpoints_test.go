@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestIsPrivateEndpoint(t *testing.T) {
+	config := &Config{
+		Endpoints: map[string]string{
+			"IBMCLOUD_KP_API_ENDPOINT":              "https://kms.example.com",
+			"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT": "https://{instance_id}.vpe.example.com",
+		},
+	}
+	config.setPrivateEndpointHosts(map[string]interface{}{
+		"IBMCLOUD_IAM_API_ENDPOINT": map[string]interface{}{
+			"private": map[string]interface{}{
+				"us-south": "https://iam.vpe.example.com",
+			},
+			"public": map[string]interface{}{
+				"us-south": "https://iam.cloud.ibm.com",
+			},
+		},
+	})
+
+	for host, private := range map[string]bool{
+		"private.us-south.iam.cloud.ibm.com":                           true,
+		"my-instance.private.us-south.secrets-manager.appdomain.cloud": true,
+		"kms.example.com":             true,
+		"my-instance.vpe.example.com": true,
+		"iam.vpe.example.com":         true,
+		"iam.cloud.ibm.com":           false,
+		"us-south.kms.cloud.ibm.com":  false,
+		"private-dns.example.com":     false,
+	} {
+		if config.isPrivateEndpoint(host) != private {
+			t.Errorf("Expected %s to be private: %t", host, private)
+		}
+	}
+}
+
+func TestEnableRetriesPrivateEndpointsOnly(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		RetryCount:           3,
+		RetryDelay:           time.Second,
+		PrivateEndpointsOnly: true,
+	}
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	config.enableRetries(service)
+
+	// The test server is not a private endpoint, the request is refused without retries
+	start := time.Now()
+	if _, err := service.Client.Get(server.URL); err == nil || !strings.Contains(err.Error(), "private_endpoints_only") {
+		t.Fatalf("Expected the public endpoint to be refused, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("Expected the refused request not to be retried, it took %s", elapsed)
+	}
+	if requests != 0 {
+		t.Fatalf("Expected no request to be sent, got %d", requests)
+	}
+
+	// The endpoint of the endpoints block is allowed
+	serverURL, _ := url.Parse(server.URL)
+	config.Endpoints = map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": server.URL}
+	config.setPrivateEndpointHosts(nil)
+	response, err := service.Client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected %s to be allowed, got %s", serverURL.Host, err)
+	}
+	response.Body.Close()
+	if requests != 1 {
+		t.Fatalf("Expected 1 request, got %d", requests)
+	}
+}
+
+func TestClientSessionPrivateEndpointsOnlyVisibility(t *testing.T) {
+	config := &Config{
+		Visibility:           "public-and-private",
+		PrivateEndpointsOnly: true,
+	}
+	if _, err := config.ClientSession(); err == nil || !strings.Contains(err.Error(), "private visibility") {
+		t.Fatalf("Expected an error about the visibility, got %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/trace"
+)
+
+const redacted = `"[REDACTED]"`
+
+// sensitiveFields are the JSON fields of the API requests and responses whose values are masked
+// in the debug logs, such as the payloads of the secrets and the private keys of the certificates
+var sensitiveFields = map[string]bool{
+	"access_token":  true,
+	"api_key":       true,
+	"apikey":        true,
+	"certificate":   true,
+	"client_secret": true,
+	"credentials":   true,
+	"intermediate":  true,
+	"passphrase":    true,
+	"password":      true,
+	"payload":       true,
+	"private_key":   true,
+	"refresh_token": true,
+	"token":         true,
+}
+
+// sensitiveFieldSuffixes are the suffixes of the other sensitive fields, such as admin_password
+var sensitiveFieldSuffixes = []string{"_password", "_private_key", "_api_key", "_apikey", "_token"}
+
+func isSensitiveField(field string) bool {
+	field = strings.ToLower(field)
+	if sensitiveFields[field] {
+		return true
+	}
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(field, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactSecrets returns the input with the values of the sensitive JSON fields masked, whatever
+// the type of the values, so that the JSON bodies of the API calls can be logged.
+func RedactSecrets(input string) string {
+	var out strings.Builder
+	last := 0
+	for i := 0; i < len(input); i++ {
+		if input[i] != '"' {
+			continue
+		}
+		end := skipJSONString(input, i)
+		if end >= len(input) {
+			break
+		}
+		field := input[i+1 : end-1]
+		colon := skipSpaces(input, end)
+		if colon >= len(input) || input[colon] != ':' || !isSensitiveField(field) {
+			i = end - 1
+			continue
+		}
+		valueStart := skipSpaces(input, colon+1)
+		if valueStart >= len(input) {
+			break
+		}
+		valueEnd := skipJSONValue(input, valueStart)
+		out.WriteString(input[last:valueStart])
+		out.WriteString(redacted)
+		last = valueEnd
+		i = valueEnd - 1
+	}
+	out.WriteString(input[last:])
+	return out.String()
+}
+
+// skipJSONString returns the index after the end of the JSON string that starts at start
+func skipJSONString(input string, start int) int {
+	for i := start + 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(input)
+}
+
+// skipJSONValue returns the index after the end of the JSON value that starts at start
+func skipJSONValue(input string, start int) int {
+	switch input[start] {
+	case '"':
+		return skipJSONString(input, start)
+	case '{', '[':
+		depth := 0
+		for i := start; i < len(input); i++ {
+			switch input[i] {
+			case '"':
+				i = skipJSONString(input, i) - 1
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return i + 1
+				}
+			}
+		}
+		return len(input)
+	}
+	for i := start; i < len(input); i++ {
+		switch input[i] {
+		case ',', '}', ']', ' ', '\t', '\r', '\n':
+			return i
+		}
+	}
+	return len(input)
+}
+
+func skipSpaces(input string, start int) int {
+	for start < len(input) && strings.ContainsRune(" \t\r\n", rune(input[start])) {
+		start++
+	}
+	return start
+}
+
+// redactingWriter masks the sensitive fields of the log entries that it writes
+type redactingWriter struct {
+	writer io.Writer
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.writer, RedactSecrets(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// redactingPrinter masks the sensitive fields of the trace of the bluemix-go clients
+type redactingPrinter struct {
+	printer trace.Printer
+}
+
+func (p *redactingPrinter) Print(v ...interface{}) {
+	p.printer.Print(RedactSecrets(fmt.Sprint(v...)))
+}
+
+func (p *redactingPrinter) Printf(format string, v ...interface{}) {
+	p.printer.Print(RedactSecrets(fmt.Sprintf(format, v...)))
+}
+
+func (p *redactingPrinter) Println(v ...interface{}) {
+	p.printer.Println(RedactSecrets(strings.TrimSuffix(fmt.Sprintln(v...), "\n")))
+}
+
+// redactDebugLogs masks the sensitive fields of the logs of the provider, of the IBM Cloud SDK
+// service clients and of the bluemix-go clients, which log the bodies of the API calls when
+// TF_LOG is set.
+func redactDebugLogs() {
+	if _, ok := log.Writer().(*redactingWriter); !ok {
+		log.SetOutput(&redactingWriter{writer: log.Writer()})
+	}
+	if _, ok := trace.Logger.(*redactingPrinter); !ok {
+		trace.Logger = &redactingPrinter{printer: trace.Logger}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
+)
+
+// newRequestLimiter returns the limiter of the requests of all the service clients, or nil when
+// the requests are not limited.
+func newRequestLimiter(requestsPerSecond int) *rate.Limiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond)
+}
+
+// enableRetries enables the retries of the API calls of a service client, and limits the rate of
+// its requests. The wait time between the retries grows from the minimum retry delay up to the
+// retry delay, unless the API returns a Retry-After header. When the provider only allows private
+// endpoints, the requests to the other endpoints are refused. When the API call summary is enabled,
+// the API calls, their retries and their latency are recorded.
+func (c *Config) enableRetries(service *core.BaseService) {
+	service.EnableRetries(c.RetryCount, c.RetryDelay)
+
+	tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper)
+	if !ok {
+		return
+	}
+	if c.RetryMinDelay > 0 {
+		tr.Client.RetryWaitMin = c.RetryMinDelay
+		if tr.Client.RetryWaitMax < c.RetryMinDelay {
+			tr.Client.RetryWaitMax = c.RetryMinDelay
+		}
+	}
+	if c.PrivateEndpointsOnly {
+		tr.Client.CheckRetry = privateEndpointRetryPolicy
+	}
+	if c.APICallSummary {
+		tr.Client.RequestLogHook = apiCallLogHook
+	}
+	httpClient := tr.Client.HTTPClient
+	switch httpClient.Transport.(type) {
+	case *rateLimitedTransport, *privateEndpointTransport, *concurrencyLimitedTransport, *apiCallTransport:
+		return
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if c.requestLimiter != nil {
+		transport = &rateLimitedTransport{
+			limiter:   c.requestLimiter,
+			transport: transport,
+		}
+	}
+	if c.PrivateEndpointsOnly {
+		transport = &privateEndpointTransport{
+			config:    c,
+			transport: transport,
+		}
+	}
+	if c.APICallSummary {
+		transport = &apiCallTransport{transport: transport}
+	}
+	httpClient.Transport = transport
+}
+
+// rateLimitedTransport waits for the limiter before each request, including the retries of a
+// request, so that the requests of all the service clients share the same rate.
+type rateLimitedTransport struct {
+	limiter   *rate.Limiter
+	transport http.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(request.Context()); err != nil {
+		return nil, err
+	}
+	return t.transport.RoundTrip(request)
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestEnableRetriesRetryAfter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		retryDelay time.Duration
+		retryAfter string
+		wait       time.Duration
+	}{
+		{name: "below the retry delay", retryDelay: 5 * time.Second, retryAfter: "1", wait: time.Second},
+		// The Retry-After header of the API wins over max_retry_interval
+		{name: "above the retry delay", retryDelay: time.Second, retryAfter: "2", wait: 2 * time.Second},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// The server rejects the first request with a 429 and a Retry-After header
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) == 1 {
+					w.Header().Set("Retry-After", tc.retryAfter)
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			config := &Config{
+				RetryCount:     2,
+				RetryDelay:     tc.retryDelay,
+				RetryMinDelay:  10 * time.Millisecond,
+				requestLimiter: newRequestLimiter(100),
+			}
+			service, err := core.NewBaseService(&core.ServiceOptions{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			config.enableRetries(service)
+
+			start := time.Now()
+			response, err := service.Client.Get(server.URL)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			response.Body.Close()
+
+			if response.StatusCode != http.StatusOK {
+				t.Fatalf("Expected the retry to succeed, got %d", response.StatusCode)
+			}
+			if requests != 2 {
+				t.Fatalf("Expected 2 requests, got %d", requests)
+			}
+			if elapsed := time.Since(start); elapsed < tc.wait {
+				t.Fatalf("Expected the retry to wait for the Retry-After header, waited %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestEnableRetriesRequestLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		RetryCount:     1,
+		RetryDelay:     time.Second,
+		requestLimiter: newRequestLimiter(10),
+	}
+
+	// The requests of two service clients share the limit of 10 requests per second
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		service, err := core.NewBaseService(&core.ServiceOptions{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		config.enableRetries(service)
+		config.enableRetries(service)
+
+		for j := 0; j < 10; j++ {
+			response, err := service.Client.Get(server.URL)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			response.Body.Close()
+		}
+	}
+
+	// The burst of 10 requests is immediate, the next 10 requests take a second
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("Expected the requests to be limited, 20 requests took %s", elapsed)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const ignoreDefaultTags = "ignore_default_tags"
+
+// resourceTagsSession is the client session that the functions of a resource that supports
+// Global Tagging are called with. It has no default tags when the resource ignores them, and it
+// gives the tags that the resource configures to the helpers that read the tags of the resource.
+type resourceTagsSession struct {
+	conns.ClientSession
+	d *schema.ResourceData
+}
+
+func (session resourceTagsSession) DefaultTags() []string {
+	if session.d.Get(ignoreDefaultTags).(bool) {
+		return nil
+	}
+	return session.ClientSession.DefaultTags()
+}
+
+func (session resourceTagsSession) DefaultAccessTags() []string {
+	if session.d.Get(ignoreDefaultTags).(bool) {
+		return nil
+	}
+	return session.ClientSession.DefaultAccessTags()
+}
+
+// configuredTags returns the tags of the tag type that the resource of a session configures, or
+// nil when the tags are not read for a resource.
+func configuredTags(meta interface{}, tagType string) *schema.Set {
+	session, ok := meta.(resourceTagsSession)
+	if !ok {
+		return nil
+	}
+	key := "tags"
+	if tagType == "access" {
+		key = "access_tags"
+	}
+	tags, _ := session.d.Get(key).(*schema.Set)
+	return tags
+}
+
+func withResourceTagsSession(d *schema.ResourceData, meta interface{}) interface{} {
+	if session, ok := meta.(conns.ClientSession); ok {
+		return resourceTagsSession{ClientSession: session, d: d}
+	}
+	return meta
+}
+
+// WithDefaultTags adds the ignore_default_tags argument to a resource that supports Global
+// Tagging, and calls its create, read and update functions with a client session that applies
+// it. The default tags of the provider configuration are not attached to a resource that
+// ignores them, and the default tags that a resource configures are kept when its tags are read.
+func WithDefaultTags(resource *schema.Resource) *schema.Resource {
+	resource.Schema[ignoreDefaultTags] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether the default tags of the provider configuration are not attached to the resource",
+	}
+	resource.Create = withDefaultTagsFunc(resource.Create)
+	resource.Read = withDefaultTagsFunc(resource.Read)
+	resource.Update = withDefaultTagsFunc(resource.Update)
+	resource.CreateContext = withDefaultTagsContextFunc(resource.CreateContext)
+	resource.ReadContext = withDefaultTagsContextFunc(resource.ReadContext)
+	resource.UpdateContext = withDefaultTagsContextFunc(resource.UpdateContext)
+	return resource
+}
+
+func withDefaultTagsFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return f(d, withResourceTagsSession(d, meta))
+	}
+}
+
+func withDefaultTagsContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(ctx, d, withResourceTagsSession(d, meta))
+	}
+}
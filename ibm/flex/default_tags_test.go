@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultTagsSession is a client session that only has default tags
+type defaultTagsSession struct {
+	conns.ClientSession
+	tags       []string
+	accessTags []string
+}
+
+func (session defaultTagsSession) DefaultTags() []string {
+	return session.tags
+}
+
+func (session defaultTagsSession) DefaultAccessTags() []string {
+	return session.accessTags
+}
+
+func testDefaultTagsResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	resource := WithDefaultTags(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      ResourceIBMVPCHash,
+			},
+			"access_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      ResourceIBMVPCHash,
+			},
+		},
+	})
+	return schema.TestResourceDataRaw(t, resource.Schema, raw)
+}
+
+func sortedTags(tags []string) []string {
+	sorted := append([]string{}, tags...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func TestSplitDefaultTags(t *testing.T) {
+	meta := defaultTagsSession{
+		tags:       []string{"env:prod", "cost-center:1234", "team"},
+		accessTags: []string{"project:payments"},
+	}
+	testCases := []struct {
+		name       string
+		tags       []string
+		tagType    string
+		defaults   []string
+		overridden []string
+	}{
+		{"no tags", nil, "user", []string{"cost-center:1234", "env:prod", "team"}, nil},
+		{"other tags", []string{"app:web"}, "", []string{"cost-center:1234", "env:prod", "team"}, nil},
+		{"override", []string{"env:dev"}, "user", []string{"cost-center:1234", "team"}, []string{"env:prod"}},
+		{"same tag", []string{"env:prod"}, "user", []string{"cost-center:1234", "team"}, nil},
+		{"key case", []string{"ENV:dev", "team:a"}, "user", []string{"cost-center:1234"}, []string{"env:prod", "team"}},
+		{"access tags", nil, "access", []string{"project:payments"}, nil},
+		{"service tags", nil, "service", nil, nil},
+	}
+	for _, tc := range testCases {
+		tags := NewStringSet(ResourceIBMVPCHash, tc.tags)
+		defaults, overridden := splitDefaultTags(meta, tags, tc.tagType)
+		if !reflect.DeepEqual(sortedTags(defaults), sortedTags(tc.defaults)) {
+			t.Errorf("%s: expected defaults %v, got %v", tc.name, tc.defaults, defaults)
+		}
+		if !reflect.DeepEqual(sortedTags(overridden), sortedTags(tc.overridden)) {
+			t.Errorf("%s: expected overridden defaults %v, got %v", tc.name, tc.overridden, overridden)
+		}
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	session := defaultTagsSession{
+		tags:       []string{"env:prod", "cost-center:1234"},
+		accessTags: []string{"project:payments"},
+	}
+	attached := []string{"env:prod", "cost-center:1234", "app:web"}
+	testCases := []struct {
+		name     string
+		raw      map[string]interface{}
+		tagType  string
+		attached []string
+		expected []string
+	}{
+		{"data source", nil, "user", attached, []string{"app:web"}},
+		{"resource", map[string]interface{}{"tags": []interface{}{"app:web"}}, "user", attached, []string{"app:web"}},
+		{"configured default", map[string]interface{}{"tags": []interface{}{"app:web", "env:prod"}}, "user", attached, []string{"app:web", "env:prod"}},
+		{"ignored defaults", map[string]interface{}{"tags": []interface{}{"app:web"}, "ignore_default_tags": true}, "user", attached, attached},
+		{"access tags", map[string]interface{}{"tags": []interface{}{"project:payments"}}, "access", []string{"project:payments"}, []string{}},
+		{"configured access tag", map[string]interface{}{"access_tags": []interface{}{"project:payments"}}, "access", []string{"project:payments"}, []string{"project:payments"}},
+	}
+	for _, tc := range testCases {
+		var meta interface{} = session
+		if tc.raw != nil {
+			meta = withResourceTagsSession(testDefaultTagsResourceData(t, tc.raw), session)
+		}
+		tags := removeDefaultTags(meta, NewStringSet(ResourceIBMVPCHash, tc.attached), tc.tagType)
+		if got := ExpandStringList(tags.List()); !reflect.DeepEqual(sortedTags(got), sortedTags(tc.expected)) {
+			t.Errorf("%s: expected tags %v, got %v", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestWithDefaultTagsIgnore(t *testing.T) {
+	session := defaultTagsSession{tags: []string{"env:prod"}, accessTags: []string{"project:payments"}}
+
+	d := testDefaultTagsResourceData(t, map[string]interface{}{"ignore_default_tags": true})
+	if meta := withResourceTagsSession(d, session); HasDefaultTags(meta, "user") || HasDefaultTags(meta, "access") {
+		t.Errorf("Expected a resource that ignores the default tags to have no default tags")
+	}
+	d = testDefaultTagsResourceData(t, map[string]interface{}{})
+	if meta := withResourceTagsSession(d, session); !HasDefaultTags(meta, "user") || !HasDefaultTags(meta, "access") {
+		t.Errorf("Expected a resource to have the default tags")
+	}
+}
+
+func TestRemoveReplacedTags(t *testing.T) {
+	t.Setenv("IC_ENV_TAGS", "schematics:ws,owner:ci")
+	meta := defaultTagsSession{tags: []string{"env:prod", "cost-center:1234"}}
+
+	attached := []string{"app:web", "env:dev", "cost-center:1234", "schematics:ws", "owner:ci"}
+	configured := NewStringSet(ResourceIBMVPCHash, []string{"app:web", "env:dev", "owner:ci"})
+	tags := RemoveReplacedTags(meta, NewStringSet(ResourceIBMVPCHash, attached), configured, "user")
+	expected := []string{"app:web", "env:dev", "owner:ci"}
+	if got := ExpandStringList(tags.List()); !reflect.DeepEqual(sortedTags(got), sortedTags(expected)) {
+		t.Errorf("Expected tags %v, got %v", expected, got)
+	}
+
+	// The environment tags are user tags
+	attached = []string{"project:payments", "schematics:ws"}
+	tags = RemoveReplacedTags(meta, NewStringSet(ResourceIBMVPCHash, attached), NewStringSet(ResourceIBMVPCHash, nil), "access")
+	if got := ExpandStringList(tags.List()); !reflect.DeepEqual(sortedTags(got), sortedTags(attached)) {
+		t.Errorf("Expected access tags %v, got %v", attached, got)
+	}
+}
@@ -47,6 +47,24 @@ func SuppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
 	return reflect.DeepEqual(oldm, newm)
 }
 
+// SuppressEquivalentJSONValue suppresses the diff of two JSON documents that only differ in
+// formatting or key order, unlike SuppressEquivalentJSON it accepts any JSON value.
+func SuppressEquivalentJSONValue(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	var oldObj, newObj interface{}
+	if err := json.Unmarshal([]byte(old), &oldObj); err != nil {
+		log.Printf("Error unmarshalling old json :: %s", err.Error())
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newObj); err != nil {
+		log.Printf("Error unmarshalling new json :: %s", err.Error())
+		return false
+	}
+	return reflect.DeepEqual(oldObj, newObj)
+}
+
 func SuppressHashedRawSecret(k, old, new string, d *schema.ResourceData) bool {
 	if len(d.Id()) == 0 {
 		return false
@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import "testing"
+
+func TestSuppressEquivalentJSONValue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{"same object", `{"a":1,"b":"x"}`, `{"a":1,"b":"x"}`, true},
+		{"key order and formatting", `{"a":1,"b":{"c":[1,2]}}`, "{\n  \"b\": {\"c\": [1, 2]},\n  \"a\": 1\n}", true},
+		{"different value", `{"a":1}`, `{"a":2}`, false},
+		{"different list order", `[1,2]`, `[2,1]`, false},
+		{"scalar values", `"x"`, ` "x" `, true},
+		{"empty old value", ``, `{}`, false},
+		{"invalid JSON", `{"a":1}`, `{"a":`, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := SuppressEquivalentJSONValue("parameters_json", tc.old, tc.new, nil); got != tc.expected {
+				t.Fatalf("Expected %t for %s and %s, got %t", tc.expected, tc.old, tc.new, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Classes of the errors of the IBM Cloud APIs
+const (
+	ErrorClassAuth       = "auth"
+	ErrorClassQuota      = "quota"
+	ErrorClassValidation = "validation"
+	ErrorClassNotFound   = "not_found"
+	ErrorClassEndpoint   = "endpoint"
+	ErrorClassConflict   = "conflict"
+	ErrorClassService    = "service"
+	ErrorClassUnknown    = "unknown"
+)
+
+const providerDocsURL = "https://registry.terraform.io/providers/IBM-Cloud/ibm/latest/docs"
+
+var errorClassHints = map[string]string{
+	ErrorClassAuth: "Check that the API key or the access token of the provider is valid, and that its identity " +
+		"has the IAM access to the resource in the account of the resource.",
+	ErrorClassQuota: "The request exceeded a quota or a rate limit of the service. Apply again later, reduce the " +
+		"parallelism of Terraform or set service_concurrency or requests_per_second in the provider, " +
+		"or request a quota increase.",
+	ErrorClassValidation: "The service rejected the arguments of the request. Check the arguments of the resource " +
+		"against the documentation of the resource.",
+	ErrorClassNotFound: "Check that the resource exists in the region and in the account of the provider, " +
+		"it may have been deleted outside of Terraform.",
+	ErrorClassEndpoint: "The endpoint of the service does not serve the API of the request, the provider may target " +
+		"the wrong endpoint. Check the region and the visibility of the provider, the endpoint_type of the resource " +
+		"and the endpoints of the provider. The resource is kept in the state.",
+	ErrorClassConflict: "The resource is in a state that conflicts with the request, such as an operation that " +
+		"is in progress. Wait for the resource to be ready and apply again.",
+	ErrorClassService: "The service failed to process the request. Apply again later, and open a support case " +
+		"with the trace ID if the error persists.",
+}
+
+var errorClassDocs = map[string]string{
+	ErrorClassAuth: "https://cloud.ibm.com/docs/account?topic=account-iamoverview",
+}
+
+// ServiceError is the error of a failed API call of an IBM Cloud SDK service client
+type ServiceError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	TraceID    string
+	MoreInfo   string
+	Class      string
+}
+
+// NewServiceError extracts the error code, the message and the trace ID of a failed API call from
+// its error and its response, and classifies the error.
+func NewServiceError(err error, response *core.DetailedResponse) ServiceError {
+	serviceErr := ServiceError{}
+	if err != nil {
+		serviceErr.Message = err.Error()
+	}
+	if response != nil {
+		serviceErr.StatusCode = response.StatusCode
+		for _, header := range []string{"X-Correlation-Id", "X-Request-Id", "Transaction-Id"} {
+			if traceID := response.Headers.Get(header); traceID != "" {
+				serviceErr.TraceID = traceID
+				break
+			}
+		}
+		if body, ok := response.Result.(map[string]interface{}); ok {
+			serviceErr.parseBody(body)
+		}
+	}
+	serviceErr.Class = classifyServiceError(serviceErr.StatusCode, serviceErr.Code)
+	if serviceErr.Class == ErrorClassNotFound && !IsResourceNotFound(response) {
+		serviceErr.Class = ErrorClassEndpoint
+	}
+	return serviceErr
+}
+
+// IsResourceNotFound reports whether the response of a failed API call is a 404 of the API of the
+// service, which means that the resource is deleted. A 404 with an HTML page or another body that
+// is not JSON comes from a gateway or a web server that does not serve the API, which happens when
+// the provider targets the wrong endpoint, such as the public endpoint of another service or the
+// endpoint of another region. The Read functions keep the resource in the state in this case, so
+// that Terraform does not plan to recreate all the resources of a service.
+func IsResourceNotFound(response *core.DetailedResponse) bool {
+	if response == nil || response.StatusCode != http.StatusNotFound {
+		return false
+	}
+	if strings.Contains(strings.ToLower(response.Headers.Get("Content-Type")), "text/html") ||
+		(len(response.RawResult) > 0 && !json.Valid(response.RawResult)) {
+		log.Printf("[WARN] The 404 response is not an error of the API, the endpoint may be misconfigured: %s", response.RawResult)
+		return false
+	}
+	return true
+}
+
+// parseBody reads the error code, the message and the trace ID of the error bodies of the APIs,
+// such as {"errors":[{"code":"...","message":"...","more_info":"..."}],"trace":"..."} or
+// {"errorCode":"...","errorMessage":"...","context":{"transactionId":"..."}}
+func (e *ServiceError) parseBody(body map[string]interface{}) {
+	if errs, ok := body["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			body = mergeErrorBody(body, first)
+		}
+	}
+	for _, key := range []string{"code", "errorCode", "error_code"} {
+		if code, ok := body[key].(string); ok && code != "" {
+			e.Code = code
+			break
+		}
+	}
+	for _, key := range []string{"message", "errorMessage", "error", "description"} {
+		if message, ok := body[key].(string); ok && message != "" {
+			e.Message = message
+			break
+		}
+	}
+	if moreInfo, ok := body["more_info"].(string); ok {
+		e.MoreInfo = moreInfo
+	}
+	if e.TraceID == "" {
+		if trace, ok := body["trace"].(string); ok {
+			e.TraceID = trace
+		} else if context, ok := body["context"].(map[string]interface{}); ok {
+			if transactionID, ok := context["transactionId"].(string); ok {
+				e.TraceID = transactionID
+			}
+		}
+	}
+}
+
+// mergeErrorBody returns the first error of the errors of a body, with the fields of the body
+// that the error does not have, such as the trace
+func mergeErrorBody(body, first map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(body)+len(first))
+	for k, v := range body {
+		merged[k] = v
+	}
+	for k, v := range first {
+		merged[k] = v
+	}
+	return merged
+}
+
+func classifyServiceError(statusCode int, code string) string {
+	code = strings.ToLower(code)
+	switch {
+	case statusCode == http.StatusTooManyRequests || strings.Contains(code, "quota") || strings.Contains(code, "limit_exceeded"):
+		return ErrorClassQuota
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return ErrorClassAuth
+	case statusCode == http.StatusNotFound:
+		return ErrorClassNotFound
+	case statusCode == http.StatusConflict || statusCode == http.StatusPreconditionFailed:
+		return ErrorClassConflict
+	case statusCode >= 400 && statusCode < 500:
+		return ErrorClassValidation
+	case statusCode >= 500:
+		return ErrorClassService
+	}
+	return ErrorClassUnknown
+}
+
+// Detail returns the error code, the trace ID, the remediation hint and the documentation link of
+// the error
+func (e ServiceError) Detail() string {
+	var detail strings.Builder
+	if e.StatusCode != 0 {
+		fmt.Fprintf(&detail, "Status code: %d\n", e.StatusCode)
+	}
+	if e.Code != "" {
+		fmt.Fprintf(&detail, "Error code: %s\n", e.Code)
+	}
+	if e.TraceID != "" {
+		fmt.Fprintf(&detail, "Trace ID: %s\n", e.TraceID)
+	}
+	if hint, ok := errorClassHints[e.Class]; ok {
+		fmt.Fprintf(&detail, "\n%s\n", hint)
+	}
+	docURL := e.MoreInfo
+	if docURL == "" {
+		docURL = errorClassDocs[e.Class]
+	}
+	if docURL == "" {
+		docURL = providerDocsURL
+	}
+	fmt.Fprintf(&detail, "\nFor more information, see %s", docURL)
+	return detail.String()
+}
+
+// ServiceErrorDiags returns the diagnostic of a failed API call of an IBM Cloud SDK service client,
+// with the error code, the trace ID and a remediation hint for the class of the error. The full
+// response is logged.
+func ServiceErrorDiags(operation string, err error, response *core.DetailedResponse) diag.Diagnostics {
+	log.Printf("[DEBUG] %s failed %s\n%s", operation, err, response)
+	serviceErr := NewServiceError(err, response)
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s failed: %s", operation, serviceErr.Message),
+			Detail:   serviceErr.Detail(),
+		},
+	}
+}
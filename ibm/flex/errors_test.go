@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestServiceErrorParseBody(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected ServiceError
+	}{
+		{
+			name: "errors",
+			body: `{"errors":[{"code":"not_found","message":"VPC not found.","more_info":"https://cloud.ibm.com/docs/vpc"}],"trace":"4fb2c8c4"}`,
+			expected: ServiceError{
+				Code:     "not_found",
+				Message:  "VPC not found.",
+				MoreInfo: "https://cloud.ibm.com/docs/vpc",
+				TraceID:  "4fb2c8c4",
+			},
+		},
+		{
+			name: "errors without fields",
+			body: `{"errors":["failed"],"code":"bad_request","message":"Bad request."}`,
+			expected: ServiceError{
+				Code:    "bad_request",
+				Message: "Bad request.",
+			},
+		},
+		{
+			name: "errorCode",
+			body: `{"errorCode":"BXNIM0415E","errorMessage":"Provided API key could not be found.","context":{"transactionId":"a9e1b3c2"}}`,
+			expected: ServiceError{
+				Code:    "BXNIM0415E",
+				Message: "Provided API key could not be found.",
+				TraceID: "a9e1b3c2",
+			},
+		},
+		{
+			name: "error",
+			body: `{"error_code":"quota_exceeded","error":"The quota of the account is exceeded."}`,
+			expected: ServiceError{
+				Code:    "quota_exceeded",
+				Message: "The quota of the account is exceeded.",
+			},
+		},
+		{
+			name:     "empty",
+			body:     `{}`,
+			expected: ServiceError{},
+		},
+	}
+	for _, tc := range testCases {
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(tc.body), &body); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		serviceErr := ServiceError{}
+		serviceErr.parseBody(body)
+		if serviceErr != tc.expected {
+			t.Errorf("%s: expected %+v, got %+v", tc.name, tc.expected, serviceErr)
+		}
+	}
+}
+
+func TestIsResourceNotFound(t *testing.T) {
+	jsonHeaders := http.Header{"Content-Type": []string{"application/json"}}
+	htmlHeaders := http.Header{"Content-Type": []string{"text/html; charset=utf-8"}}
+	testCases := []struct {
+		name     string
+		response *core.DetailedResponse
+		expected bool
+	}{
+		{"no response", nil, false},
+		{"not a 404", &core.DetailedResponse{StatusCode: http.StatusForbidden, Headers: jsonHeaders}, false},
+		{"empty body", &core.DetailedResponse{StatusCode: http.StatusNotFound}, true},
+		{"HTML body", &core.DetailedResponse{
+			StatusCode: http.StatusNotFound,
+			Headers:    htmlHeaders,
+			RawResult:  []byte("<html><body><h1>404 Not Found</h1></body></html>"),
+		}, false},
+		{"text body", &core.DetailedResponse{
+			StatusCode: http.StatusNotFound,
+			RawResult:  []byte("404 page not found"),
+		}, false},
+		{"JSON not_found", &core.DetailedResponse{
+			StatusCode: http.StatusNotFound,
+			Headers:    jsonHeaders,
+			Result: map[string]interface{}{
+				"errors": []interface{}{map[string]interface{}{"code": "not_found", "message": "VPC not found."}},
+			},
+		}, true},
+		{"JSON routing_table_route_not_found", &core.DetailedResponse{
+			StatusCode: http.StatusNotFound,
+			Headers:    jsonHeaders,
+			Result: map[string]interface{}{
+				"errors": []interface{}{map[string]interface{}{"code": "routing_table_route_not_found", "message": "Route not found."}},
+			},
+		}, true},
+		{"JSON raw body", &core.DetailedResponse{
+			StatusCode: http.StatusNotFound,
+			RawResult:  []byte(`{"code":"not_found","message":"Route not found."}`),
+		}, true},
+	}
+	for _, tc := range testCases {
+		if got := IsResourceNotFound(tc.response); got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.expected, got)
+		}
+	}
+}
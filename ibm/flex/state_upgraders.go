@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StateMigration upgrades the states of a resource from a schema version to the next version
+type StateMigration struct {
+	// Resource is the resource at the schema version that the migration upgrades from, it is the
+	// resource itself when only the values of the state change, such as the format of the ID.
+	Resource *schema.Resource
+	Upgrade  schema.StateUpgradeFunc
+}
+
+// WithStateMigrations sets the state upgraders of a resource from its migrations, in the order of
+// the schema versions: the first migration upgrades the states of the version 0, the second one
+// the states of the version 1, and so on. The schema version of the resource is the number of its
+// migrations, so a migration is added at the end of the list when the schema changes, and the
+// existing states are upgraded by all the migrations of their version and of the next versions.
+func WithStateMigrations(resource *schema.Resource, migrations ...StateMigration) *schema.Resource {
+	resource.SchemaVersion = len(migrations)
+	resource.StateUpgraders = make([]schema.StateUpgrader, 0, len(migrations))
+	for version, migration := range migrations {
+		resource.StateUpgraders = append(resource.StateUpgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    migration.Resource.CoreConfigSchema().ImpliedType(),
+			Upgrade: migration.Upgrade,
+		})
+	}
+	return resource
+}
+
+// UpgradeCompositeID returns a state upgrade that sets the ID of a resource to the values of its
+// attributes joined with "/", such as <region>/<instance_id>/<secret_id>. The ID is kept when one
+// of the attributes is not in the state.
+func UpgradeCompositeID(attributes ...string) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+		parts := make([]string, 0, len(attributes))
+		for _, attribute := range attributes {
+			value, ok := rawState[attribute].(string)
+			if !ok || value == "" {
+				log.Printf("[WARN] The ID %v is not upgraded, %s is not in the state", rawState["id"], attribute)
+				return rawState, nil
+			}
+			parts = append(parts, value)
+		}
+		rawState["id"] = strings.Join(parts, "/")
+		return rawState, nil
+	}
+}
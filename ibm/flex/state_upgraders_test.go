@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithStateMigrations(t *testing.T) {
+	resourceV0 := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region":    {Type: schema.TypeString, Required: true},
+			"secret_id": {Type: schema.TypeString, Computed: true},
+			"tags":      {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+	WithStateMigrations(resource,
+		StateMigration{Resource: resourceV0, Upgrade: UpgradeCompositeID("region", "secret_id")},
+		StateMigration{Resource: resource, Upgrade: UpgradeCompositeID("region", "secret_id")},
+	)
+
+	if resource.SchemaVersion != 2 || len(resource.StateUpgraders) != 2 {
+		t.Fatalf("Expected the schema version 2 with 2 state upgraders, got %d with %d", resource.SchemaVersion, len(resource.StateUpgraders))
+	}
+	for version, upgrader := range resource.StateUpgraders {
+		if upgrader.Version != version {
+			t.Fatalf("Expected the upgrader %d to upgrade the version %d, got %d", version, version, upgrader.Version)
+		}
+	}
+	if !resource.StateUpgraders[0].Type.Equals(resourceV0.CoreConfigSchema().ImpliedType()) {
+		t.Fatalf("Expected the type of the upgrader 0 to be the type of the version 0")
+	}
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestUpgradeCompositeID(t *testing.T) {
+	upgrade := UpgradeCompositeID("region", "instance_id", "secret_id")
+
+	state, err := upgrade(context.Background(), map[string]interface{}{
+		"id":          "1234",
+		"region":      "us-south",
+		"instance_id": "abcd",
+		"secret_id":   "1234",
+	}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if state["id"] != "us-south/abcd/1234" {
+		t.Fatalf("Expected the ID us-south/abcd/1234, got %v", state["id"])
+	}
+
+	// The ID is kept when an attribute is missing
+	state, err = upgrade(context.Background(), map[string]interface{}{
+		"id":        "1234",
+		"region":    "us-south",
+		"secret_id": "1234",
+	}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if state["id"] != "1234" {
+		t.Fatalf("Expected the ID to be kept, got %v", state["id"])
+	}
+}
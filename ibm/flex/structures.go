@@ -2056,7 +2056,7 @@ func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType s
 	if err != nil {
 		return nil, err
 	}
-	return taggingResult, nil
+	return removeDefaultTags(meta, taggingResult, tagType), nil
 }
 
 func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tagType string) (*schema.Set, error) {
@@ -2153,6 +2153,23 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 		}
 	}
 
+	defaults, overridden := splitDefaultTags(meta, news, tagType)
+	add = append(add, defaults...)
+
+	if len(overridden) > 0 {
+		detachTagOptions := &globaltaggingv1.DetachTagOptions{}
+		detachTagOptions.Resources = resources
+		detachTagOptions.TagNames = overridden
+		if len(tagType) > 0 {
+			detachTagOptions.TagType = PtrToString(tagType)
+		}
+
+		_, resp, err := gtClient.DetachTag(detachTagOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error detaching overridden default tags %v: %s\n%s", overridden, err, resp)
+		}
+	}
+
 	if len(remove) > 0 {
 		detachTagOptions := &globaltaggingv1.DetachTagOptions{}
 		detachTagOptions.Resources = resources
@@ -2219,7 +2236,7 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	if err != nil {
 		return nil, err
 	}
-	return taggingResult, nil
+	return removeDefaultTags(meta, taggingResult, "user"), nil
 }
 
 func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN string) error {
@@ -2253,6 +2270,16 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		add = append(add, envTags...)
 	}
 
+	defaults, overridden := splitDefaultTags(meta, news, "user")
+	add = append(add, defaults...)
+
+	if len(overridden) > 0 {
+		_, err := gtClient.Tags().DetachTags(resourceCRN, overridden)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error detaching overridden default tags %v: %s", overridden, err)
+		}
+	}
+
 	if len(remove) > 0 {
 		_, err := gtClient.Tags().DetachTags(resourceCRN, remove)
 		if err != nil {
@@ -2276,6 +2303,179 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 	return nil
 }
 
+// HasDefaultTags returns whether the provider configuration has default tags of the tag type,
+// which are attached to resources even when they have no tags.
+func HasDefaultTags(meta interface{}, tagType string) bool {
+	return len(getDefaultTags(meta, tagType)) > 0
+}
+
+func getDefaultTags(meta interface{}, tagType string) []string {
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	switch strings.TrimSpace(tagType) {
+	case "", "user":
+		return session.DefaultTags()
+	case "access":
+		return session.DefaultAccessTags()
+	}
+	return nil
+}
+
+// tagKey returns the key of a key:value tag, or the whole tag when it has no value.
+func tagKey(tag string) string {
+	key := strings.SplitN(tag, ":", 2)[0]
+	return strings.ToLower(strings.TrimSpace(key))
+}
+
+// splitDefaultTags returns the default tags that are attached to a resource with the given tags,
+// and the default tags that the tags of the resource override with the same key.
+func splitDefaultTags(meta interface{}, tags *schema.Set, tagType string) (defaults, overridden []string) {
+	keys := make(map[string]bool)
+	for _, tag := range tags.List() {
+		keys[tagKey(fmt.Sprint(tag))] = true
+	}
+	for _, tag := range getDefaultTags(meta, tagType) {
+		if !keys[tagKey(tag)] {
+			defaults = append(defaults, tag)
+		} else if !tags.Contains(tag) {
+			overridden = append(overridden, tag)
+		}
+	}
+	return defaults, overridden
+}
+
+// removeDefaultTags removes the default tags of the provider configuration from the tags that
+// are attached to a resource, so that they don't show as a difference of the resource tags. The
+// default tags that the resource configures are kept.
+func removeDefaultTags(meta interface{}, tags *schema.Set, tagType string) *schema.Set {
+	configured := configuredTags(meta, tagType)
+	for _, tag := range getDefaultTags(meta, tagType) {
+		if configured == nil || !configured.Contains(tag) {
+			tags.Remove(tag)
+		}
+	}
+	return tags
+}
+
+// globalTaggingBatchSize is the maximum number of tag names of an attach or detach request
+const globalTaggingBatchSize = 100
+
+// AttachGlobalTags attaches tags of the tag type to resources, in batches of tag names.
+func AttachGlobalTags(meta interface{}, resources []globaltaggingv1.Resource, tagNames []string, tagType string) error {
+	return batchGlobalTags(meta, resources, tagNames, tagType, true)
+}
+
+// DetachGlobalTags detaches tags of the tag type from resources, in batches of tag names. The
+// tags are not deleted from the account.
+func DetachGlobalTags(meta interface{}, resources []globaltaggingv1.Resource, tagNames []string, tagType string) error {
+	return batchGlobalTags(meta, resources, tagNames, tagType, false)
+}
+
+func batchGlobalTags(meta interface{}, resources []globaltaggingv1.Resource, tagNames []string, tagType string, attach bool) error {
+	if len(tagNames) == 0 {
+		return nil
+	}
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+	var accountID *string
+	if tagType == "service" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		accountID = PtrToString(userDetails.UserAccount)
+	}
+	var tType *string
+	if len(tagType) > 0 {
+		tType = PtrToString(tagType)
+	}
+
+	for start := 0; start < len(tagNames); start += globalTaggingBatchSize {
+		end := start + globalTaggingBatchSize
+		if end > len(tagNames) {
+			end = len(tagNames)
+		}
+		batch := tagNames[start:end]
+		if attach {
+			_, resp, err := gtClient.AttachTag(&globaltaggingv1.AttachTagOptions{
+				Resources: resources,
+				TagNames:  batch,
+				TagType:   tType,
+				AccountID: accountID,
+			})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error attaching tags %v: %s\n%s", batch, err, resp)
+			}
+		} else {
+			_, resp, err := gtClient.DetachTag(&globaltaggingv1.DetachTagOptions{
+				Resources: resources,
+				TagNames:  batch,
+				TagType:   tType,
+				AccountID: accountID,
+			})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error detaching tags %v: %s\n%s", batch, err, resp)
+			}
+		}
+	}
+	return nil
+}
+
+// ReplaceGlobalTagsUsingCRN makes the tags of the tag type of a resource exactly the given tags,
+// with the environment tags and the default tags of the provider. The tags that are attached to
+// the resource and not in the given tags are detached, whoever attached them.
+func ReplaceGlobalTagsUsingCRN(meta interface{}, tags *schema.Set, resourceID, resourceType, tagType string) error {
+	current, err := GetGlobalTagsUsingSearchAPI(meta, resourceID, resourceType, tagType)
+	if err != nil {
+		return err
+	}
+
+	desired := NewStringSet(ResourceIBMVPCHash, ExpandStringList(tags.List()))
+	if strings.TrimSpace(tagType) == "" || tagType == "user" {
+		if schematicTags := os.Getenv("IC_ENV_TAGS"); schematicTags != "" {
+			for _, tag := range strings.Split(schematicTags, ",") {
+				desired.Add(tag)
+			}
+		}
+	}
+	defaults, _ := splitDefaultTags(meta, tags, tagType)
+	for _, tag := range defaults {
+		desired.Add(tag)
+	}
+
+	resources := []globaltaggingv1.Resource{
+		{ResourceID: PtrToString(resourceID), ResourceType: PtrToString(resourceType)},
+	}
+	if err := DetachGlobalTags(meta, resources, ExpandStringList(current.Difference(desired).List()), tagType); err != nil {
+		return err
+	}
+	return AttachGlobalTags(meta, resources, ExpandStringList(desired.Difference(current).List()), tagType)
+}
+
+// RemoveReplacedTags removes the environment tags and the default tags that
+// ReplaceGlobalTagsUsingCRN attaches with the given tags from the tags that are read from the
+// resource, so that they don't show as a difference of the given tags.
+func RemoveReplacedTags(meta interface{}, tags, configured *schema.Set, tagType string) *schema.Set {
+	if strings.TrimSpace(tagType) == "" || tagType == "user" {
+		if schematicTags := os.Getenv("IC_ENV_TAGS"); schematicTags != "" {
+			for _, tag := range strings.Split(schematicTags, ",") {
+				if !configured.Contains(tag) {
+					tags.Remove(tag)
+				}
+			}
+		}
+	}
+	defaults, _ := splitDefaultTags(meta, configured, tagType)
+	for _, tag := range defaults {
+		tags.Remove(tag)
+	}
+	return tags
+}
+
 func GetBaseController(meta interface{}) (string, error) {
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
@@ -4,13 +4,18 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -50,6 +55,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/schematics"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/transitgateway"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/usagereports"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
@@ -160,6 +166,31 @@ func Provider() *schema.Provider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"max_retry_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum wait time (in seconds) between the retries of API calls. It is also the fixed wait time between the retries of the bluemix-go and Classic Infrastructure API calls.",
+				DefaultFunc:  schema.EnvDefaultFunc("MAX_RETRY_INTERVAL", 5),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry_min_delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The minimum wait time (in seconds) between the retries of API calls.",
+				DefaultFunc: schema.EnvDefaultFunc("RETRY_MIN_DELAY", 1),
+			},
+			"requests_per_second": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum number of API requests per second of all the service clients. The requests are not limited when it is 0.",
+				DefaultFunc: schema.EnvDefaultFunc("REQUESTS_PER_SECOND", 0),
+			},
+			"api_call_summary": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log a summary of the API calls, the retries and the cumulative latency of each service endpoint at the end of the run",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_API_CALL_SUMMARY", "IBMCLOUD_API_CALL_SUMMARY"}, false),
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -187,6 +218,30 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of a profile of the IBM credentials file, with the API key, the region, the zone and the resource group of the provider",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PROFILE", "IBMCLOUD_PROFILE"}, nil),
+			},
+			"assume_profile_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID or the CRN of a trusted profile that is assumed with the IBM Cloud API key for all the API calls",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ASSUME_PROFILE_ID", "IBMCLOUD_ASSUME_PROFILE_ID"}, nil),
+			},
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A command that prints the IAM API key or access token as a JSON object, used when no other credentials are set",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CREDENTIAL_PROCESS", "IBMCLOUD_CREDENTIAL_PROCESS"}, nil),
+			},
+			"iam_cr_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The file of the compute resource token that is used with iam_profile_id to authenticate as the trusted profile",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_CR_TOKEN_FILE", "IBMCLOUD_IAM_CR_TOKEN_FILE"}, nil),
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -206,12 +261,56 @@ func Provider() *schema.Provider {
 				Description:  "Visibility of the provider if it is private or public.",
 				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public"),
 			},
+			"private_endpoints_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Refuse the requests to the endpoints that are not private, instead of falling back to public endpoints",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PRIVATE_ENDPOINTS_ONLY", "IBMCLOUD_PRIVATE_ENDPOINTS_ONLY"}, false),
+			},
 			"endpoints_file_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The endpoints of the services, they override the endpoints file",
+				Elem:        providerEndpointsSchema(),
+			},
+			"service_concurrency": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The maximum number of concurrent API requests of the services",
+				Elem:        providerServiceConcurrencySchema(),
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags that are attached to every resource that supports Global Tagging",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "User tags that are attached to every resource, a tag of the resource with the same key overrides them",
+						},
+						"access_tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Access tags that are attached to every resource, an access tag of the resource with the same key overrides them",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -630,6 +729,10 @@ func Provider() *schema.Provider {
 			"ibm_enterprise_account_groups": enterprise.DataSourceIBMEnterpriseAccountGroups(),
 			"ibm_enterprise_accounts":       enterprise.DataSourceIBMEnterpriseAccounts(),
 
+			// //Added for Usage Reports
+			"ibm_billing_account_usage":        usagereports.DataSourceIBMBillingAccountUsage(),
+			"ibm_billing_resource_group_usage": usagereports.DataSourceIBMBillingResourceGroupUsage(),
+
 			// //Added for Secrets Manager
 			// V1 data sources:
 			"ibm_secrets_manager_secrets": secretsmanager.DataSourceIBMSecretsManagerSecrets(),
@@ -646,10 +749,16 @@ func Provider() *schema.Provider {
 			"ibm_sm_public_certificate_configuration_dns_classic_infrastructure": secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructure()),
 			"ibm_sm_iam_credentials_configuration":                               secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmIamCredentialsConfiguration()),
 			"ibm_sm_configurations":                                              secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmConfigurations()),
+			"ibm_sm_secret":                                                      secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecret()),
+			"ibm_sm_secret_version":                                              secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretVersion()),
+			"ibm_sm_secret_versions":                                             secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretVersions()),
+			"ibm_sm_secret_locks":                                                secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretLocks()),
 			"ibm_sm_secrets":                                                     secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecrets()),
+			"ibm_sm_secrets_by_labels":                                           secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretsByLabels()),
 			"ibm_sm_arbitrary_secret_metadata":                                   secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmArbitrarySecretMetadata()),
 			"ibm_sm_imported_certificate_metadata":                               secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmImportedCertificateMetadata()),
 			"ibm_sm_public_certificate_metadata":                                 secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPublicCertificateMetadata()),
+			"ibm_sm_public_certificate_challenges":                               secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPublicCertificateChallenges()),
 			"ibm_sm_private_certificate_metadata":                                secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPrivateCertificateMetadata()),
 			"ibm_sm_iam_credentials_secret_metadata":                             secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmIamCredentialsSecretMetadata()),
 			"ibm_sm_kv_secret_metadata":                                          secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmKvSecretMetadata()),
@@ -696,19 +805,20 @@ func Provider() *schema.Provider {
 			"ibm_scc_account_notification_settings": scc.DataSourceIBMSccNotificationSettings(),
 
 			// Compliance Posture Management
-			"ibm_scc_posture_scopes":            scc.DataSourceIBMSccPostureScopes(),
-			"ibm_scc_posture_latest_scans":      scc.DataSourceIBMSccPostureLatestScans(),
-			"ibm_scc_posture_profiles":          scc.DataSourceIBMSccPostureProfiles(),
-			"ibm_scc_posture_scan_summary":      scc.DataSourceIBMSccPostureScansSummary(),
-			"ibm_scc_posture_scan_summaries":    scc.DataSourceIBMSccPostureScanSummaries(),
-			"ibm_scc_posture_profile":           scc.DataSourceIBMSccPostureProfileDetails(),
-			"ibm_scc_posture_group_profile":     scc.DataSourceIBMSccPostureGroupProfileDetails(),
-			"ibm_scc_posture_scope_correlation": scc.DataSourceIBMSccPostureScopeCorrelation(),
-			"ibm_scc_posture_credential":        scc.DataSourceIBMSccPostureCredential(),
-			"ibm_scc_posture_collector":         scc.DataSourceIBMSccPostureCollector(),
-			"ibm_scc_posture_scope":             scc.DataSourceIBMSccPostureScope(),
-			"ibm_scc_posture_credentials":       scc.DataSourceIBMSccPostureCredentials(),
-			"ibm_scc_posture_collectors":        scc.DataSourceIBMSccPostureCollectors(),
+			"ibm_scc_posture_scopes":             scc.DataSourceIBMSccPostureScopes(),
+			"ibm_scc_posture_latest_scans":       scc.DataSourceIBMSccPostureLatestScans(),
+			"ibm_scc_posture_latest_scan_result": scc.DataSourceIBMSccPostureLatestScanResult(),
+			"ibm_scc_posture_profiles":           scc.DataSourceIBMSccPostureProfiles(),
+			"ibm_scc_posture_scan_summary":       scc.DataSourceIBMSccPostureScansSummary(),
+			"ibm_scc_posture_scan_summaries":     scc.DataSourceIBMSccPostureScanSummaries(),
+			"ibm_scc_posture_profile":            scc.DataSourceIBMSccPostureProfileDetails(),
+			"ibm_scc_posture_group_profile":      scc.DataSourceIBMSccPostureGroupProfileDetails(),
+			"ibm_scc_posture_scope_correlation":  scc.DataSourceIBMSccPostureScopeCorrelation(),
+			"ibm_scc_posture_credential":         scc.DataSourceIBMSccPostureCredential(),
+			"ibm_scc_posture_collector":          scc.DataSourceIBMSccPostureCollector(),
+			"ibm_scc_posture_scope":              scc.DataSourceIBMSccPostureScope(),
+			"ibm_scc_posture_credentials":        scc.DataSourceIBMSccPostureCredentials(),
+			"ibm_scc_posture_collectors":         scc.DataSourceIBMSccPostureCollectors(),
 			// // Added for Context Based Restrictions
 			"ibm_cbr_zone": contextbasedrestrictions.DataSourceIBMCbrZone(),
 			"ibm_cbr_rule": contextbasedrestrictions.DataSourceIBMCbrRule(),
@@ -814,8 +924,8 @@ func Provider() *schema.Provider {
 			"ibm_function_rule":                         functions.ResourceIBMFunctionRule(),
 			"ibm_function_trigger":                      functions.ResourceIBMFunctionTrigger(),
 			"ibm_function_namespace":                    functions.ResourceIBMFunctionNamespace(),
-			"ibm_cis":                                   cis.ResourceIBMCISInstance(),
-			"ibm_database":                              database.ResourceIBMDatabaseInstance(),
+			"ibm_cis":                                   flex.WithDefaultTags(cis.ResourceIBMCISInstance()),
+			"ibm_database":                              flex.WithDefaultTags(database.ResourceIBMDatabaseInstance()),
 			"ibm_certificate_manager_import":            certificatemanager.ResourceIBMCertificateManagerImport(),
 			"ibm_certificate_manager_order":             certificatemanager.ResourceIBMCertificateManagerOrder(),
 			"ibm_cis_domain":                            cis.ResourceIBMCISDomain(),
@@ -850,6 +960,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_firewall_rule":                     cis.ResourceIBMCISFirewallrules(),
 			"ibm_cloudant":                              cloudant.ResourceIBMCloudant(),
 			"ibm_cloudant_database":                     cloudant.ResourceIBMCloudantDatabase(),
+			"ibm_cloudant_replication":                  cloudant.ResourceIBMCloudantReplication(),
 			"ibm_cloud_shell_account_settings":          cloudshell.ResourceIBMCloudShellAccountSettings(),
 			"ibm_compute_autoscale_group":               classicinfrastructure.ResourceIBMComputeAutoScaleGroup(),
 			"ibm_compute_autoscale_policy":              classicinfrastructure.ResourceIBMComputeAutoScalePolicy(),
@@ -871,9 +982,9 @@ func Provider() *schema.Provider {
 			"ibm_container_vpc_alb_create":              kubernetes.ResourceIBMContainerVpcAlbCreateNew(),
 			"ibm_container_vpc_worker_pool":             kubernetes.ResourceIBMContainerVpcWorkerPool(),
 			"ibm_container_vpc_worker":                  kubernetes.ResourceIBMContainerVpcWorker(),
-			"ibm_container_vpc_cluster":                 kubernetes.ResourceIBMContainerVpcCluster(),
+			"ibm_container_vpc_cluster":                 flex.WithDefaultTags(kubernetes.ResourceIBMContainerVpcCluster()),
 			"ibm_container_alb_cert":                    kubernetes.ResourceIBMContainerALBCert(),
-			"ibm_container_cluster":                     kubernetes.ResourceIBMContainerCluster(),
+			"ibm_container_cluster":                     flex.WithDefaultTags(kubernetes.ResourceIBMContainerCluster()),
 			"ibm_container_cluster_feature":             kubernetes.ResourceIBMContainerClusterFeature(),
 			"ibm_container_bind_service":                kubernetes.ResourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                 kubernetes.ResourceIBMContainerWorkerPool(),
@@ -897,7 +1008,7 @@ func Provider() *schema.Provider {
 			"ibm_event_streams_schema":                  eventstreams.ResourceIBMEventStreamsSchema(),
 			"ibm_firewall":                              classicinfrastructure.ResourceIBMFirewall(),
 			"ibm_firewall_policy":                       classicinfrastructure.ResourceIBMFirewallPolicy(),
-			"ibm_hpcs":                                  hpcs.ResourceIBMHPCS(),
+			"ibm_hpcs":                                  flex.WithDefaultTags(hpcs.ResourceIBMHPCS()),
 			"ibm_hpcs_managed_key":                      hpcs.ResourceIbmManagedKey(),
 			"ibm_hpcs_key_template":                     hpcs.ResourceIbmKeyTemplate(),
 			"ibm_hpcs_keystore":                         hpcs.ResourceIbmKeystore(),
@@ -933,62 +1044,62 @@ func Provider() *schema.Provider {
 			"ibm_is_bare_metal_server_network_interface_allow_float": vpc.ResourceIBMIsBareMetalServerNetworkInterfaceAllowFloat(),
 			"ibm_is_bare_metal_server_network_interface_floating_ip": vpc.ResourceIBMIsBareMetalServerNetworkInterfaceFloatingIp(),
 			"ibm_is_bare_metal_server_network_interface":             vpc.ResourceIBMIsBareMetalServerNetworkInterface(),
-			"ibm_is_bare_metal_server":                               vpc.ResourceIBMIsBareMetalServer(),
+			"ibm_is_bare_metal_server":                               flex.WithDefaultTags(vpc.ResourceIBMIsBareMetalServer()),
 
-			"ibm_is_dedicated_host":                              vpc.ResourceIbmIsDedicatedHost(),
+			"ibm_is_dedicated_host":                              flex.WithDefaultTags(vpc.ResourceIbmIsDedicatedHost()),
 			"ibm_is_dedicated_host_group":                        vpc.ResourceIbmIsDedicatedHostGroup(),
 			"ibm_is_dedicated_host_disk_management":              vpc.ResourceIBMISDedicatedHostDiskManagement(),
-			"ibm_is_placement_group":                             vpc.ResourceIbmIsPlacementGroup(),
-			"ibm_is_floating_ip":                                 vpc.ResourceIBMISFloatingIP(),
-			"ibm_is_flow_log":                                    vpc.ResourceIBMISFlowLog(),
-			"ibm_is_instance":                                    vpc.ResourceIBMISInstance(),
+			"ibm_is_placement_group":                             flex.WithDefaultTags(vpc.ResourceIbmIsPlacementGroup()),
+			"ibm_is_floating_ip":                                 flex.WithDefaultTags(vpc.ResourceIBMISFloatingIP()),
+			"ibm_is_flow_log":                                    flex.WithDefaultTags(vpc.ResourceIBMISFlowLog()),
+			"ibm_is_instance":                                    flex.WithDefaultTags(vpc.ResourceIBMISInstance()),
 			"ibm_is_instance_action":                             vpc.ResourceIBMISInstanceAction(),
 			"ibm_is_instance_network_interface":                  vpc.ResourceIBMIsInstanceNetworkInterface(),
 			"ibm_is_instance_network_interface_floating_ip":      vpc.ResourceIBMIsInstanceNetworkInterfaceFloatingIp(),
 			"ibm_is_instance_disk_management":                    vpc.ResourceIBMISInstanceDiskManagement(),
-			"ibm_is_instance_group":                              vpc.ResourceIBMISInstanceGroup(),
+			"ibm_is_instance_group":                              flex.WithDefaultTags(vpc.ResourceIBMISInstanceGroup()),
 			"ibm_is_instance_group_membership":                   vpc.ResourceIBMISInstanceGroupMembership(),
 			"ibm_is_instance_group_manager":                      vpc.ResourceIBMISInstanceGroupManager(),
 			"ibm_is_instance_group_manager_policy":               vpc.ResourceIBMISInstanceGroupManagerPolicy(),
 			"ibm_is_instance_group_manager_action":               vpc.ResourceIBMISInstanceGroupManagerAction(),
 			"ibm_is_instance_volume_attachment":                  vpc.ResourceIBMISInstanceVolumeAttachment(),
-			"ibm_is_virtual_endpoint_gateway":                    vpc.ResourceIBMISEndpointGateway(),
+			"ibm_is_virtual_endpoint_gateway":                    flex.WithDefaultTags(vpc.ResourceIBMISEndpointGateway()),
 			"ibm_is_virtual_endpoint_gateway_ip":                 vpc.ResourceIBMISEndpointGatewayIP(),
 			"ibm_is_instance_template":                           vpc.ResourceIBMISInstanceTemplate(),
 			"ibm_is_ike_policy":                                  vpc.ResourceIBMISIKEPolicy(),
 			"ibm_is_ipsec_policy":                                vpc.ResourceIBMISIPSecPolicy(),
-			"ibm_is_lb":                                          vpc.ResourceIBMISLB(),
+			"ibm_is_lb":                                          flex.WithDefaultTags(vpc.ResourceIBMISLB()),
 			"ibm_is_lb_listener":                                 vpc.ResourceIBMISLBListener(),
 			"ibm_is_lb_listener_policy":                          vpc.ResourceIBMISLBListenerPolicy(),
 			"ibm_is_lb_listener_policy_rule":                     vpc.ResourceIBMISLBListenerPolicyRule(),
 			"ibm_is_lb_pool":                                     vpc.ResourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                              vpc.ResourceIBMISLBPoolMember(),
-			"ibm_is_network_acl":                                 vpc.ResourceIBMISNetworkACL(),
+			"ibm_is_network_acl":                                 flex.WithDefaultTags(vpc.ResourceIBMISNetworkACL()),
 			"ibm_is_network_acl_rule":                            vpc.ResourceIBMISNetworkACLRule(),
-			"ibm_is_public_gateway":                              vpc.ResourceIBMISPublicGateway(),
-			"ibm_is_security_group":                              vpc.ResourceIBMISSecurityGroup(),
+			"ibm_is_public_gateway":                              flex.WithDefaultTags(vpc.ResourceIBMISPublicGateway()),
+			"ibm_is_security_group":                              flex.WithDefaultTags(vpc.ResourceIBMISSecurityGroup()),
 			"ibm_is_security_group_rule":                         vpc.ResourceIBMISSecurityGroupRule(),
 			"ibm_is_security_group_target":                       vpc.ResourceIBMISSecurityGroupTarget(),
 			"ibm_is_security_group_network_interface_attachment": vpc.ResourceIBMISSecurityGroupNetworkInterfaceAttachment(),
-			"ibm_is_subnet":                                      vpc.ResourceIBMISSubnet(),
+			"ibm_is_subnet":                                      flex.WithDefaultTags(vpc.ResourceIBMISSubnet()),
 			"ibm_is_subnet_reserved_ip":                          vpc.ResourceIBMISReservedIP(),
 			"ibm_is_subnet_network_acl_attachment":               vpc.ResourceIBMISSubnetNetworkACLAttachment(),
 			"ibm_is_subnet_public_gateway_attachment":            vpc.ResourceIBMISSubnetPublicGatewayAttachment(),
 			"ibm_is_subnet_routing_table_attachment":             vpc.ResourceIBMISSubnetRoutingTableAttachment(),
-			"ibm_is_ssh_key":                                     vpc.ResourceIBMISSSHKey(),
-			"ibm_is_snapshot":                                    vpc.ResourceIBMSnapshot(),
-			"ibm_is_volume":                                      vpc.ResourceIBMISVolume(),
-			"ibm_is_vpn_gateway":                                 vpc.ResourceIBMISVPNGateway(),
+			"ibm_is_ssh_key":                                     flex.WithDefaultTags(vpc.ResourceIBMISSSHKey()),
+			"ibm_is_snapshot":                                    flex.WithDefaultTags(vpc.ResourceIBMSnapshot()),
+			"ibm_is_volume":                                      flex.WithDefaultTags(vpc.ResourceIBMISVolume()),
+			"ibm_is_vpn_gateway":                                 flex.WithDefaultTags(vpc.ResourceIBMISVPNGateway()),
 			"ibm_is_vpn_gateway_connection":                      vpc.ResourceIBMISVPNGatewayConnection(),
-			"ibm_is_vpc":                                         vpc.ResourceIBMISVPC(),
+			"ibm_is_vpc":                                         flex.WithDefaultTags(vpc.ResourceIBMISVPC()),
 			"ibm_is_vpc_address_prefix":                          vpc.ResourceIBMISVpcAddressPrefix(),
 			"ibm_is_vpc_route":                                   vpc.ResourceIBMISVpcRoute(),
 			"ibm_is_vpc_routing_table":                           vpc.ResourceIBMISVPCRoutingTable(),
 			"ibm_is_vpc_routing_table_route":                     vpc.ResourceIBMISVPCRoutingTableRoute(),
-			"ibm_is_vpn_server":                                  vpc.ResourceIBMIsVPNServer(),
+			"ibm_is_vpn_server":                                  flex.WithDefaultTags(vpc.ResourceIBMIsVPNServer()),
 			"ibm_is_vpn_server_client":                           vpc.ResourceIBMIsVPNServerClient(),
 			"ibm_is_vpn_server_route":                            vpc.ResourceIBMIsVPNServerRoute(),
-			"ibm_is_image":                                       vpc.ResourceIBMISImage(),
+			"ibm_is_image":                                       flex.WithDefaultTags(vpc.ResourceIBMISImage()),
 			"ibm_lb":                                             classicinfrastructure.ResourceIBMLb(),
 			"ibm_lbaas":                                          classicinfrastructure.ResourceIBMLbaas(),
 			"ibm_lbaas_health_monitor":                           classicinfrastructure.ResourceIBMLbaasHealthMonitor(),
@@ -1015,6 +1126,7 @@ func Provider() *schema.Provider {
 			"ibm_app_config_property":                            appconfiguration.ResourceIBMIbmAppConfigProperty(),
 			"ibm_app_config_segment":                             appconfiguration.ResourceIBMIbmAppConfigSegment(),
 			"ibm_app_config_snapshot":                            appconfiguration.ResourceIBMIbmAppConfigSnapshot(),
+			"ibm_app_config_snapshot_promote":                    appconfiguration.ResourceIBMAppConfigSnapshotPromote(),
 			"ibm_kms_key":                                        kms.ResourceIBMKmskey(),
 			"ibm_kms_key_with_policy_overrides":                  kms.ResourceIBMKmsKeyWithPolicyOverrides(),
 			"ibm_kms_key_alias":                                  kms.ResourceIBMKmskeyAlias(),
@@ -1023,7 +1135,7 @@ func Provider() *schema.Provider {
 			"ibm_kp_key":                                         kms.ResourceIBMkey(),
 			"ibm_kms_instance_policies":                          kms.ResourceIBMKmsInstancePolicy(),
 			"ibm_resource_group":                                 resourcemanager.ResourceIBMResourceGroup(),
-			"ibm_resource_instance":                              resourcecontroller.ResourceIBMResourceInstance(),
+			"ibm_resource_instance":                              flex.WithDefaultTags(resourcecontroller.ResourceIBMResourceInstance()),
 			"ibm_resource_key":                                   resourcecontroller.ResourceIBMResourceKey(),
 			"ibm_security_group":                                 classicinfrastructure.ResourceIBMSecurityGroup(),
 			"ibm_security_group_rule":                            classicinfrastructure.ResourceIBMSecurityGroupRule(),
@@ -1066,6 +1178,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
 			"ibm_pi_spp_placement_group":             power.ResourceIBMPISPPPlacementGroup(),
 			"ibm_pi_shared_processor_pool":           power.ResourceIBMPISharedProcessorPool(),
+			"ibm_pi_workspace":                       power.ResourceIBMPIWorkspace(),
 
 			// //Private DNS related resources
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
@@ -1082,15 +1195,16 @@ func Provider() *schema.Provider {
 			"ibm_dns_custom_resolver_secondary_zone":  dnsservices.ResourceIBMPrivateDNSSecondaryZone(),
 
 			// //Direct Link related resources
-			"ibm_dl_gateway":            directlink.ResourceIBMDLGateway(),
+			"ibm_dl_gateway":            flex.WithDefaultTags(directlink.ResourceIBMDLGateway()),
 			"ibm_dl_virtual_connection": directlink.ResourceIBMDLGatewayVC(),
-			"ibm_dl_provider_gateway":   directlink.ResourceIBMDLProviderGateway(),
+			"ibm_dl_provider_gateway":   flex.WithDefaultTags(directlink.ResourceIBMDLProviderGateway()),
 			"ibm_dl_route_report":       directlink.ResourceIBMDLGatewayRouteReport(),
 			// //Added for Transit Gateway
-			"ibm_tg_gateway":                  transitgateway.ResourceIBMTransitGateway(),
-			"ibm_tg_connection":               transitgateway.ResourceIBMTransitGatewayConnection(),
-			"ibm_tg_connection_prefix_filter": transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilter(),
-			"ibm_tg_route_report":             transitgateway.ResourceIBMTransitGatewayRouteReport(),
+			"ibm_tg_gateway":                   flex.WithDefaultTags(transitgateway.ResourceIBMTransitGateway()),
+			"ibm_tg_connection":                transitgateway.ResourceIBMTransitGatewayConnection(),
+			"ibm_tg_connection_prefix_filter":  transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilter(),
+			"ibm_tg_connection_prefix_filters": transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilters(),
+			"ibm_tg_route_report":              transitgateway.ResourceIBMTransitGatewayRouteReport(),
 
 			// //Catalog related resources
 			"ibm_cm_offering_instance": catalogmanagement.ResourceIBMCmOfferingInstance(),
@@ -1111,6 +1225,8 @@ func Provider() *schema.Provider {
 			"ibm_schematics_job":            schematics.ResourceIBMSchematicsJob(),
 			"ibm_schematics_inventory":      schematics.ResourceIBMSchematicsInventory(),
 			"ibm_schematics_resource_query": schematics.ResourceIBMSchematicsResourceQuery(),
+			"ibm_schematics_agent":          schematics.ResourceIBMSchematicsAgent(),
+			"ibm_schematics_policy":         schematics.ResourceIBMSchematicsPolicy(),
 
 			// //Added for Secrets Manager
 			"ibm_sm_secret_group":                                                secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretGroup()),
@@ -1129,11 +1245,18 @@ func Provider() *schema.Provider {
 			"ibm_sm_private_certificate_configuration_template":                  secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationTemplate()),
 			"ibm_sm_iam_credentials_configuration":                               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmIamCredentialsConfiguration()),
 			"ibm_sm_en_registration":                                             secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmEnRegistration()),
+			"ibm_sm_public_certificate_action_validate_manual_dns":               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificateActionValidateManualDns()),
+			"ibm_sm_private_certificate_configuration_action_sign_csr":           secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationActionSignCsr()),
+			"ibm_sm_private_certificate_configuration_action_set_signed":         secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationActionSetSigned()),
+			"ibm_sm_secret_version_action":                                       secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretVersionAction()),
+			"ibm_sm_secret_lock":                                                 secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretLock()),
+			"ibm_sm_en_registration_action_send_test_event":                      secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmEnRegistrationActionSendTestEvent()),
+			"ibm_sm_secret_rotation":                                             secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretRotation()),
 
 			// //satellite  resources
-			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
+			"ibm_satellite_location":                            flex.WithDefaultTags(satellite.ResourceIBMSatelliteLocation()),
 			"ibm_satellite_host":                                satellite.ResourceIBMSatelliteHost(),
-			"ibm_satellite_cluster":                             satellite.ResourceIBMSatelliteCluster(),
+			"ibm_satellite_cluster":                             flex.WithDefaultTags(satellite.ResourceIBMSatelliteCluster()),
 			"ibm_satellite_cluster_worker_pool":                 satellite.ResourceIBMSatelliteClusterWorkerPool(),
 			"ibm_satellite_link":                                satellite.ResourceIBMSatelliteLink(),
 			"ibm_satellite_endpoint":                            satellite.ResourceIBMSatelliteEndpoint(),
@@ -1241,64 +1364,66 @@ func Validator() validate.ValidatorDict {
 	initOnce.Do(func() {
 		globalValidatorDict = validate.ValidatorDict{
 			ResourceValidatorDictionary: map[string]*validate.ResourceValidator{
-				"ibm_iam_account_settings":        iamidentity.ResourceIBMIAMAccountSettingsValidator(),
-				"ibm_iam_custom_role":             iampolicy.ResourceIBMIAMCustomRoleValidator(),
-				"ibm_cis_healthcheck":             cis.ResourceIBMCISHealthCheckValidator(),
-				"ibm_cis_rate_limit":              cis.ResourceIBMCISRateLimitValidator(),
-				"ibm_cis":                         cis.ResourceIBMCISValidator(),
-				"ibm_cis_domain_settings":         cis.ResourceIBMCISDomainSettingValidator(),
-				"ibm_cis_domain":                  cis.ResourceIBMCISDomainValidator(),
-				"ibm_cis_tls_settings":            cis.ResourceIBMCISTLSSettingsValidator(),
-				"ibm_cis_routing":                 cis.ResourceIBMCISRoutingValidator(),
-				"ibm_cis_page_rule":               cis.ResourceIBMCISPageRuleValidator(),
-				"ibm_cis_waf_package":             cis.ResourceIBMCISWAFPackageValidator(),
-				"ibm_cis_waf_group":               cis.ResourceIBMCISWAFGroupValidator(),
-				"ibm_cis_certificate_upload":      cis.ResourceIBMCISCertificateUploadValidator(),
-				"ibm_cis_cache_settings":          cis.ResourceIBMCISCacheSettingsValidator(),
-				"ibm_cis_custom_page":             cis.ResourceIBMCISCustomPageValidator(),
-				"ibm_cis_firewall":                cis.ResourceIBMCISFirewallValidator(),
-				"ibm_cis_range_app":               cis.ResourceIBMCISRangeAppValidator(),
-				"ibm_cis_waf_rule":                cis.ResourceIBMCISWAFRuleValidator(),
-				"ibm_cis_certificate_order":       cis.ResourceIBMCISCertificateOrderValidator(),
-				"ibm_cis_filter":                  cis.ResourceIBMCISFilterValidator(),
-				"ibm_cis_firewall_rules":          cis.ResourceIBMCISFirewallrulesValidator(),
-				"ibm_cis_webhook":                 cis.ResourceIBMCISWebhooksValidator(),
-				"ibm_cis_alert":                   cis.ResourceIBMCISAlertValidator(),
-				"ibm_cis_dns_record":              cis.ResourceIBMCISDnsRecordValidator(),
-				"ibm_cis_dns_records_import":      cis.ResourceIBMCISDnsRecordsImportValidator(),
-				"ibm_cis_edge_functions_action":   cis.ResourceIBMCISEdgeFunctionsActionValidator(),
-				"ibm_cis_edge_functions_trigger":  cis.ResourceIBMCISEdgeFunctionsTriggerValidator(),
-				"ibm_cis_global_load_balancer":    cis.ResourceIBMCISGlbValidator(),
-				"ibm_cis_logpush_job":             cis.ResourceIBMCISLogPushJobValidator(),
-				"ibm_cis_mtls_app":                cis.ResourceIBMCISMtlsAppValidator(),
-				"ibm_cis_mtls":                    cis.ResourceIBMCISMtlsValidator(),
-				"ibm_cis_origin_auth":             cis.ResourceIBMCISOriginAuthPullValidator(),
-				"ibm_cis_origin_pool":             cis.ResourceIBMCISPoolValidator(),
-				"ibm_container_cluster":           kubernetes.ResourceIBMContainerClusterValidator(),
-				"ibm_container_worker_pool":       kubernetes.ResourceIBMContainerWorkerPoolValidator(),
-				"ibm_container_vpc_worker_pool":   kubernetes.ResourceIBMContainerVPCWorkerPoolValidator(),
-				"ibm_container_vpc_worker":        kubernetes.ResourceIBMContainerVPCWorkerValidator(),
-				"ibm_container_vpc_cluster":       kubernetes.ResourceIBMContainerVpcClusterValidator(),
-				"ibm_cos_bucket":                  cos.ResourceIBMCOSBucketValidator(),
-				"ibm_cr_namespace":                registry.ResourceIBMCrNamespaceValidator(),
-				"ibm_tg_gateway":                  transitgateway.ResourceIBMTGValidator(),
-				"ibm_app_config_feature":          appconfiguration.ResourceIBMAppConfigFeatureValidator(),
-				"ibm_tg_connection":               transitgateway.ResourceIBMTransitGatewayConnectionValidator(),
-				"ibm_tg_connection_prefix_filter": transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilterValidator(),
-				"ibm_dl_virtual_connection":       directlink.ResourceIBMDLGatewayVCValidator(),
-				"ibm_dl_gateway":                  directlink.ResourceIBMDLGatewayValidator(),
-				"ibm_dl_provider_gateway":         directlink.ResourceIBMDLProviderGatewayValidator(),
-				"ibm_database":                    database.ResourceIBMICDValidator(),
-				"ibm_function_package":            functions.ResourceIBMFuncPackageValidator(),
-				"ibm_function_action":             functions.ResourceIBMFuncActionValidator(),
-				"ibm_function_rule":               functions.ResourceIBMFuncRuleValidator(),
-				"ibm_function_trigger":            functions.ResourceIBMFuncTriggerValidator(),
-				"ibm_function_namespace":          functions.ResourceIBMFuncNamespaceValidator(),
-				"ibm_hpcs":                        hpcs.ResourceIBMHPCSValidator(),
-				"ibm_hpcs_managed_key":            hpcs.ResourceIbmManagedKeyValidator(),
-				"ibm_hpcs_keystore":               hpcs.ResourceIbmKeystoreValidator(),
-				"ibm_hpcs_key_template":           hpcs.ResourceIbmKeyTemplateValidator(),
-				"ibm_hpcs_vault":                  hpcs.ResourceIbmVaultValidator(),
+				"ibm_iam_account_settings":         iamidentity.ResourceIBMIAMAccountSettingsValidator(),
+				"ibm_iam_custom_role":              iampolicy.ResourceIBMIAMCustomRoleValidator(),
+				"ibm_cis_healthcheck":              cis.ResourceIBMCISHealthCheckValidator(),
+				"ibm_cis_rate_limit":               cis.ResourceIBMCISRateLimitValidator(),
+				"ibm_cis":                          cis.ResourceIBMCISValidator(),
+				"ibm_cis_domain_settings":          cis.ResourceIBMCISDomainSettingValidator(),
+				"ibm_cis_domain":                   cis.ResourceIBMCISDomainValidator(),
+				"ibm_cis_tls_settings":             cis.ResourceIBMCISTLSSettingsValidator(),
+				"ibm_cis_routing":                  cis.ResourceIBMCISRoutingValidator(),
+				"ibm_cis_page_rule":                cis.ResourceIBMCISPageRuleValidator(),
+				"ibm_cis_waf_package":              cis.ResourceIBMCISWAFPackageValidator(),
+				"ibm_cis_waf_group":                cis.ResourceIBMCISWAFGroupValidator(),
+				"ibm_cis_certificate_upload":       cis.ResourceIBMCISCertificateUploadValidator(),
+				"ibm_cis_cache_settings":           cis.ResourceIBMCISCacheSettingsValidator(),
+				"ibm_cis_custom_page":              cis.ResourceIBMCISCustomPageValidator(),
+				"ibm_cis_firewall":                 cis.ResourceIBMCISFirewallValidator(),
+				"ibm_cis_range_app":                cis.ResourceIBMCISRangeAppValidator(),
+				"ibm_cis_waf_rule":                 cis.ResourceIBMCISWAFRuleValidator(),
+				"ibm_cis_certificate_order":        cis.ResourceIBMCISCertificateOrderValidator(),
+				"ibm_cis_filter":                   cis.ResourceIBMCISFilterValidator(),
+				"ibm_cis_firewall_rules":           cis.ResourceIBMCISFirewallrulesValidator(),
+				"ibm_cis_webhook":                  cis.ResourceIBMCISWebhooksValidator(),
+				"ibm_cis_alert":                    cis.ResourceIBMCISAlertValidator(),
+				"ibm_cis_dns_record":               cis.ResourceIBMCISDnsRecordValidator(),
+				"ibm_cis_dns_records_import":       cis.ResourceIBMCISDnsRecordsImportValidator(),
+				"ibm_cis_edge_functions_action":    cis.ResourceIBMCISEdgeFunctionsActionValidator(),
+				"ibm_cis_edge_functions_trigger":   cis.ResourceIBMCISEdgeFunctionsTriggerValidator(),
+				"ibm_cis_global_load_balancer":     cis.ResourceIBMCISGlbValidator(),
+				"ibm_cis_logpush_job":              cis.ResourceIBMCISLogPushJobValidator(),
+				"ibm_cis_mtls_app":                 cis.ResourceIBMCISMtlsAppValidator(),
+				"ibm_cis_mtls":                     cis.ResourceIBMCISMtlsValidator(),
+				"ibm_cis_origin_auth":              cis.ResourceIBMCISOriginAuthPullValidator(),
+				"ibm_cis_origin_pool":              cis.ResourceIBMCISPoolValidator(),
+				"ibm_container_cluster":            kubernetes.ResourceIBMContainerClusterValidator(),
+				"ibm_container_worker_pool":        kubernetes.ResourceIBMContainerWorkerPoolValidator(),
+				"ibm_container_vpc_worker_pool":    kubernetes.ResourceIBMContainerVPCWorkerPoolValidator(),
+				"ibm_container_vpc_worker":         kubernetes.ResourceIBMContainerVPCWorkerValidator(),
+				"ibm_container_vpc_cluster":        kubernetes.ResourceIBMContainerVpcClusterValidator(),
+				"ibm_cos_bucket":                   cos.ResourceIBMCOSBucketValidator(),
+				"ibm_cr_namespace":                 registry.ResourceIBMCrNamespaceValidator(),
+				"ibm_tg_gateway":                   transitgateway.ResourceIBMTGValidator(),
+				"ibm_app_config_feature":           appconfiguration.ResourceIBMAppConfigFeatureValidator(),
+				"ibm_app_config_property":          appconfiguration.ResourceIBMAppConfigPropertyValidator(),
+				"ibm_tg_connection":                transitgateway.ResourceIBMTransitGatewayConnectionValidator(),
+				"ibm_tg_connection_prefix_filter":  transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilterValidator(),
+				"ibm_tg_connection_prefix_filters": transitgateway.ResourceIBMTransitGatewayConnectionPrefixFiltersValidator(),
+				"ibm_dl_virtual_connection":        directlink.ResourceIBMDLGatewayVCValidator(),
+				"ibm_dl_gateway":                   directlink.ResourceIBMDLGatewayValidator(),
+				"ibm_dl_provider_gateway":          directlink.ResourceIBMDLProviderGatewayValidator(),
+				"ibm_database":                     database.ResourceIBMICDValidator(),
+				"ibm_function_package":             functions.ResourceIBMFuncPackageValidator(),
+				"ibm_function_action":              functions.ResourceIBMFuncActionValidator(),
+				"ibm_function_rule":                functions.ResourceIBMFuncRuleValidator(),
+				"ibm_function_trigger":             functions.ResourceIBMFuncTriggerValidator(),
+				"ibm_function_namespace":           functions.ResourceIBMFuncNamespaceValidator(),
+				"ibm_hpcs":                         hpcs.ResourceIBMHPCSValidator(),
+				"ibm_hpcs_managed_key":             hpcs.ResourceIbmManagedKeyValidator(),
+				"ibm_hpcs_keystore":                hpcs.ResourceIbmKeystoreValidator(),
+				"ibm_hpcs_key_template":            hpcs.ResourceIbmKeyTemplateValidator(),
+				"ibm_hpcs_vault":                   hpcs.ResourceIbmVaultValidator(),
 
 				"ibm_is_backup_policy":      vpc.ResourceIBMIsBackupPolicyValidator(),
 				"ibm_is_backup_policy_plan": vpc.ResourceIBMIsBackupPolicyPlanValidator(),
@@ -1362,6 +1487,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_schematics_workspace":                 schematics.ResourceIBMSchematicsWorkspaceValidator(),
 				"ibm_schematics_inventory":                 schematics.ResourceIBMSchematicsInventoryValidator(),
 				"ibm_schematics_resource_query":            schematics.ResourceIBMSchematicsResourceQueryValidator(),
+				"ibm_schematics_agent":                     schematics.ResourceIBMSchematicsAgentValidator(),
+				"ibm_schematics_policy":                    schematics.ResourceIBMSchematicsPolicyValidator(),
 				"ibm_resource_instance":                    resourcecontroller.ResourceIBMResourceInstanceValidator(),
 				"ibm_resource_key":                         resourcecontroller.ResourceIBMResourceKeyValidator(),
 				"ibm_is_virtual_endpoint_gateway":          vpc.ResourceIBMISEndpointGatewayValidator(),
@@ -1441,8 +1568,10 @@ func Validator() validate.ValidatorDict {
 				// // Added for Secrets Manager
 				"ibm_sm_secret_group":                                                secretsmanager.ResourceIbmSmSecretGroupValidator(),
 				"ibm_sm_en_registration":                                             secretsmanager.ResourceIbmSmEnRegistrationValidator(),
+				"ibm_sm_secret_version_action":                                       secretsmanager.ResourceIbmSmSecretVersionActionValidator(),
 				"ibm_sm_public_certificate_configuration_dns_cis":                    secretsmanager.ResourceIbmSmConfigurationPublicCertificateDNSCisValidator(),
 				"ibm_sm_public_certificate_configuration_dns_classic_infrastructure": secretsmanager.ResourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructureValidator(),
+				"ibm_sm_secret_lock":                                                 secretsmanager.ResourceIbmSmSecretLockValidator(),
 			},
 			DataSourceValidatorDictionary: map[string]*validate.ResourceValidator{
 				"ibm_is_subnet":          vpc.DataSourceIBMISSubnetValidator(),
@@ -1527,10 +1656,41 @@ func Validator() validate.ValidatorDict {
 	return globalValidatorDict
 }
 
+// providerEndpointsSchema returns the schema of the endpoints block, with an endpoint for each
+// service of conns.ServiceEndpointKeys.
+func providerEndpointsSchema() *schema.Resource {
+	endpointsSchema := make(map[string]*schema.Schema)
+	for service := range conns.ServiceEndpointKeys {
+		endpointsSchema[service] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  fmt.Sprintf("The endpoint of the %s service", strings.ReplaceAll(service, "_", " ")),
+		}
+	}
+	// The endpoints of Secrets Manager are per instance
+	endpointsSchema["secrets_manager"].ValidateFunc = validation.StringMatch(regexp.MustCompile(`^https?://.*\{instance_id\}`), "must be a URL with the {instance_id} placeholder")
+	endpointsSchema["secrets_manager"].Description = "The template of the endpoints of the Secrets Manager instances, {instance_id} and {region} are replaced with the instance ID and the region"
+	return &schema.Resource{Schema: endpointsSchema}
+}
+
+func providerServiceConcurrencySchema() *schema.Resource {
+	concurrencySchema := make(map[string]*schema.Schema)
+	for _, service := range conns.ConcurrencyLimitedServices {
+		concurrencySchema[service] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  fmt.Sprintf("The maximum number of concurrent API requests of the %s service", strings.ReplaceAll(service, "_", " ")),
+		}
+	}
+	return &schema.Resource{Schema: concurrencySchema}
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
-	var iamToken, iamRefreshToken, iamTrustedProfileId string
+	var iamToken, iamRefreshToken, iamTrustedProfileId, iamCRTokenFile string
 	if key, ok := d.GetOk("bluemix_api_key"); ok {
 		bluemixAPIKey = key.(string)
 	}
@@ -1546,6 +1706,17 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if ttoken, ok := d.GetOk("iam_profile_id"); ok {
 		iamTrustedProfileId = ttoken.(string)
 	}
+	if crfile, ok := d.GetOk("iam_cr_token_file"); ok {
+		iamCRTokenFile = crfile.(string)
+	}
+	var assumeProfileID string
+	if profile, ok := d.GetOk("assume_profile_id"); ok {
+		assumeProfileID = profile.(string)
+	}
+	var credentialProcess string
+	if process, ok := d.GetOk("credential_process"); ok {
+		credentialProcess = process.(string)
+	}
 	var softlayerUsername, softlayerAPIKey, softlayerEndpointUrl string
 	var softlayerTimeout int
 	if username, ok := d.GetOk("softlayer_username"); ok {
@@ -1588,10 +1759,66 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		file = f.(string)
 	}
 
+	endpoints := make(map[string]string)
+	if v, ok := d.GetOk("endpoints.0"); ok {
+		for service, endpoint := range v.(map[string]interface{}) {
+			if endpoint.(string) != "" {
+				endpoints[conns.ServiceEndpointKeys[service]] = endpoint.(string)
+			}
+		}
+	}
+
+	serviceConcurrency := make(map[string]int)
+	if v, ok := d.GetOk("service_concurrency.0"); ok {
+		for service, concurrency := range v.(map[string]interface{}) {
+			if concurrency.(int) > 0 {
+				serviceConcurrency[service] = concurrency.(int)
+			}
+		}
+	}
+
+	var defaultTags, defaultAccessTags []string
+	if v, ok := d.GetOk("default_tags.0.tags"); ok {
+		defaultTags = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("default_tags.0.access_tags"); ok {
+		defaultAccessTags = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
+
+	if name, ok := d.GetOk("profile"); ok {
+		profile, err := conns.CredentialsProfile(name.(string))
+		if err != nil {
+			return nil, err
+		}
+		// The arguments of the provider and the environment variables have precedence over the profile
+		if bluemixAPIKey == "" && iamToken == "" && iamTrustedProfileId == "" && credentialProcess == "" {
+			bluemixAPIKey = profile[conns.ProfileAPIKey]
+		}
+		if assumeProfileID == "" {
+			assumeProfileID = profile[conns.ProfileAssumeProfileID]
+		}
+		if v := profile[conns.ProfileRegion]; v != "" && region == "us-south" {
+			if env, _ := schema.MultiEnvDefaultFunc([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "")(); env == "" {
+				region = v
+			}
+		}
+		if zone == "" {
+			zone = profile[conns.ProfileZone]
+		}
+		if resourceGrp == "" {
+			resourceGrp = profile[conns.ProfileResourceGroup]
+		}
+	}
 	retryCount := d.Get("max_retries").(int)
+	retryInterval := d.Get("max_retry_interval").(int)
+	retryMinDelay := d.Get("retry_min_delay").(int)
+	privateEndpointsOnly := d.Get("private_endpoints_only").(bool)
+	requestsPerSecond := d.Get("requests_per_second").(int)
+	apiCallSummary := d.Get("api_call_summary").(bool)
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -1614,7 +1841,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		SoftLayerAPIKey:      softlayerAPIKey,
 		RetryCount:           retryCount,
 		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
+		RetryDelay:           time.Duration(retryInterval) * time.Second,
+		RetryMinDelay:        time.Duration(retryMinDelay) * time.Second,
+		RequestsPerSecond:    requestsPerSecond,
+		APICallSummary:       apiCallSummary,
 		FunctionNameSpace:    wskNameSpace,
 		RiaasEndPoint:        riaasEndPoint,
 		IAMToken:             iamToken,
@@ -1622,7 +1852,15 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Zone:                 zone,
 		Visibility:           visibility,
 		EndpointsFile:        file,
+		PrivateEndpointsOnly: privateEndpointsOnly,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		IAMCRTokenFile:       iamCRTokenFile,
+		CredentialProcess:    credentialProcess,
+		AssumeProfileID:      assumeProfileID,
+		Endpoints:            endpoints,
+		ServiceConcurrency:   serviceConcurrency,
+		DefaultTags:          defaultTags,
+		DefaultAccessTags:    defaultAccessTags,
 	}
 
 	return config.ClientSession()
@@ -347,15 +347,7 @@ func dataSourcePropertiesListPropertiesToMap(property appconfigurationv1.Propert
 		propertyMap["type"] = property.Type
 	}
 	if property.Value != nil {
-		value := property.Value
-		switch value.(interface{}).(type) {
-		case string:
-			propertyMap["value"] = value.(string)
-		case float64:
-			propertyMap["value"] = fmt.Sprintf("%v", value)
-		case bool:
-			propertyMap["value"] = strconv.FormatBool(value.(bool))
-		}
+		propertyMap["value"] = appConfigPropertyValueToString(property.Value)
 	}
 	if property.Tags != nil {
 		propertyMap["tags"] = property.Tags
@@ -413,15 +405,7 @@ func dataSourcePropertyListPropertiesSegmentRulesToMap(segmentRulesItem appconfi
 		segmentRulesMap["rules"] = rulesList
 	}
 	if segmentRulesItem.Value != nil {
-		segmentValue := segmentRulesItem.Value
-		switch segmentValue.(interface{}).(type) {
-		case string:
-			segmentRulesMap["value"] = segmentValue.(string)
-		case float64:
-			segmentRulesMap["value"] = fmt.Sprintf("%v", segmentValue)
-		case bool:
-			segmentRulesMap["value"] = strconv.FormatBool(segmentValue.(bool))
-		}
+		segmentRulesMap["value"] = appConfigPropertyValueToString(segmentRulesItem.Value)
 	}
 	if segmentRulesItem.Order != nil {
 		segmentRulesMap["order"] = segmentRulesItem.Order
@@ -2,7 +2,6 @@ package appconfiguration
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -179,15 +178,7 @@ func dataSourceIbmAppConfigPropertyRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 	if property.Value != nil {
-		value := property.Value
-		switch value.(interface{}).(type) {
-		case string:
-			d.Set("value", value.(string))
-		case float64:
-			d.Set("value", fmt.Sprintf("%v", value))
-		case bool:
-			d.Set("value", strconv.FormatBool(value.(bool)))
-		}
+		d.Set("value", appConfigPropertyValueToString(property.Value))
 	}
 	if property.Tags != nil {
 		if err = d.Set("tags", property.Tags); err != nil {
@@ -268,15 +259,7 @@ func dataSourcePropertySegmentRulesToMap(segmentRulesItem appconfigurationv1.Seg
 		segmentRulesMap["rules"] = rulesList
 	}
 	if segmentRulesItem.Value != nil {
-		segmentValue := segmentRulesItem.Value
-		switch segmentValue.(interface{}).(type) {
-		case string:
-			segmentRulesMap["value"] = segmentValue.(string)
-		case float64:
-			segmentRulesMap["value"] = fmt.Sprintf("%v", segmentValue)
-		case bool:
-			segmentRulesMap["value"] = strconv.FormatBool(segmentValue.(bool))
-		}
+		segmentRulesMap["value"] = appConfigPropertyValueToString(segmentRulesItem.Value)
 	}
 	if segmentRulesItem.Order != nil {
 		segmentRulesMap["order"] = segmentRulesItem.Order
@@ -118,7 +118,7 @@ func resourceIbmIbmAppConfigCollectiontRead(d *schema.ResourceData, meta interfa
 
 	result, response, err := appconfigClient.GetCollection(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 		}
 		return fmt.Errorf("[DEBUG] GetCollection failed %s\n%s", err, response)
@@ -279,7 +279,7 @@ func resourceIbmIbmAppConfigCollectionDelete(d *schema.ResourceData, meta interf
 
 	response, err := appconfigClient.DeleteCollection(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
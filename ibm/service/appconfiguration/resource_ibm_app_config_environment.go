@@ -230,7 +230,7 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 	response, err := appconfigClient.DeleteEnvironment(options)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -4,6 +4,7 @@
 package appconfiguration
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -24,6 +26,8 @@ func ResourceIBMIbmAppConfigFeature() *schema.Resource {
 		Delete:   resourceIbmIbmAppConfigFeatureDelete,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIbmAppConfigFeatureValidateSegmentRules,
+
 		Schema: map[string]*schema.Schema{
 			"guid": {
 				Type:        schema.TypeString,
@@ -72,9 +76,11 @@ func ResourceIBMIbmAppConfigFeature() *schema.Resource {
 				Description: "Tags associated with the feature.",
 			},
 			"rollout_percentage": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Rollout percentage of the feature.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(0, 100),
+				Description:  "Rollout percentage of the feature.",
 			},
 			"segment_rules": {
 				Type:        schema.TypeList,
@@ -91,6 +97,7 @@ func ResourceIBMIbmAppConfigFeature() *schema.Resource {
 									"segments": {
 										Type:        schema.TypeList,
 										Required:    true,
+										MinItems:    1,
 										Description: "List of segment ids that are used for targeting using the rule.",
 										Elem:        &schema.Schema{Type: schema.TypeString},
 									},
@@ -103,14 +110,17 @@ func ResourceIBMIbmAppConfigFeature() *schema.Resource {
 							Description: "Value to be used for evaluation for this rule. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
 						},
 						"order": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Order of the rule, used during evaluation. The evaluation is performed in the order defined and the value associated with the first matching rule is used for evaluation.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Order of the rule, used during evaluation. The evaluation is performed in the order defined and the value associated with the first matching rule is used for evaluation.",
 						},
 						"rollout_percentage": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Rollout percentage for the segment rule.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(0, 100),
+							Description:  "Rollout percentage for the segment rule.",
 						},
 					},
 				},
@@ -171,9 +181,7 @@ func resourceIbmIbmAppConfigFeatureCreate(d *schema.ResourceData, meta interface
 	options.SetEnabledValue(d.Get("enabled_value").(string))
 	options.SetEnvironmentID(d.Get("environment_id").(string))
 	options.SetDisabledValue(d.Get("disabled_value").(string))
-	if _, ok := d.GetOk("rollout_percentage"); ok {
-		options.SetRolloutPercentage(int64(d.Get("rollout_percentage").(int)))
-	}
+	options.SetRolloutPercentage(int64(d.Get("rollout_percentage").(int)))
 	if _, ok := d.GetOk("description"); ok {
 		options.SetDescription(d.Get("description").(string))
 	}
@@ -235,9 +243,7 @@ func resourceIbmIbmAppConfigFeatureUpdate(d *schema.ResourceData, meta interface
 		if _, ok := d.GetOk("description"); ok {
 			options.SetDescription(d.Get("description").(string))
 		}
-		if _, ok := d.GetOk("rollout_percentage"); ok {
-			options.SetRolloutPercentage(int64(d.Get("rollout_percentage").(int)))
-		}
+		options.SetRolloutPercentage(int64(d.Get("rollout_percentage").(int)))
 		if _, ok := d.GetOk("tags"); ok {
 			options.SetTags(d.Get("tags").(string))
 		}
@@ -416,7 +422,7 @@ func resourceIbmIbmAppConfigFeatureDelete(d *schema.ResourceData, meta interface
 
 	response, err := appconfigClient.DeleteFeature(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -447,6 +453,27 @@ func ResourceIBMAppConfigFeatureValidator() *validate.ResourceValidator {
 	return &resourceValidator
 }
 
+// resourceIbmAppConfigFeatureValidateSegmentRules rejects segment rules sharing an order, the
+// service evaluates the rules by order so it must identify a single rule
+func resourceIbmAppConfigFeatureValidateSegmentRules(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	orders := map[int]bool{}
+	for _, e := range diff.Get("segment_rules").([]interface{}) {
+		if e == nil {
+			continue
+		}
+		order := e.(map[string]interface{})["order"].(int)
+		if order == 0 {
+			// the order is not known until apply
+			continue
+		}
+		if orders[order] {
+			return fmt.Errorf("[ERROR] 'order' %d is used by more than one rule in 'segment_rules', the order of every rule must be unique", order)
+		}
+		orders[order] = true
+	}
+	return nil
+}
+
 // output
 func resourceIbmAppConfigFeatureSegmentRuleToMap(segmentRule appconfigurationv1.FeatureSegmentRule) map[string]interface{} {
 	segmentRuleMap := map[string]interface{}{}
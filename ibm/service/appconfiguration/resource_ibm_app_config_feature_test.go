@@ -61,6 +61,78 @@ func TestAccIbmIbmAppConfigFeatureBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmIbmAppConfigFeatureSegmentRollout(t *testing.T) {
+	var conf appconfigurationv1.Feature
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	featureID := fmt.Sprintf("tf_feature_id_%d", acctest.RandIntRange(10, 100))
+	segmentID := fmt.Sprintf("tf_segment_id_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmAppConfigFeatureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigFeatureConfigSegmentRollout(instanceName, name, featureID, segmentID, 20, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmAppConfigFeatureExists("ibm_app_config_feature.ibm_app_config_feature_resource1", conf),
+					resource.TestCheckResourceAttr("ibm_app_config_feature.ibm_app_config_feature_resource1", "rollout_percentage", "20"),
+					resource.TestCheckResourceAttr("ibm_app_config_feature.ibm_app_config_feature_resource1", "segment_rules.0.order", "1"),
+					resource.TestCheckResourceAttr("ibm_app_config_feature.ibm_app_config_feature_resource1", "segment_rules.0.rollout_percentage", "50"),
+					resource.TestCheckResourceAttr("ibm_app_config_feature.ibm_app_config_feature_resource1", "segment_rules.0.rules.0.segments.0", segmentID),
+					resource.TestCheckResourceAttr("ibm_app_config_feature.ibm_app_config_feature_resource1", "segment_exists", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIbmAppConfigFeatureConfigSegmentRollout(instanceName, name, featureID, segmentID, 0, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_app_config_feature.ibm_app_config_feature_resource1", "rollout_percentage", "0"),
+					resource.TestCheckResourceAttr("ibm_app_config_feature.ibm_app_config_feature_resource1", "segment_rules.0.rollout_percentage", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigFeatureConfigSegmentRollout(instanceName, name, featureID, segmentID string, rollout, segmentRollout int) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test456" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "enterprise"
+		}
+		resource "ibm_app_config_segment" "ibm_app_config_segment_resource1" {
+			guid       = ibm_resource_instance.app_config_terraform_test456.guid
+			name       = "beta users"
+			segment_id = "%s"
+			rules {
+				attribute_name = "email"
+				operator       = "endsWith"
+				values         = ["@example.com"]
+			}
+		}
+		resource "ibm_app_config_feature" "ibm_app_config_feature_resource1" {
+			guid               = ibm_resource_instance.app_config_terraform_test456.guid
+			name               = "%s"
+			environment_id     = "dev"
+			feature_id         = "%s"
+			type               = "BOOLEAN"
+			enabled_value      = true
+			disabled_value     = false
+			rollout_percentage = %d
+			segment_rules {
+				rules {
+					segments = [ibm_app_config_segment.ibm_app_config_segment_resource1.segment_id]
+				}
+				value              = true
+				order              = 1
+				rollout_percentage = %d
+			}
+		}`, instanceName, segmentID, name, featureID, rollout, segmentRollout)
+}
+
 func testAccCheckIbmAppConfigFeatureConfigBasic(name, envName, featureID, featureType, description, tags string) string {
 	return fmt.Sprintf(`
 		resource "ibm_resource_instance" "app_config_terraform_test456" {
@@ -4,11 +4,17 @@
 package appconfiguration
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"log"
+	"reflect"
 	"strconv"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/ghodss/yaml"
+
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +28,8 @@ func ResourceIBMIbmAppConfigProperty() *schema.Resource {
 		Delete:   resourceIbmIbmAppConfigPropertyDelete,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIbmAppConfigPropertyValidateValues,
+
 		Schema: map[string]*schema.Schema{
 			"guid": {
 				Type:        schema.TypeString,
@@ -44,14 +52,17 @@ func ResourceIBMIbmAppConfigProperty() *schema.Resource {
 				Description: "Property id.",
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Type of the Property  (BOOLEAN, STRING, NUMERIC).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_app_config_property", "type"),
+				Description:  "Type of the Property  (BOOLEAN, STRING, NUMERIC).",
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Value of the Property. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: resourceIbmAppConfigPropertySuppressEquivalentValue,
+				Description:      "Value of the Property. The value can be Boolean, Numeric, String - TEXT, String - JSON or String - YAML as per the `type` and `format` attributes.",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -64,9 +75,12 @@ func ResourceIBMIbmAppConfigProperty() *schema.Resource {
 				Description: "Tags associated with the property.",
 			},
 			"format": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Format of the feature (TEXT, JSON, YAML).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_app_config_property", "format"),
+				Description:  "Format of the property (TEXT, JSON, YAML). Only used when `type` is STRING, defaults to TEXT.",
 			},
 			"segment_rules": {
 				Type:        schema.TypeList,
@@ -90,9 +104,10 @@ func ResourceIBMIbmAppConfigProperty() *schema.Resource {
 							},
 						},
 						"value": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Value to be used for evaluation for this rule. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: resourceIbmAppConfigPropertySuppressEquivalentValue,
+							Description:      "Value to be used for evaluation for this rule. The value can be Boolean, Numeric, String - TEXT, String - JSON or String - YAML as per the `type` and `format` attributes.",
 						},
 						"order": {
 							Type:        schema.TypeInt,
@@ -158,7 +173,12 @@ func resourceIbmIbmAppConfigPropertyCreate(d *schema.ResourceData, meta interfac
 	options.SetType(d.Get("type").(string))
 	options.SetEnvironmentID(d.Get("environment_id").(string))
 	options.SetPropertyID(d.Get("property_id").(string))
-	options.SetValue(d.Get("value").(string))
+
+	value, err := resourceIbmAppConfigPropertyParseValue(d.Get("type").(string), d.Get("format").(string), d.Get("value").(string))
+	if err != nil {
+		return fmt.Errorf("'value' parameter has wrong value: %s", err)
+	}
+	options.SetValue(value)
 
 	if _, ok := d.GetOk("description"); ok {
 		options.SetDescription(d.Get("description").(string))
@@ -168,6 +188,8 @@ func resourceIbmIbmAppConfigPropertyCreate(d *schema.ResourceData, meta interfac
 	}
 	if _, ok := d.GetOk("format"); ok {
 		options.SetFormat(d.Get("format").(string))
+	} else if d.Get("type").(string) == "STRING" {
+		options.SetFormat("TEXT")
 	}
 	if _, ok := d.GetOk("collections"); ok {
 		var collections []appconfigurationv1.CollectionRef
@@ -222,7 +244,7 @@ func resourceIbmIbmAppConfigPropertyRead(d *schema.ResourceData, meta interface{
 
 	result, response, err := appconfigClient.GetProperty(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 		}
 		return fmt.Errorf("[DEBUG] GetProperty failed %s\n%s", err, response)
@@ -246,15 +268,14 @@ func resourceIbmIbmAppConfigPropertyRead(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("error setting type: %s", err)
 		}
 	}
+	if result.Format != nil {
+		if err = d.Set("format", result.Format); err != nil {
+			return fmt.Errorf("error setting format: %s", err)
+		}
+	}
 	if result.Value != nil {
-		value := result.Value
-		switch value.(interface{}).(type) {
-		case string:
-			d.Set("value", value.(string))
-		case float64:
-			d.Set("value", fmt.Sprintf("%v", value))
-		case bool:
-			d.Set("value", strconv.FormatBool(value.(bool)))
+		if err = d.Set("value", appConfigPropertyValueToString(result.Value)); err != nil {
+			return fmt.Errorf("error setting value: %s", err)
 		}
 	}
 	if result.Description != nil {
@@ -327,7 +348,12 @@ func resourceIbmIbmAppConfigPropertyUpdate(d *schema.ResourceData, meta interfac
 		options.SetPropertyID(parts[2])
 
 		options.SetName(d.Get("name").(string))
-		options.SetValue(d.Get("value").(string))
+
+		value, err := resourceIbmAppConfigPropertyParseValue(d.Get("type").(string), d.Get("format").(string), d.Get("value").(string))
+		if err != nil {
+			return fmt.Errorf("'value' parameter has wrong value: %s", err)
+		}
+		options.SetValue(value)
 
 		if _, ok := d.GetOk("description"); ok {
 			options.SetDescription(d.Get("description").(string))
@@ -383,7 +409,7 @@ func resourceIbmIbmAppConfigPropertyDelete(d *schema.ResourceData, meta interfac
 
 	response, err := appconfigClient.DeleteProperty(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -396,6 +422,138 @@ func resourceIbmIbmAppConfigPropertyDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+func ResourceIBMAppConfigPropertyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "BOOLEAN, NUMERIC, STRING",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "format",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "JSON, TEXT, YAML",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_app_config_property",
+		Schema:       validateSchema,
+	}
+	return &resourceValidator
+}
+
+// resourceIbmAppConfigPropertyValidateValues checks the property value and the segment rule values
+// against the declared type and format, so a mismatch fails at plan time instead of on the API
+func resourceIbmAppConfigPropertyValidateValues(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("type") {
+		return nil
+	}
+	propertyType := diff.Get("type").(string)
+	format := diff.Get("format").(string)
+	if format != "" && propertyType != "STRING" && diff.HasChange("format") {
+		return fmt.Errorf("[ERROR] 'format' can only be set when 'type' is STRING")
+	}
+	if diff.NewValueKnown("value") {
+		if _, err := resourceIbmAppConfigPropertyParseValue(propertyType, format, diff.Get("value").(string)); err != nil {
+			return fmt.Errorf("[ERROR] 'value' is not a valid %s: %s", resourceIbmAppConfigPropertyTypeName(propertyType, format), err)
+		}
+	}
+	if !diff.NewValueKnown("segment_rules") {
+		return nil
+	}
+	for i, e := range diff.Get("segment_rules").([]interface{}) {
+		if e == nil {
+			continue
+		}
+		ruleValue := e.(map[string]interface{})["value"].(string)
+		if ruleValue == "" {
+			// the value is not known until apply
+			continue
+		}
+		if _, err := resourceIbmAppConfigPropertyParseValue(propertyType, format, ruleValue); err != nil {
+			return fmt.Errorf("[ERROR] 'value' of segment_rules.%d is not a valid %s: %s", i, resourceIbmAppConfigPropertyTypeName(propertyType, format), err)
+		}
+	}
+	return nil
+}
+
+// resourceIbmAppConfigPropertyParseValue converts a value given as string to the value the
+// service expects for the type and format of the property
+func resourceIbmAppConfigPropertyParseValue(propertyType, format, value string) (interface{}, error) {
+	switch propertyType {
+	case "NUMERIC":
+		return strconv.ParseFloat(value, 64)
+	case "BOOLEAN":
+		return strconv.ParseBool(value)
+	case "STRING":
+		switch format {
+		case "JSON":
+			var v interface{}
+			if err := json.Unmarshal([]byte(value), &v); err != nil {
+				return nil, err
+			}
+			return v, nil
+		case "YAML":
+			var v interface{}
+			if err := yaml.Unmarshal([]byte(value), &v); err != nil {
+				return nil, err
+			}
+			return value, nil
+		}
+	}
+	return value, nil
+}
+
+func resourceIbmAppConfigPropertyTypeName(propertyType, format string) string {
+	if propertyType == "STRING" && format != "" {
+		return fmt.Sprintf("%s value", format)
+	}
+	return fmt.Sprintf("%s value", propertyType)
+}
+
+// resourceIbmAppConfigPropertySuppressEquivalentValue ignores formatting differences between JSON values
+func resourceIbmAppConfigPropertySuppressEquivalentValue(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("format").(string) != "JSON" || old == "" || new == "" {
+		return false
+	}
+	var oldObj, newObj interface{}
+	if err := json.Unmarshal([]byte(old), &oldObj); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newObj); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldObj, newObj)
+}
+
+// appConfigPropertyValueToString returns the value of a property or a segment rule as it is
+// written in the configuration, JSON values are returned as JSON strings
+func appConfigPropertyValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%v", v)
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return ""
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			log.Printf("[DEBUG] Error marshalling property value %v: %s", v, err)
+			return ""
+		}
+		return string(b)
+	}
+}
+
 func resourceIbmAppConfigPropertyMapToCollectionRef(collectionRefMap map[string]interface{}) appconfigurationv1.CollectionRef {
 	collectionRef := appconfigurationv1.CollectionRef{}
 	collectionRef.CollectionID = core.StringPtr(collectionRefMap["collection_id"].(string))
@@ -413,25 +571,11 @@ func resourceIbmAppConfigPropertyMapToSegmentRule(d *schema.ResourceData, segmen
 	segmentRule.Rules = rules
 
 	segmentRule.Order = core.Int64Ptr(int64(segmentRuleMap["order"].(int)))
-	ruleValue := segmentRuleMap["value"].(string)
-	switch d.Get("type").(string) {
-	case "STRING":
-		segmentRule.Value = ruleValue
-	case "NUMERIC":
-		v, err := strconv.ParseFloat(ruleValue, 64)
-		if err != nil {
-			return segmentRule, fmt.Errorf("'value' parameter in 'segment_rules' has wrong value: %s", err)
-		}
-		segmentRule.Value = v
-	case "BOOLEAN":
-		if ruleValue == "false" {
-			segmentRule.Value = false
-		} else if ruleValue == "true" {
-			segmentRule.Value = true
-		} else {
-			return segmentRule, fmt.Errorf("'value' parameter in 'segment_rules' has wrong value")
-		}
+	ruleValue, err := resourceIbmAppConfigPropertyParseValue(d.Get("type").(string), d.Get("format").(string), segmentRuleMap["value"].(string))
+	if err != nil {
+		return segmentRule, fmt.Errorf("'value' parameter in 'segment_rules' has wrong value: %s", err)
 	}
+	segmentRule.Value = ruleValue
 
 	return segmentRule, nil
 }
@@ -460,15 +604,7 @@ func resourceIbmAppConfigPropertySegmentRuleToMap(segmentRule appconfigurationv1
 
 	segmentRuleMap["rules"] = rules
 	segmentRuleMap["order"] = flex.IntValue(segmentRule.Order)
-	segmentValue := segmentRule.Value
-	switch segmentValue.(interface{}).(type) {
-	case string:
-		segmentRuleMap["value"] = segmentValue.(string)
-	case float64:
-		segmentRuleMap["value"] = fmt.Sprintf("%v", segmentValue)
-	case bool:
-		segmentRuleMap["value"] = strconv.FormatBool(segmentValue.(bool))
-	}
+	segmentRuleMap["value"] = appConfigPropertyValueToString(segmentRule.Value)
 
 	return segmentRuleMap
 }
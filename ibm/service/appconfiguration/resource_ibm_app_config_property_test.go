@@ -9,6 +9,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
 	"testing"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
@@ -60,6 +61,33 @@ func TestAccIbmIbmAppConfigPropertyBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmIbmAppConfigPropertyJSON(t *testing.T) {
+	var conf appconfigurationv1.Property
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	propertyID := fmt.Sprintf("tf_property_id_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmAppConfigPropertyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIbmAppConfigPropertyConfigJSON(instanceName, name, propertyID, `"{\"attempts\": 3"`),
+				ExpectError: regexp.MustCompile("'value' is not a valid JSON value"),
+			},
+			{
+				Config: testAccCheckIbmAppConfigPropertyConfigJSON(instanceName, name, propertyID, `jsonencode({ attempts = 3 })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmAppConfigPropertyExists("ibm_app_config_property.ibm_app_config_property_resource1", conf),
+					resource.TestCheckResourceAttr("ibm_app_config_property.ibm_app_config_property_resource1", "format", "JSON"),
+					resource.TestCheckResourceAttr("ibm_app_config_property.ibm_app_config_property_resource1", "value", `{"attempts":3}`),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmAppConfigPropertyConfigBasic(instanceName, name, propertyID, typeVar, description, tags string) string {
 	return fmt.Sprintf(`
     	resource "ibm_resource_instance" "app_config_terraform_test476" {
@@ -143,3 +171,22 @@ func testAccCheckIbmAppConfigPropertyDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIbmAppConfigPropertyConfigJSON(instanceName, name, propertyID, value string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test476" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "lite"
+		}
+		resource "ibm_app_config_property" "ibm_app_config_property_resource1" {
+			guid           = ibm_resource_instance.app_config_terraform_test476.guid
+			environment_id = "dev"
+			name           = "%s"
+			property_id    = "%s"
+			type           = "STRING"
+			format         = "JSON"
+			value          = %s
+		}`, instanceName, name, propertyID, value)
+}
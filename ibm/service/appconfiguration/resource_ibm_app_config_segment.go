@@ -160,7 +160,7 @@ func resourceIbmIbmAppConfigSegmentRead(d *schema.ResourceData, meta interface{}
 
 	result, response, err := appconfigClient.GetSegment(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 		}
 		return fmt.Errorf("[DEBUG] GetSegment failed %s\n%s", err, response)
@@ -290,7 +290,7 @@ func resourceIbmIbmAppConfigSegmentDelete(d *schema.ResourceData, meta interface
 
 	response, err := appconfigClient.DeleteSegment(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -67,6 +67,7 @@ func ResourceIBMIbmAppConfigSnapshot() *schema.Resource {
 			"action": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use the ibm_app_config_snapshot_promote resource to promote a snapshot.",
 				Description: "action promote",
 			},
 			"environment_id": {
@@ -295,7 +296,7 @@ func resourceIbmIbmAppConfigSnapshotDelete(d *schema.ResourceData, meta interfac
 
 	response, err := appconfigClient.DeleteGitconfig(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMAppConfigSnapshotPromote promotes the configuration of a git
+// config's environment to its git repository. Every apply that creates or
+// replaces the resource commits a new snapshot, so pipelines can re-promote
+// by changing `triggers`.
+func ResourceIBMAppConfigSnapshotPromote() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIbmAppConfigSnapshotPromoteCreate,
+		Read:   resourceIbmAppConfigSnapshotPromoteRead,
+		Delete: resourceIbmAppConfigSnapshotPromoteDelete,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"git_config_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Git config id of the snapshot to promote.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, promotes the snapshot again.",
+			},
+			"git_commit_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Git commit id of the promoted snapshot.",
+			},
+			"git_commit_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Git commit message of the promoted snapshot.",
+			},
+			"last_sync_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the promotion.",
+			},
+		},
+	}
+}
+
+func resourceIbmAppConfigSnapshotPromoteCreate(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+	gitConfigID := d.Get("git_config_id").(string)
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.PromoteGitconfigOptions{}
+	options.SetGitConfigID(gitConfigID)
+
+	result, response, err := appconfigClient.PromoteGitconfig(options)
+	if err != nil {
+		log.Printf("[DEBUG] PromoteGitconfig failed %s\n%s", err, response)
+		return fmt.Errorf("PromoteGitconfig failed %s\n%s", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", guid, gitConfigID, time.Now().Unix()))
+
+	if result.GitCommitID != nil {
+		if err = d.Set("git_commit_id", result.GitCommitID); err != nil {
+			return fmt.Errorf("[ERROR] Error setting git_commit_id: %s", err)
+		}
+	}
+	if result.Message != nil {
+		if err = d.Set("git_commit_message", result.Message); err != nil {
+			return fmt.Errorf("[ERROR] Error setting git_commit_message: %s", err)
+		}
+	}
+	if result.LastSyncTime != nil {
+		if err = d.Set("last_sync_time", result.LastSyncTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting last_sync_time: %s", err)
+		}
+	}
+	return resourceIbmAppConfigSnapshotPromoteRead(d, meta)
+}
+
+func resourceIbmAppConfigSnapshotPromoteRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil
+	}
+	if len(parts) != 3 {
+		return fmt.Errorf("Kindly check the id")
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return err
+	}
+
+	// A promotion is a one-off commit. Only drop it from state when the git
+	// config it was made for no longer exists.
+	options := &appconfigurationv1.GetGitconfigOptions{}
+	options.SetGitConfigID(parts[1])
+
+	_, response, err := appconfigClient.GetGitconfig(options)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[DEBUG] GetGitconfig failed %s\n%s", err, response)
+	}
+
+	d.Set("guid", parts[0])
+	d.Set("git_config_id", parts[1])
+	return nil
+}
+
+func resourceIbmAppConfigSnapshotPromoteDelete(d *schema.ResourceData, meta interface{}) error {
+	// Commits pushed to the git repository are not reverted.
+	d.SetId("")
+	return nil
+}
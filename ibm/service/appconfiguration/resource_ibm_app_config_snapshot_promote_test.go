@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+// The promotion has no import step: it is a one-off commit to the git
+// repository that the API cannot read back, so the resource has no importer.
+func TestAccIbmAppConfigSnapshotPromoteBasic(t *testing.T) {
+	name := fmt.Sprintf("name_%d", acctest.RandIntRange(10, 100))
+	gitConfigID := fmt.Sprintf("git_config_id_%d", acctest.RandIntRange(10, 100))
+	collectionID := fmt.Sprintf("collection_id_%d", acctest.RandIntRange(10, 100))
+	environmentID := fmt.Sprintf("environment_id_%d", acctest.RandIntRange(10, 100))
+	release := "v1"
+	newRelease := "v2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigSnapshotPromoteConfigBasic(name, gitConfigID, collectionID, environmentID, release),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmAppConfigSnapshotPromoteExists("ibm_app_config_snapshot_promote.app_config_snapshot_promote_resource1"),
+					resource.TestCheckResourceAttr("ibm_app_config_snapshot_promote.app_config_snapshot_promote_resource1", "git_config_id", gitConfigID),
+					resource.TestCheckResourceAttr("ibm_app_config_snapshot_promote.app_config_snapshot_promote_resource1", "triggers.release", release),
+					resource.TestCheckResourceAttrSet("ibm_app_config_snapshot_promote.app_config_snapshot_promote_resource1", "git_commit_id"),
+					resource.TestCheckResourceAttrSet("ibm_app_config_snapshot_promote.app_config_snapshot_promote_resource1", "last_sync_time"),
+				),
+			},
+			{
+				Config: testAccCheckIbmAppConfigSnapshotPromoteConfigBasic(name, gitConfigID, collectionID, environmentID, newRelease),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmAppConfigSnapshotPromoteExists("ibm_app_config_snapshot_promote.app_config_snapshot_promote_resource1"),
+					resource.TestCheckResourceAttr("ibm_app_config_snapshot_promote.app_config_snapshot_promote_resource1", "triggers.release", newRelease),
+					resource.TestCheckResourceAttrSet("ibm_app_config_snapshot_promote.app_config_snapshot_promote_resource1", "git_commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigSnapshotPromoteConfigBasic(name, gitConfigID, collectionID, environmentID, release string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test461" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "enterprise"
+		}
+		resource "ibm_app_config_collection" "app_config_collection_resource1" {
+			guid          = ibm_resource_instance.app_config_terraform_test461.guid
+			name          = "%s"
+			collection_id = "%s"
+		}
+		resource "ibm_app_config_environment" "app_config_environment_resource1" {
+			guid           = ibm_resource_instance.app_config_terraform_test461.guid
+			name           = "%s"
+			environment_id = "%s"
+		}
+		resource "ibm_app_config_snapshot" "app_config_snapshot_resource1" {
+			guid            = ibm_resource_instance.app_config_terraform_test461.guid
+			git_config_id   = "%s"
+			git_config_name = "%s"
+			git_url         = "%s"
+			git_branch      = "main"
+			git_file_path   = "terraform/%s.json"
+			git_token       = "%s"
+			collection_id   = ibm_app_config_collection.app_config_collection_resource1.collection_id
+			environment_id  = ibm_app_config_environment.app_config_environment_resource1.environment_id
+		}
+		resource "ibm_app_config_snapshot_promote" "app_config_snapshot_promote_resource1" {
+			guid          = ibm_resource_instance.app_config_terraform_test461.guid
+			git_config_id = ibm_app_config_snapshot.app_config_snapshot_resource1.git_config_id
+			triggers = {
+				release = "%s"
+			}
+		}`, name, name, collectionID, name, environmentID, gitConfigID, name, acc.AppConfigGitURL, gitConfigID, acc.AppConfigGitToken, release)
+}
+
+func testAccCheckIbmAppConfigSnapshotPromoteExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		appconfigClient, err := getAppConfigClient(acc.TestAccProvider.Meta(), parts[0])
+		if err != nil {
+			return err
+		}
+
+		options := &appconfigurationv1.GetGitconfigOptions{}
+		options.SetGitConfigID(parts[1])
+
+		result, _, err := appconfigClient.GetGitconfig(options)
+		if err != nil {
+			return err
+		}
+		if result.LastSyncTime == nil {
+			return fmt.Errorf("Snapshot of git config %s was not promoted", parts[1])
+		}
+		return nil
+	}
+}
@@ -6,12 +6,12 @@ package atracker
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 )
 
@@ -63,8 +63,7 @@ func dataSourceIBMAtrackerEndpointsRead(context context.Context, d *schema.Resou
 
 	endpoints, response, err := atrackerClient.GetEndpointsWithContext(context, getEndpointsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetEndpointsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetEndpointsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetEndpointsWithContext", err, response)
 	}
 
 	d.SetId(dataSourceIBMAtrackerEndpointsID(d))
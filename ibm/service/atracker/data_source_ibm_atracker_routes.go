@@ -6,13 +6,13 @@ package atracker
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
 )
@@ -128,8 +128,7 @@ func dataSourceIBMAtrackerRoutesRead(context context.Context, d *schema.Resource
 
 	routeList, response, err := atrackerClientv2.ListRoutesWithContext(context, listRoutesOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListRoutesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListRoutesWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListRoutesWithContext", err, response)
 	}
 	// TODO: Remove after deprecation
 	if len(routeList.Routes) == 0 {
@@ -140,8 +139,7 @@ func dataSourceIBMAtrackerRoutesRead(context context.Context, d *schema.Resource
 		if err != nil && response != nil && strings.Contains(response.String(), BLOCKED_V1_RESOURCE) {
 			return nil
 		} else if err != nil {
-			log.Printf("[DEBUG] ListRoutesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListRoutesWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListRoutesWithContext", err, response)
 		}
 		var matchRoutesV1 []atrackerv1.Route
 		var name string
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
 )
@@ -289,8 +290,7 @@ func DataSourceIBMAtrackerTargetsRead(context context.Context, d *schema.Resourc
 
 		targetList, response, err := atrackerClientv2.ListTargetsWithContext(context, listTargetsOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ListTargetsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListTargetsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListTargetsWithContext", err, response)
 		}
 
 		// Use the provided filter argument and construct a new list with only the requested resource(s)
@@ -139,8 +139,7 @@ func resourceIBMAtrackerRouteCreate(context context.Context, d *schema.ResourceD
 
 	route, response, err := atrackerClient.CreateRouteWithContext(context, createRouteOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRouteWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateRouteWithContext", err, response)
 	}
 
 	d.SetId(*route.ID)
@@ -164,8 +163,7 @@ func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceDat
 	route, response, err := atrackerClient.GetRouteWithContext(context, getRouteOptions)
 
 	if err != nil && response != nil && response.StatusCode != 404 {
-		log.Printf("[DEBUG] GetRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRouteWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetRouteWithContext", err, response)
 	}
 	if err == nil && response != nil {
 		if err = d.Set("name", route.Name); err != nil {
@@ -264,8 +262,7 @@ func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceD
 
 		_, response, err := atrackerClient.ReplaceRouteWithContext(context, replaceRouteOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ReplaceRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceRouteWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ReplaceRouteWithContext", err, response)
 		}
 		return resourceIBMAtrackerRouteRead(context, d, meta)
 	}
@@ -285,8 +282,7 @@ func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceD
 
 	_, response, err := atrackerClientV1.ReplaceRouteWithContext(context, replaceRouteOptionsV1)
 	if err != nil {
-		log.Printf("[DEBUG] ReplaceRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceRouteWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ReplaceRouteWithContext", err, response)
 	}
 
 	return resourceIBMAtrackerRouteRead(context, d, meta)
@@ -307,8 +303,7 @@ func resourceIBMAtrackerRouteDelete(context context.Context, d *schema.ResourceD
 
 		response, err := atrackerClient.DeleteRouteWithContext(context, deleteRouteOptions)
 		if err != nil {
-			log.Printf("[DEBUG] DeleteRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("DeleteRouteWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("DeleteRouteWithContext", err, response)
 		}
 	} else {
 		deleteRouteOptions := &atrackerv1.DeleteRouteOptions{}
@@ -317,8 +312,7 @@ func resourceIBMAtrackerRouteDelete(context context.Context, d *schema.ResourceD
 
 		response, err := atrackerClientV1.DeleteRouteWithContext(context, deleteRouteOptions)
 		if err != nil {
-			log.Printf("[DEBUG] DeleteRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("DeleteRouteWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("DeleteRouteWithContext", err, response)
 		}
 	}
 
@@ -113,8 +113,7 @@ func resourceIBMAtrackerSettingsCreate(context context.Context, d *schema.Resour
 
 	settings, response, err := atrackerClient.PutSettingsWithContext(context, putSettingsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] PutSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("PutSettingsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("PutSettingsWithContext", err, response)
 	}
 
 	d.SetId(*settings.MetadataRegionPrimary)
@@ -131,12 +130,11 @@ func resourceIBMAtrackerSettingsRead(context context.Context, d *schema.Resource
 
 	settings, response, err := atrackerClient.GetSettingsWithContext(context, getSettingsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSettingsWithContext", err, response)
 	}
 
 	if err = d.Set("metadata_region_primary", settings.MetadataRegionPrimary); err != nil {
@@ -196,7 +194,7 @@ func resourceIBMAtrackerSettingsUpdate(context context.Context, d *schema.Resour
 		if err != nil {
 			log.Printf("[DEBUG] PutSettingsWithContext failed %s\n%s", err, response)
 			log.Printf("[DEBUG] PutSettingsWithContext failed %v\n", putSettingsOptions)
-			return diag.FromErr(fmt.Errorf("PutSettingsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("PutSettingsWithContext", err, response)
 		}
 		d.SetId(*setting.MetadataRegionPrimary)
 	}
@@ -237,8 +235,7 @@ func resourceIBMAtrackerSettingsDelete(context context.Context, d *schema.Resour
 
 	_, response, err := atrackerClient.PutSettingsWithContext(context, putSettingsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] PutSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("PutSettingsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("PutSettingsWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -27,6 +27,7 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 		UpdateContext: resourceIBMAtrackerTargetUpdate,
 		DeleteContext: resourceIBMAtrackerTargetDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMAtrackerTargetValidateEndpoint,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -135,6 +136,12 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 					},
 				},
 			},
+			"validate_write": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Test a write to the target after it is created or updated, and fail the apply if the write fails.",
+			},
 			"region": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -309,12 +316,17 @@ func resourceIBMAtrackerTargetCreate(context context.Context, d *schema.Resource
 
 	target, response, err := atrackerClient.CreateTargetWithContext(context, createTargetOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTargetWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateTargetWithContext", err, response)
 	}
 
 	d.SetId(*target.ID)
 
+	if d.Get("validate_write").(bool) {
+		if err := resourceIBMAtrackerTargetValidateWrite(context, atrackerClient, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMAtrackerTargetRead(context, d, meta)
 }
 
@@ -330,12 +342,11 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 
 	target, response, err := atrackerClient.GetTargetWithContext(context, getTargetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTargetWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTargetWithContext", err, response)
 	}
 
 	if err = d.Set("name", target.Name); err != nil {
@@ -344,6 +355,9 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 	if err = d.Set("target_type", target.TargetType); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting target_type: %s", err))
 	}
+	if _, ok := d.GetOkExists("validate_write"); !ok {
+		d.Set("validate_write", false)
+	}
 	// Don't report difference if the last parts of CRN are different
 	if target.CosEndpoint != nil {
 		cosEndpointMap, err := resourceIBMAtrackerTargetCosEndpointPrototypeToMap(target.CosEndpoint)
@@ -478,14 +492,65 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 	if hasChange {
 		_, response, err := atrackerClient.ReplaceTargetWithContext(context, replaceTargetOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ReplaceTargetWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTargetWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ReplaceTargetWithContext", err, response)
+		}
+	}
+
+	if (hasChange || d.HasChange("validate_write")) && d.Get("validate_write").(bool) {
+		if err := resourceIBMAtrackerTargetValidateWrite(context, atrackerClient, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	return resourceIBMAtrackerTargetRead(context, d, meta)
 }
 
+// resourceIBMAtrackerTargetValidateEndpoint checks that the endpoint block matches the target type.
+func resourceIBMAtrackerTargetValidateEndpoint(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("target_type") {
+		return nil
+	}
+	endpoints := map[string]string{
+		"cloud_object_storage": "cos_endpoint",
+		"logdna":               "logdna_endpoint",
+		"event_streams":        "eventstreams_endpoint",
+	}
+	targetType := diff.Get("target_type").(string)
+	if endpoint, ok := endpoints[targetType]; ok && diff.NewValueKnown(endpoint) {
+		if _, ok := diff.GetOk(endpoint); !ok {
+			return fmt.Errorf("[ERROR] %s is required for target_type %s", endpoint, targetType)
+		}
+	}
+	for endpointType, endpoint := range endpoints {
+		if endpointType == targetType || !diff.NewValueKnown(endpoint) {
+			continue
+		}
+		if _, ok := diff.GetOk(endpoint); ok {
+			return fmt.Errorf("[ERROR] %s can not be set for target_type %s", endpoint, targetType)
+		}
+	}
+	return nil
+}
+
+func resourceIBMAtrackerTargetValidateWrite(context context.Context, atrackerClient *atrackerv2.AtrackerV2, id string) error {
+	validateTargetOptions := &atrackerv2.ValidateTargetOptions{}
+	validateTargetOptions.SetID(id)
+
+	target, response, err := atrackerClient.ValidateTargetWithContext(context, validateTargetOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ValidateTargetWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("ValidateTargetWithContext failed %s\n%s", err, response)
+	}
+	if target.WriteStatus != nil && target.WriteStatus.Status != nil && *target.WriteStatus.Status == "failed" {
+		reason := ""
+		if target.WriteStatus.ReasonForLastFailure != nil {
+			reason = *target.WriteStatus.ReasonForLastFailure
+		}
+		return fmt.Errorf("[ERROR] Write to target %s failed: %s", id, reason)
+	}
+	return nil
+}
+
 func resourceIBMAtrackerTargetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, atrackerClient, err := getAtrackerClients(meta)
 	if err != nil {
@@ -498,8 +563,7 @@ func resourceIBMAtrackerTargetDelete(context context.Context, d *schema.Resource
 
 	_, response, err := atrackerClient.DeleteTargetWithContext(context, deleteTargetOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTargetWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteTargetWithContext", err, response)
 	}
 
 	d.SetId("")
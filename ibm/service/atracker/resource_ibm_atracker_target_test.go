@@ -5,6 +5,7 @@ package atracker_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -51,6 +52,21 @@ func TestAccIBMAtrackerTargetBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMAtrackerTargetEndpointMismatch(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMAtrackerTargetConfigBasic(name, "event_streams"),
+				ExpectError: regexp.MustCompile("eventstreams_endpoint is required for target_type event_streams"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMAtrackerTargetConfigBasic(name string, targetType string) string {
 	return fmt.Sprintf(`
 
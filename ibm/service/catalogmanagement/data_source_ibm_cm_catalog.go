@@ -6,7 +6,6 @@ package catalogmanagement
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -393,8 +392,7 @@ func dataSourceIBMCmCatalogRead(context context.Context, d *schema.ResourceData,
 
 	catalog, response, err := catalogManagementClient.GetCatalogWithContext(context, getCatalogOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCatalogWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetCatalogWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getCatalogOptions.CatalogIdentifier))
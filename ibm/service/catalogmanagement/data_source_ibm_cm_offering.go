@@ -6,7 +6,6 @@ package catalogmanagement
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -2482,8 +2481,7 @@ func dataSourceIBMCmOfferingRead(context context.Context, d *schema.ResourceData
 
 	offering, response, err := catalogManagementClient.GetOfferingWithContext(context, getOfferingOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetOfferingWithContext", err, response)
 	}
 
 	d.SetId(*getOfferingOptions.OfferingID)
@@ -6,7 +6,6 @@ package catalogmanagement
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -1622,8 +1621,7 @@ func dataSourceIBMCmVersionRead(context context.Context, d *schema.ResourceData,
 
 	offering, response, err := catalogManagementClient.GetVersionWithContext(context, getVersionOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVersionWithContext", err, response)
 	}
 	version := offering.Kinds[0].Versions[0]
 
@@ -6,7 +6,6 @@ package catalogmanagement
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -420,8 +419,7 @@ func resourceIBMCmCatalogCreate(context context.Context, d *schema.ResourceData,
 
 	catalog, response, err := catalogManagementClient.CreateCatalogWithContext(context, createCatalogOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateCatalogWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateCatalogWithContext", err, response)
 	}
 
 	d.SetId(*catalog.ID)
@@ -441,12 +439,11 @@ func resourceIBMCmCatalogRead(context context.Context, d *schema.ResourceData, m
 
 	catalog, response, err := catalogManagementClient.GetCatalogWithContext(context, getCatalogOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCatalogWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetCatalogWithContext", err, response)
 	}
 
 	if err = d.Set("label", catalog.Label); err != nil {
@@ -591,8 +588,7 @@ func resourceIBMCmCatalogUpdate(context context.Context, d *schema.ResourceData,
 
 	_, response, err := catalogManagementClient.ReplaceCatalogWithContext(context, replaceCatalogOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ReplaceCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceCatalogWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ReplaceCatalogWithContext", err, response)
 	}
 
 	return resourceIBMCmCatalogRead(context, d, meta)
@@ -610,8 +606,7 @@ func resourceIBMCmCatalogDelete(context context.Context, d *schema.ResourceData,
 
 	response, err := catalogManagementClient.DeleteCatalogWithContext(context, deleteCatalogOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteCatalogWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteCatalogWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -7,7 +7,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -219,8 +218,7 @@ func resourceIBMCmObjectCreate(context context.Context, d *schema.ResourceData,
 
 	catalogObject, response, err := catalogManagementClient.CreateObjectWithContext(context, createObjectOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateObjectWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateObjectWithContext", err, response)
 	}
 
 	d.SetId(*catalogObject.ID)
@@ -270,8 +268,7 @@ func resourceIBMCmObjectCreate(context context.Context, d *schema.ResourceData,
 
 		catalogObject, response, err = catalogManagementClient.ReplaceObjectWithContext(context, replaceObjectOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ReplaceObjectWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceObjectWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ReplaceObjectWithContext", err, response)
 		}
 	}
 
@@ -291,12 +288,11 @@ func resourceIBMCmObjectRead(context context.Context, d *schema.ResourceData, me
 
 	catalogObject, response, err := catalogManagementClient.GetObjectWithContext(context, getObjectOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetObjectWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetObjectWithContext", err, response)
 	}
 
 	if err = d.Set("catalog_id", getObjectOptions.CatalogIdentifier); err != nil {
@@ -392,12 +388,11 @@ func resourceIBMCmObjectUpdate(context context.Context, d *schema.ResourceData,
 
 	catalogObject, response, err := catalogManagementClient.GetObjectWithContext(context, getObjectOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetObjectWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetObjectWithContext", err, response)
 	}
 
 	replaceObjectOptions := &catalogmanagementv1.ReplaceObjectOptions{}
@@ -497,8 +492,7 @@ func resourceIBMCmObjectUpdate(context context.Context, d *schema.ResourceData,
 
 	_, response, err = catalogManagementClient.ReplaceObjectWithContext(context, replaceObjectOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ReplaceObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceObjectWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ReplaceObjectWithContext", err, response)
 	}
 
 	return resourceIBMCmObjectRead(context, d, meta)
@@ -517,8 +511,7 @@ func resourceIBMCmObjectDelete(context context.Context, d *schema.ResourceData,
 
 	response, err := catalogManagementClient.DeleteObjectWithContext(context, deleteObjectOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteObjectWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteObjectWithContext", err, response)
 	}
 
 	d.SetId("")
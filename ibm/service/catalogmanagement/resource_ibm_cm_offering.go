@@ -6,7 +6,6 @@ package catalogmanagement
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -2526,12 +2525,11 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 
 		offering, response, err := catalogManagementClient.GetOfferingWithContext(context, getOfferingOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if flex.IsResourceNotFound(response) {
 				d.SetId("")
 				return nil
 			}
-			log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("GetOfferingWithContext", err, response)
 		}
 
 		d.SetId(*offering.ID)
@@ -2737,8 +2735,7 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 
 	offering, response, err := catalogManagementClient.CreateOfferingWithContext(context, createOfferingOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateOfferingWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateOfferingWithContext", err, response)
 	}
 
 	d.SetId(*offering.ID)
@@ -2756,8 +2753,7 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 		addOfferingAccessListOptions.SetAccesses(SIToSS(d.Get("publish_to_access_list").([]interface{})))
 		_, response, err = catalogManagementClient.AddOfferingAccessListWithContext(context, &addOfferingAccessListOptions)
 		if err != nil {
-			log.Printf("[DEBUG] AddOfferingAccessListWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("AddOfferingAccessListWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("AddOfferingAccessListWithContext", err, response)
 		}
 	}
 
@@ -2774,8 +2770,7 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 	if shareOffering {
 		_, response, err = catalogManagementClient.ShareOfferingWithContext(context, &shareOfferingOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ShareOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ShareOfferingWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ShareOfferingWithContext", err, response)
 		}
 	}
 
@@ -2795,12 +2790,11 @@ func resourceIBMCmOfferingRead(context context.Context, d *schema.ResourceData,
 
 	offering, response, err := catalogManagementClient.GetOfferingWithContext(context, getOfferingOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetOfferingWithContext", err, response)
 	}
 
 	if err = d.Set("catalog_id", getOfferingOptions.CatalogIdentifier); err != nil {
@@ -3029,12 +3023,11 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 	getOfferingOptions.SetOfferingID(d.Id())
 	offering, response, err := catalogManagementClient.GetOfferingWithContext(context, getOfferingOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetOfferingWithContext", err, response)
 	}
 
 	updateOfferingOptions.SetCatalogIdentifier(*offering.CatalogID)
@@ -3543,8 +3536,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 		addOfferingAccessListOptions.SetAccesses(SIToSS(d.Get("publish_to_access_list").([]interface{})))
 		_, response, err = catalogManagementClient.AddOfferingAccessListWithContext(context, &addOfferingAccessListOptions)
 		if err != nil {
-			log.Printf("[DEBUG] AddOfferingAccessListWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("AddOfferingAccessListWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("AddOfferingAccessListWithContext", err, response)
 		}
 	}
 
@@ -3561,16 +3553,14 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 	if publishStatusChanged {
 		_, response, err = catalogManagementClient.ShareOfferingWithContext(context, &shareOfferingOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ShareOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ShareOfferingWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ShareOfferingWithContext", err, response)
 		}
 	}
 
 	if hasChange {
 		_, response, err := catalogManagementClient.UpdateOfferingWithContext(context, updateOfferingOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateOfferingWithContext", err, response)
 		}
 	}
 
@@ -3590,8 +3580,7 @@ func resourceIBMCmOfferingDelete(context context.Context, d *schema.ResourceData
 
 	response, err := catalogManagementClient.DeleteOfferingWithContext(context, deleteOfferingOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteOfferingWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteOfferingWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -243,7 +243,7 @@ func resourceIBMCmOfferingInstanceRead(d *schema.ResourceData, meta interface{})
 
 	offeringInstance, response, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
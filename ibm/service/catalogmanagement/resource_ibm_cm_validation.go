@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 )
@@ -183,12 +184,11 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 
 		offering, response, err := catalogManagementClient.GetVersionWithContext(context, getVersionOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if flex.IsResourceNotFound(response) {
 				d.SetId("")
 				return nil
 			}
-			log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("GetVersionWithContext", err, response)
 		}
 
 		version = offering.Kinds[0].Versions[0]
@@ -239,8 +239,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 
 	response, err := catalogManagementClient.ValidateInstallWithContext(context, validateInstallOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ValidateInstallWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ValidateInstallWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ValidateInstallWithContext", err, response)
 	}
 
 	d.SetId(*validateInstallOptions.VersionLocID)
@@ -250,8 +249,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	validationStatusOptions.SetXAuthRefreshToken(d.Get("x_auth_refresh_token").(string))
 	result, response, err := catalogManagementClient.GetValidationStatusWithContext(context, validationStatusOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetValidationStatusWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetValidationStatusWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetValidationStatusWithContext", err, response)
 	}
 
 	status := *result.State
@@ -273,8 +271,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 
 		result, response, err = catalogManagementClient.GetValidationStatusWithContext(context, validationStatusOptions)
 		if err != nil {
-			log.Printf("[DEBUG] GetValidationStatusWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetValidationStatusWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("GetValidationStatusWithContext", err, response)
 		}
 	}
 
@@ -302,12 +299,11 @@ func resourceIBMCmValidationRead(context context.Context, d *schema.ResourceData
 
 	offering, response, err := catalogManagementClient.GetVersionWithContext(context, getVersionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVersionWithContext", err, response)
 	}
 
 	version := offering.Kinds[0].Versions[0]
@@ -1736,18 +1736,16 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 	getOfferingOptions.SetOfferingID(d.Get("offering_id").(string))
 	oldOffering, response, err := catalogManagementClient.GetOfferingWithContext(context, getOfferingOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetOfferingWithContext", err, response)
 	}
 
 	offering, response, err := catalogManagementClient.ImportOfferingVersionWithContext(context, importOfferingVersionOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ImportOfferingVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ImportOfferingVersionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ImportOfferingVersionWithContext", err, response)
 	}
 
 	activeVersionID, err := getVersionFromOffering(*oldOffering, *offering)
@@ -1935,8 +1933,7 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 	if hasChange {
 		_, response, err := catalogManagementClient.UpdateOfferingWithContext(context, updateOfferingOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateOfferingWithContext", err, response)
 		}
 	}
 
@@ -1955,12 +1952,11 @@ func resourceIBMCmVersionRead(context context.Context, d *schema.ResourceData, m
 
 	offering, response, err := catalogManagementClient.GetVersionWithContext(context, getVersionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVersionWithContext", err, response)
 	}
 
 	version := offering.Kinds[0].Versions[0]
@@ -2205,12 +2201,11 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 
 	partialOffering, response, err := catalogManagementClient.GetVersionWithContext(context, getVersionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVersionWithContext", err, response)
 	}
 	activeVersion := partialOffering.Kinds[0].Versions[0]
 
@@ -2220,12 +2215,11 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 	getOfferingOptions.SetOfferingID(*partialOffering.ID)
 	offering, response, err := catalogManagementClient.GetOfferingWithContext(context, getOfferingOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetOfferingWithContext", err, response)
 	}
 
 	updateOfferingOptions := &catalogmanagementv1.UpdateOfferingOptions{}
@@ -2381,8 +2375,7 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 	if hasChange {
 		_, response, err := catalogManagementClient.UpdateOfferingWithContext(context, updateOfferingOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateOfferingWithContext", err, response)
 		}
 	}
 
@@ -2401,8 +2394,7 @@ func resourceIBMCmVersionDelete(context context.Context, d *schema.ResourceData,
 
 	response, err := catalogManagementClient.DeleteVersionWithContext(context, deleteVersionOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteVersionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteVersionWithContext", err, response)
 	}
 
 	d.SetId("")
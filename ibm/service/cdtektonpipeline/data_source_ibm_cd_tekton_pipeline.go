@@ -6,7 +6,6 @@ package cdtektonpipeline
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -504,8 +503,7 @@ func dataSourceIBMCdTektonPipelineRead(context context.Context, d *schema.Resour
 
 	tektonPipeline, response, err := cdTektonPipelineClient.GetTektonPipelineWithContext(context, getTektonPipelineOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTektonPipelineWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getTektonPipelineOptions.ID))
@@ -6,7 +6,6 @@ package cdtektonpipeline
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -271,8 +270,7 @@ func dataSourceIBMCdTektonPipelineTriggerRead(context context.Context, d *schema
 
 	TriggerIntf, response, err := cdTektonPipelineClient.GetTektonPipelineTriggerWithContext(context, getTektonPipelineTriggerOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTektonPipelineTriggerWithContext", err, response)
 	}
 	trigger := TriggerIntf.(*cdtektonpipelinev2.Trigger)
 
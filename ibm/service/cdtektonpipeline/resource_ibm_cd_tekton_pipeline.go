@@ -6,7 +6,6 @@ package cdtektonpipeline
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -528,8 +527,7 @@ func resourceIBMCdTektonPipelineCreate(context context.Context, d *schema.Resour
 	}
 	tektonPipeline, response, err := cdTektonPipelineClient.CreateTektonPipelineWithContext(context, createTektonPipelineOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateTektonPipelineWithContext", err, response)
 	}
 
 	d.SetId(*tektonPipeline.ID)
@@ -549,12 +547,11 @@ func resourceIBMCdTektonPipelineRead(context context.Context, d *schema.Resource
 
 	tektonPipeline, response, err := cdTektonPipelineClient.GetTektonPipelineWithContext(context, getTektonPipelineOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTektonPipelineWithContext", err, response)
 	}
 
 	if err = d.Set("enable_notifications", tektonPipeline.EnableNotifications); err != nil {
@@ -686,8 +683,7 @@ func resourceIBMCdTektonPipelineUpdate(context context.Context, d *schema.Resour
 		updateTektonPipelineOptions.TektonPipelinePatch, _ = patchVals.AsPatch()
 		_, response, err := cdTektonPipelineClient.UpdateTektonPipelineWithContext(context, updateTektonPipelineOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateTektonPipelineWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTektonPipelineWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateTektonPipelineWithContext", err, response)
 		}
 	}
 
@@ -706,8 +702,7 @@ func resourceIBMCdTektonPipelineDelete(context context.Context, d *schema.Resour
 
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineWithContext(context, deleteTektonPipelineOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteTektonPipelineWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package cdtektonpipeline
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -145,8 +144,7 @@ func resourceIBMCdTektonPipelineDefinitionCreate(context context.Context, d *sch
 
 	definition, response, err := cdTektonPipelineClient.CreateTektonPipelineDefinitionWithContext(context, createTektonPipelineDefinitionOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateTektonPipelineDefinitionWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createTektonPipelineDefinitionOptions.PipelineID, *definition.ID))
@@ -172,12 +170,11 @@ func resourceIBMCdTektonPipelineDefinitionRead(context context.Context, d *schem
 
 	definition, response, err := cdTektonPipelineClient.GetTektonPipelineDefinitionWithContext(context, getTektonPipelineDefinitionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTektonPipelineDefinitionWithContext", err, response)
 	}
 
 	if err = d.Set("pipeline_id", getTektonPipelineDefinitionOptions.PipelineID); err != nil {
@@ -234,8 +231,7 @@ func resourceIBMCdTektonPipelineDefinitionUpdate(context context.Context, d *sch
 	if hasChange {
 		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelineDefinitionWithContext(context, replaceTektonPipelineDefinitionOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ReplaceTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ReplaceTektonPipelineDefinitionWithContext", err, response)
 		}
 	}
 
@@ -260,8 +256,7 @@ func resourceIBMCdTektonPipelineDefinitionDelete(context context.Context, d *sch
 
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineDefinitionWithContext(context, deleteTektonPipelineDefinitionOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteTektonPipelineDefinitionWithContext", err, response)
 	}
 
 	d.SetId("")
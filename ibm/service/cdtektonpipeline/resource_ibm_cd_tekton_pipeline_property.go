@@ -6,7 +6,6 @@ package cdtektonpipeline
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -154,8 +153,7 @@ func resourceIBMCdTektonPipelinePropertyCreate(context context.Context, d *schem
 
 	property, response, err := cdTektonPipelineClient.CreateTektonPipelinePropertiesWithContext(context, createTektonPipelinePropertiesOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateTektonPipelinePropertiesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelinePropertiesWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateTektonPipelinePropertiesWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createTektonPipelinePropertiesOptions.PipelineID, *property.Name))
@@ -181,12 +179,11 @@ func resourceIBMCdTektonPipelinePropertyRead(context context.Context, d *schema.
 
 	property, response, err := cdTektonPipelineClient.GetTektonPipelinePropertyWithContext(context, getTektonPipelinePropertyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetTektonPipelinePropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTektonPipelinePropertyWithContext", err, response)
 	}
 
 	if err = d.Set("pipeline_id", getTektonPipelinePropertyOptions.PipelineID); err != nil {
@@ -276,8 +273,7 @@ func resourceIBMCdTektonPipelinePropertyUpdate(context context.Context, d *schem
 	if hasChange {
 		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelinePropertyWithContext(context, replaceTektonPipelinePropertyOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ReplaceTektonPipelinePropertyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ReplaceTektonPipelinePropertyWithContext", err, response)
 		}
 	}
 
@@ -302,8 +298,7 @@ func resourceIBMCdTektonPipelinePropertyDelete(context context.Context, d *schem
 
 	response, err := cdTektonPipelineClient.DeleteTektonPipelinePropertyWithContext(context, deleteTektonPipelinePropertyOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteTektonPipelinePropertyWithContext", err, response)
 	}
 
 	d.SetId("")
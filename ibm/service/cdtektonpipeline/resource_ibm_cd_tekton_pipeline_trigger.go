@@ -6,7 +6,6 @@ package cdtektonpipeline
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -366,8 +365,7 @@ func resourceIBMCdTektonPipelineTriggerCreate(context context.Context, d *schema
 
 	triggerIntf, response, err := cdTektonPipelineClient.CreateTektonPipelineTriggerWithContext(context, createTektonPipelineTriggerOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateTektonPipelineTriggerWithContext", err, response)
 	}
 
 	trigger := triggerIntf.(*cdtektonpipelinev2.Trigger)
@@ -394,12 +392,11 @@ func resourceIBMCdTektonPipelineTriggerRead(context context.Context, d *schema.R
 
 	triggerIntf, response, err := cdTektonPipelineClient.GetTektonPipelineTriggerWithContext(context, getTektonPipelineTriggerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTektonPipelineTriggerWithContext", err, response)
 	}
 
 	trigger := triggerIntf.(*cdtektonpipelinev2.Trigger)
@@ -588,8 +585,7 @@ func resourceIBMCdTektonPipelineTriggerUpdate(context context.Context, d *schema
 		updateTektonPipelineTriggerOptions.TriggerPatch, _ = patchVals.AsPatch()
 		_, response, err := cdTektonPipelineClient.UpdateTektonPipelineTriggerWithContext(context, updateTektonPipelineTriggerOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateTektonPipelineTriggerWithContext", err, response)
 		}
 	}
 
@@ -614,8 +610,7 @@ func resourceIBMCdTektonPipelineTriggerDelete(context context.Context, d *schema
 
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineTriggerWithContext(context, deleteTektonPipelineTriggerOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteTektonPipelineTriggerWithContext", err, response)
 	}
 
 	d.SetId("")
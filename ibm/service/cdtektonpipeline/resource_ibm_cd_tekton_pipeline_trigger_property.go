@@ -6,7 +6,6 @@ package cdtektonpipeline
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -171,8 +170,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyCreate(context context.Context, d
 
 	triggerProperty, response, err := cdTektonPipelineClient.CreateTektonPipelineTriggerPropertiesWithContext(context, createTektonPipelineTriggerPropertiesOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateTektonPipelineTriggerPropertiesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineTriggerPropertiesWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateTektonPipelineTriggerPropertiesWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", *createTektonPipelineTriggerPropertiesOptions.PipelineID, *createTektonPipelineTriggerPropertiesOptions.TriggerID, *triggerProperty.Name))
@@ -199,12 +197,11 @@ func resourceIBMCdTektonPipelineTriggerPropertyRead(context context.Context, d *
 
 	triggerProperty, response, err := cdTektonPipelineClient.GetTektonPipelineTriggerPropertyWithContext(context, getTektonPipelineTriggerPropertyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTektonPipelineTriggerPropertyWithContext", err, response)
 	}
 
 	if err = d.Set("pipeline_id", getTektonPipelineTriggerPropertyOptions.PipelineID); err != nil {
@@ -302,8 +299,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyUpdate(context context.Context, d
 	if hasChange {
 		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelineTriggerPropertyWithContext(context, replaceTektonPipelineTriggerPropertyOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ReplaceTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ReplaceTektonPipelineTriggerPropertyWithContext", err, response)
 		}
 	}
 
@@ -329,8 +325,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyDelete(context context.Context, d
 
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineTriggerPropertyWithContext(context, deleteTektonPipelineTriggerPropertyOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteTektonPipelineTriggerPropertyWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package cdtoolchain
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -151,8 +150,7 @@ func dataSourceIBMCdToolchainToolCustomRead(context context.Context, d *schema.R
 
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetToolByIDWithContext", err, response)
 	}
 
 	if *toolchainTool.ToolTypeID != "customtool" {
@@ -6,7 +6,6 @@ package cdtoolchain
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -141,8 +140,7 @@ func resourceIBMCdToolchainCreate(context context.Context, d *schema.ResourceDat
 
 	toolchainPost, response, err := cdToolchainClient.CreateToolchainWithContext(context, createToolchainOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateToolchainWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolchainWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateToolchainWithContext", err, response)
 	}
 
 	d.SetId(*toolchainPost.ID)
@@ -162,12 +160,11 @@ func resourceIBMCdToolchainRead(context context.Context, d *schema.ResourceData,
 
 	toolchain, response, err := cdToolchainClient.GetToolchainByIDWithContext(context, getToolchainByIDOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetToolchainByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolchainByIDWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetToolchainByIDWithContext", err, response)
 	}
 
 	if err = d.Set("name", toolchain.Name); err != nil {
@@ -239,8 +236,7 @@ func resourceIBMCdToolchainUpdate(context context.Context, d *schema.ResourceDat
 		updateToolchainOptions.ToolchainPrototypePatch, _ = patchVals.AsPatch()
 		_, response, err := cdToolchainClient.UpdateToolchainWithContext(context, updateToolchainOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateToolchainWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolchainWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateToolchainWithContext", err, response)
 		}
 	}
 
@@ -259,8 +255,7 @@ func resourceIBMCdToolchainDelete(context context.Context, d *schema.ResourceDat
 
 	response, err := cdToolchainClient.DeleteToolchainWithContext(context, deleteToolchainOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteToolchainWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolchainWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteToolchainWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -228,7 +228,7 @@ func ResourceIBMCISAlertPolicyRead(d *schema.ResourceData, meta interface{}) err
 	opt := sess.NewGetAlertPolicyOptions(alertID)
 	result, resp, err := sess.GetAlertPolicy(opt)
 	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
+		if flex.IsResourceNotFound(resp) {
 			d.SetId("")
 			return nil
 		}
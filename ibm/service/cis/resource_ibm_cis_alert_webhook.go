@@ -123,7 +123,7 @@ func ResourceIBMCISWebhookRead(d *schema.ResourceData, meta interface{}) error {
 
 	result, response, err := sess.GetWebhook(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -459,7 +459,7 @@ func ResourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	opt := sess.NewGetDnsRecordOptions(recordID)
 	result, response, err := sess.GetDnsRecord(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
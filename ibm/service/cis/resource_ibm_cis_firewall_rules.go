@@ -154,7 +154,7 @@ func ResourceIBMCISFirewallrulesRead(context context.Context, d *schema.Resource
 
 	result, response, err := cisClient.GetFirewallRuleWithContext(context, opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
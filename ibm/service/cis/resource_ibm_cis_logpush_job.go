@@ -184,7 +184,7 @@ func ResourceIBMCISLogpushJobRead(d *schema.ResourceData, meta interface{}) erro
 	opt := sess.NewGetLogpushJobV2Options(int64(JobID))
 	result, response, err := sess.GetLogpushJobV2(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
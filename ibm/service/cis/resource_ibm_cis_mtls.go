@@ -150,7 +150,7 @@ func resourceIBMCISMtlsRead(context context.Context, d *schema.ResourceData, met
 	result, response, err := sess.GetAccessCertificate(getOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
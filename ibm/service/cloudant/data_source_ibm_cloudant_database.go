@@ -6,12 +6,12 @@ package cloudant
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
 )
 
@@ -163,8 +163,7 @@ func dataSourceIBMCloudantDatabaseRead(context context.Context, d *schema.Resour
 
 	databaseInformation, response, err := cloudantClient.GetDatabaseInformationWithContext(context, getDatabaseInformationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetDatabaseInformationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetDatabaseInformationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetDatabaseInformationWithContext", err, response)
 	}
 
 	d.SetId(dataSourceIBMCloudantDatabaseID(d))
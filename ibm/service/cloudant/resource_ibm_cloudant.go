@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -22,8 +21,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
-	"github.com/IBM/go-sdk-core/v5/core"
-	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
 
 func ResourceIBMCloudant() *schema.Resource {
@@ -288,7 +285,7 @@ func resourceIBMCloudantUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("enable_cors") {
+	if d.HasChange("enable_cors") || d.HasChange("cors_config") {
 		err := updateCloudantInstanceCors(client, d)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating CORS settings: %s", err)
@@ -382,37 +379,12 @@ func getCloudantClient(d *schema.ResourceData, meta interface{}) (*cloudantv1.Cl
 }
 
 func GetCloudantClientForUrl(endpoint string, meta interface{}) (*cloudantv1.CloudantV1, error) {
-	session, err := meta.(conns.ClientSession).BluemixSession()
+	// Reuse the access token of the provider instead of requesting one for every client
+	authenticator, err := meta.(conns.ClientSession).IAMAuthenticator()
 	if err != nil {
 		return nil, err
 	}
 
-	var authenticator core.Authenticator
-	token := session.Config.IAMAccessToken
-
-	if token != "" {
-		token = strings.Replace(token, "Bearer ", "", -1)
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: token,
-		}
-	} else {
-		apiKey := session.Config.BluemixAPIKey
-		region := session.Config.Region
-		visibility := session.Config.Visibility
-		iamURL := iamidentity.DefaultServiceURL
-		if visibility == "private" || visibility == "public-and-private" {
-			if region == "us-south" || region == "us-east" {
-				iamURL = conns.ContructEndpoint(fmt.Sprintf("private.%s.iam", region), "cloud.ibm.com")
-			} else {
-				iamURL = conns.ContructEndpoint("private.iam", "cloud.ibm.com")
-			}
-		}
-		authenticator = &core.IamAuthenticator{
-			ApiKey: apiKey,
-			URL:    conns.EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
-		}
-	}
-
 	client, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
 		Authenticator: authenticator,
 		URL:           endpoint,
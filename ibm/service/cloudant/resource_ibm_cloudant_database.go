@@ -6,7 +6,6 @@ package cloudant
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -79,8 +78,7 @@ func resourceIBMCloudantDatabaseCreate(context context.Context, d *schema.Resour
 
 	_, response, err := cloudantClient.PutDatabaseWithContext(context, putDatabaseOptions)
 	if err != nil {
-		log.Printf("[DEBUG] PutDatabaseWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("PutDatabaseWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("PutDatabaseWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, dbName))
@@ -109,12 +107,11 @@ func resourceIBMCloudantDatabaseRead(context context.Context, d *schema.Resource
 
 	databaseInformation, response, err := cloudantClient.GetDatabaseInformationWithContext(context, getDatabaseInformationOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetDatabaseInformationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetDatabaseInformationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetDatabaseInformationWithContext", err, response)
 	}
 
 	d.Set("instance_crn", instanceCRN)
@@ -155,8 +152,7 @@ func resourceIBMCloudantDatabaseDelete(context context.Context, d *schema.Resour
 
 	_, response, err := cloudantClient.DeleteDatabaseWithContext(context, deleteDatabaseOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteDatabaseWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteDatabaseWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteDatabaseWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -0,0 +1,286 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantReplicationCreate,
+		ReadContext:   resourceIBMCloudantReplicationRead,
+		UpdateContext: resourceIBMCloudantReplicationUpdate,
+		DeleteContext: resourceIBMCloudantReplicationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"doc_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the replication document in the _replicator database.",
+			},
+			"source": resourceIBMCloudantReplicationDatabaseSchema("The database to replicate from."),
+			"target": resourceIBMCloudantReplicationDatabaseSchema("The database to replicate to."),
+			"continuous": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to keep replicating changes from the source after the initial replication.",
+			},
+			"create_target": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to create the target database if it does not exist.",
+			},
+			"selector": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "A JSON selector that filters the documents to replicate.",
+			},
+			"rev": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The revision of the replication document.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantReplicationDatabaseSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The URL of the database.",
+				},
+				"iam_api_key": &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The IAM API key used to access the database.",
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantReplicationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cloudantClient, err := getCloudantReplicationClient(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	docID := d.Get("doc_id").(string)
+	err = putCloudantReplicationDocument(context, cloudantClient, docID, "", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, docID))
+
+	return resourceIBMCloudantReplicationRead(context, d, meta)
+}
+
+func resourceIBMCloudantReplicationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, docID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cloudantClient, err := getCloudantReplicationClient(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(docID)
+
+	replicationDocument, response, err := cloudantClient.GetReplicationDocumentWithContext(context, getReplicationDocumentOptions)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return flex.ServiceErrorDiags("GetReplicationDocumentWithContext", err, response)
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("doc_id", docID)
+
+	// The API keys are not read back so that they are only compared with the configuration
+	if err = d.Set("source", flattenCloudantReplicationDatabase(replicationDocument.Source, d.Get("source").([]interface{}))); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting source: %s", err))
+	}
+	if err = d.Set("target", flattenCloudantReplicationDatabase(replicationDocument.Target, d.Get("target").([]interface{}))); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting target: %s", err))
+	}
+
+	continuous := replicationDocument.Continuous != nil && *replicationDocument.Continuous
+	if err = d.Set("continuous", continuous); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting continuous: %s", err))
+	}
+	createTarget := replicationDocument.CreateTarget != nil && *replicationDocument.CreateTarget
+	if err = d.Set("create_target", createTarget); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting create_target: %s", err))
+	}
+
+	selector := ""
+	if len(replicationDocument.Selector) > 0 {
+		selectorJSON, err := json.Marshal(replicationDocument.Selector)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error marshalling selector: %s", err))
+		}
+		selector = string(selectorJSON)
+	}
+	if err = d.Set("selector", selector); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting selector: %s", err))
+	}
+
+	if err = d.Set("rev", replicationDocument.Rev); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rev: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantReplicationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, docID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cloudantClient, err := getCloudantReplicationClient(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Updating the document restarts the replication with the new settings
+	err = putCloudantReplicationDocument(context, cloudantClient, docID, d.Get("rev").(string), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMCloudantReplicationRead(context, d, meta)
+}
+
+func resourceIBMCloudantReplicationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, docID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cloudantClient, err := getCloudantReplicationClient(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteReplicationDocumentOptions := cloudantClient.NewDeleteReplicationDocumentOptions(docID)
+	deleteReplicationDocumentOptions.SetRev(d.Get("rev").(string))
+
+	_, response, err := cloudantClient.DeleteReplicationDocumentWithContext(context, deleteReplicationDocumentOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return flex.ServiceErrorDiags("DeleteReplicationDocumentWithContext", err, response)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getCloudantReplicationClient(instanceCRN string, meta interface{}) (*cloudantv1.CloudantV1, error) {
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return nil, err
+	}
+	return GetCloudantClientForUrl(cUrl, meta)
+}
+
+func putCloudantReplicationDocument(context context.Context, cloudantClient *cloudantv1.CloudantV1, docID, rev string, d *schema.ResourceData) error {
+	replicationDocument := &cloudantv1.ReplicationDocument{
+		Source:       expandCloudantReplicationDatabase(d.Get("source").([]interface{})),
+		Target:       expandCloudantReplicationDatabase(d.Get("target").([]interface{})),
+		Continuous:   core.BoolPtr(d.Get("continuous").(bool)),
+		CreateTarget: core.BoolPtr(d.Get("create_target").(bool)),
+	}
+	if selector, ok := d.GetOk("selector"); ok {
+		var selectorMap map[string]interface{}
+		if err := json.Unmarshal([]byte(selector.(string)), &selectorMap); err != nil {
+			return fmt.Errorf("[ERROR] Error parsing selector: %s", err)
+		}
+		replicationDocument.Selector = selectorMap
+	}
+	if rev != "" {
+		replicationDocument.Rev = &rev
+	}
+
+	putReplicationDocumentOptions := cloudantClient.NewPutReplicationDocumentOptions(docID, replicationDocument)
+
+	_, response, err := cloudantClient.PutReplicationDocumentWithContext(context, putReplicationDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutReplicationDocumentWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("PutReplicationDocumentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func expandCloudantReplicationDatabase(databases []interface{}) *cloudantv1.ReplicationDatabase {
+	database := databases[0].(map[string]interface{})
+	replicationDatabase := &cloudantv1.ReplicationDatabase{
+		URL: flex.PtrToString(database["url"].(string)),
+	}
+	if apiKey := database["iam_api_key"].(string); apiKey != "" {
+		replicationDatabase.Auth = &cloudantv1.ReplicationDatabaseAuth{
+			Iam: &cloudantv1.ReplicationDatabaseAuthIam{
+				ApiKey: flex.PtrToString(apiKey),
+			},
+		}
+	}
+	return replicationDatabase
+}
+
+func flattenCloudantReplicationDatabase(database *cloudantv1.ReplicationDatabase, current []interface{}) []map[string]interface{} {
+	if database == nil {
+		return []map[string]interface{}{}
+	}
+	replicationDatabase := map[string]interface{}{
+		"url": database.URL,
+	}
+	if len(current) > 0 && current[0] != nil {
+		replicationDatabase["iam_api_key"] = current[0].(map[string]interface{})["iam_api_key"]
+	}
+	return []map[string]interface{}{replicationDatabase}
+}
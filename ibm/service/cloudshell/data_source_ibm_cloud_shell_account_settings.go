@@ -6,7 +6,6 @@ package cloudshell
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -125,8 +124,7 @@ func dataSourceIBMCloudShellAccountSettingsRead(context context.Context, d *sche
 
 	accountSettings, response, err := ibmCloudShellClient.GetAccountSettingsWithContext(context, getAccountSettingsOptions)
 	if err != nil || accountSettings == nil {
-		log.Printf("[DEBUG] GetAccountSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAccountSettingsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetAccountSettingsWithContext", err, response)
 	}
 
 	d.SetId(*accountSettings.AccountID)
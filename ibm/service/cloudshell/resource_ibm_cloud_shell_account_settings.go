@@ -6,7 +6,6 @@ package cloudshell
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -165,8 +164,7 @@ func resourceIBMCloudShellAccountSettingsCreate(context context.Context, d *sche
 
 	accountSettings, response, err := ibmCloudShellClient.UpdateAccountSettingsWithContext(context, updateAccountSettingsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] UpdateAccountSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateAccountSettingsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("UpdateAccountSettingsWithContext", err, response)
 	}
 
 	d.SetId(*accountSettings.ID)
@@ -212,12 +210,11 @@ func resourceIBMCloudShellAccountSettingsRead(context context.Context, d *schema
 
 	accountSettings, response, err := ibmCloudShellClient.GetAccountSettingsWithContext(context, getAccountSettingsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetAccountSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAccountSettingsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetAccountSettingsWithContext", err, response)
 	}
 
 	if err = d.Set("account_id", accountSettings.AccountID); err != nil {
@@ -347,8 +344,7 @@ func resourceIBMCloudShellAccountSettingsUpdate(context context.Context, d *sche
 	if hasChange {
 		_, response, err := ibmCloudShellClient.UpdateAccountSettingsWithContext(context, updateAccountSettingsOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateAccountSettingsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateAccountSettingsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateAccountSettingsWithContext", err, response)
 		}
 	}
 
@@ -6,7 +6,6 @@ package contextbasedrestrictions
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -189,8 +188,7 @@ func dataSourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, m
 
 	rule, response, err := contextBasedRestrictionsClient.GetRuleWithContext(context, getRuleOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetRuleWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getRuleOptions.RuleID))
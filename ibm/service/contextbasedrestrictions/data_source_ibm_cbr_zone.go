@@ -6,7 +6,6 @@ package contextbasedrestrictions
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -169,8 +168,7 @@ func dataSourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, m
 
 	zone, response, err := contextBasedRestrictionsClient.GetZoneWithContext(context, getZoneOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetZoneWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetZoneWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getZoneOptions.ZoneID))
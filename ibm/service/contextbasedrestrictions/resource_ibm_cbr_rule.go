@@ -6,7 +6,6 @@ package contextbasedrestrictions
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -295,8 +294,7 @@ func resourceIBMCbrRuleCreate(context context.Context, d *schema.ResourceData, m
 
 	rule, response, err := contextBasedRestrictionsClient.CreateRuleWithContext(context, createRuleOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRuleWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateRuleWithContext", err, response)
 	}
 
 	d.SetId(*rule.ID)
@@ -316,12 +314,11 @@ func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, met
 
 	rule, response, err := contextBasedRestrictionsClient.GetRuleWithContext(context, getRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetRuleWithContext", err, response)
 	}
 
 	if err = d.Set("x_correlation_id", getRuleOptions.XCorrelationID); err != nil {
@@ -452,8 +449,7 @@ func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, m
 
 	_, response, err := contextBasedRestrictionsClient.ReplaceRuleWithContext(context, replaceRuleOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ReplaceRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceRuleWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ReplaceRuleWithContext", err, response)
 	}
 
 	return resourceIBMCbrRuleRead(context, d, meta)
@@ -471,8 +467,7 @@ func resourceIBMCbrRuleDelete(context context.Context, d *schema.ResourceData, m
 
 	response, err := contextBasedRestrictionsClient.DeleteRuleWithContext(context, deleteRuleOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRuleWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteRuleWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package contextbasedrestrictions
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -282,8 +281,7 @@ func resourceIBMCbrZoneCreate(context context.Context, d *schema.ResourceData, m
 
 	zone, response, err := contextBasedRestrictionsClient.CreateZoneWithContext(context, createZoneOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateZoneWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateZoneWithContext", err, response)
 	}
 
 	d.SetId(*zone.ID)
@@ -303,12 +301,11 @@ func resourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, met
 
 	zone, response, err := contextBasedRestrictionsClient.GetZoneWithContext(context, getZoneOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetZoneWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetZoneWithContext", err, response)
 	}
 
 	if err = d.Set("x_correlation_id", getZoneOptions.XCorrelationID); err != nil {
@@ -435,8 +432,7 @@ func resourceIBMCbrZoneUpdate(context context.Context, d *schema.ResourceData, m
 
 	_, response, err := contextBasedRestrictionsClient.ReplaceZoneWithContext(context, replaceZoneOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ReplaceZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceZoneWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ReplaceZoneWithContext", err, response)
 	}
 
 	return resourceIBMCbrZoneRead(context, d, meta)
@@ -454,8 +450,7 @@ func resourceIBMCbrZoneDelete(context context.Context, d *schema.ResourceData, m
 
 	response, err := contextBasedRestrictionsClient.DeleteZoneWithContext(context, deleteZoneOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteZoneWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteZoneWithContext", err, response)
 	}
 
 	d.SetId("")
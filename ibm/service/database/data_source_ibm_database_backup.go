@@ -6,7 +6,6 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -77,8 +76,7 @@ func DataSourceIBMDatabaseBackupRead(context context.Context, d *schema.Resource
 
 	backup, response, err := cloudDatabasesClient.GetBackupInfoWithContext(context, getBackupInfoOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetBackupInfoWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetBackupInfoWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetBackupInfoWithContext", err, response)
 	}
 
 	d.SetId(*backup.Backup.ID)
@@ -6,13 +6,13 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 )
@@ -110,8 +110,7 @@ func DataSourceIBMDatabaseBackupsRead(context context.Context, d *schema.Resourc
 
 	backups, response, err := cloudDatabasesClient.ListDeploymentBackupsWithContext(context, listDeploymentBackupsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListDeploymentBackupsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListDeploymentBackupsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListDeploymentBackupsWithContext", err, response)
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
@@ -6,7 +6,6 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -1648,8 +1647,7 @@ func DataSourceIBMDatabaseConnectionRead(context context.Context, d *schema.Reso
 
 	connection, response, err := cloudDatabasesClient.GetConnectionWithContext(context, getConnectionOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetConnectionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConnectionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetConnectionWithContext", err, response)
 	}
 
 	d.SetId(DataSourceIBMDatabaseConnectionID(d))
@@ -6,9 +6,9 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -74,8 +74,7 @@ func dataSourceIBMDatabaseRemotesRead(context context.Context, d *schema.Resourc
 
 	remotes, response, err := cloudDatabasesClient.ListRemotesWithContext(context, listRemotesOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListRemotesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListRemotesWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListRemotesWithContext", err, response)
 	}
 
 	d.SetId(d.Get("deployment_id").(string))
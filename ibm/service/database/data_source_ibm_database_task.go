@@ -67,7 +67,7 @@ func dataSourceIBMDatabaseTaskRead(context context.Context, d *schema.ResourceDa
 	task, response, err := cloudDatabasesClient.GetTaskWithContext(context, getTaskOptions)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetTaskWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTaskWithContext", err, response)
 	}
 
 	d.SetId(*task.Task.ID)
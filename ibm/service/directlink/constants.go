@@ -68,6 +68,7 @@ const (
 	dlUpdatedAt                    = "updated_at"
 	dlVlan                         = "vlan"
 	dlWindowSize                   = "window_size"
+	dlWaitForMacSecSecured         = "wait_for_macsec_secured"
 	customerAccountID              = "customer_account_id"
 	dlRouteReports                 = "route_reports"
 	dlPrefix                       = "prefix"
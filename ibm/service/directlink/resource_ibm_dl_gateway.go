@@ -238,16 +238,18 @@ func ResourceIBMDLGateway() *schema.Resource {
 							Description: "Indicate whether MACsec protection should be active (true) or inactive (false) for this MACsec enabled gateway",
 						},
 						dlPrimaryCak: {
-							Type:        schema.TypeString,
-							Required:    true,
-							ForceNew:    false,
-							Description: "Desired primary connectivity association key. Keys for a MACsec configuration must have names with an even number of characters from [0-9a-fA-F]",
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     false,
+							ValidateFunc: validate.InvokeValidator("ibm_dl_gateway", dlPrimaryCak),
+							Description:  "Desired primary connectivity association key. Keys for a MACsec configuration must have names with an even number of characters from [0-9a-fA-F]",
 						},
 						dlFallbackCak: {
-							Type:        schema.TypeString,
-							Optional:    true,
-							ForceNew:    false,
-							Description: "Fallback connectivity association key. Keys used for MACsec configuration must have names with an even number of characters from [0-9a-fA-F]",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     false,
+							ValidateFunc: validate.InvokeValidator("ibm_dl_gateway", dlFallbackCak),
+							Description:  "Fallback connectivity association key. Keys used for MACsec configuration must have names with an even number of characters from [0-9a-fA-F]",
 						},
 						dlWindowSize: {
 							Type:        schema.TypeInt,
@@ -299,6 +301,12 @@ func ResourceIBMDLGateway() *schema.Resource {
 					},
 				},
 			},
+			dlWaitForMacSecSecured: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for MACsec to reach the secured status after the MACsec configuration of a provisioned gateway changes",
+			},
 			dlBgpCerCidr: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -495,10 +503,28 @@ func ResourceIBMDLGatewayValidator() *validate.ResourceValidator {
 			MinValue:                   "3",
 			MaxValue:                   "10"})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlPrimaryCak,
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     dlMacSecCakCrnRegexp})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlFallbackCak,
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     dlMacSecCakCrnRegexp})
+
 	ibmISDLGatewayResourceValidator := validate.ResourceValidator{ResourceName: "ibm_dl_gateway", Schema: validateSchema}
 	return &ibmISDLGatewayResourceValidator
 }
 
+// dlMacSecCakCrnRegexp matches the CRN of a Key Protect or Hyper Protect Crypto Services key
+const dlMacSecCakCrnRegexp = `^crn:v1:[^:]+:[^:]+:(kms|hs-crypto):[^:]*:[^:]*:[^:]+:key:[^:]+$`
+
 func directlinkClient(meta interface{}) (*directlinkv1.DirectLinkV1, error) {
 	sess, err := meta.(conns.ClientSession).DirectlinkV1API()
 	return sess, err
@@ -742,7 +768,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if _, ok := d.GetOk(dlTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -772,7 +798,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 
 	instance, response, err := directLink.GetGateway(getOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -993,6 +1019,38 @@ func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resou
 	}
 }
 
+func isWaitForDirectLinkMacSecSecured(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for MACsec of direct link (%s) to be secured.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", directlinkv1.GatewayMacsecConfig_Status_Init, directlinkv1.GatewayMacsecConfig_Status_Pending},
+		Target:     []string{directlinkv1.GatewayMacsecConfig_Status_Secured, ""},
+		Refresh:    isDirectLinkMacSecRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+func isDirectLinkMacSecRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getOptions := &directlinkv1.GetGatewayOptions{
+			ID: &id,
+		}
+		instance, response, err := client.GetGateway(getOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+		}
+		// MACsec only comes up once the cross connect is in place
+		if instance.OperationalStatus == nil || *instance.OperationalStatus != dlGatewayProvisioningDone {
+			return instance, "", nil
+		}
+		if instance.MacsecConfig == nil || instance.MacsecConfig.Status == nil {
+			return instance, "retry", nil
+		}
+		return instance, *instance.MacsecConfig.Status, nil
+	}
+}
+
 func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	directLink, err := directlinkClient(meta)
@@ -1190,6 +1248,13 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if dtype == "dedicated" && d.HasChange(dlMacSecConfig) && d.Get(dlWaitForMacSecSecured).(bool) && d.Get("macsec_config.0.active").(bool) {
+		_, err = isWaitForDirectLinkMacSecSecured(directLink, ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMdlGatewayRead(d, meta)
 }
 
@@ -1228,7 +1293,7 @@ func resourceIBMdlGatewayExists(d *schema.ResourceData, meta interface{}) (bool,
 	}
 	_, response, err := directLink.GetGateway(getOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return false, nil
 		}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -74,6 +75,43 @@ func TestAccIBMDLGatewayConnect_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMDLGatewayMacSecInvalidCak(t *testing.T) {
+	gatewayname := fmt.Sprintf("gateway-macsec-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMDLGatewayMacSecConfig(gatewayname, "my-cak"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("primary_cak"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLGatewayMacSecConfig(gatewayname, primaryCak string) string {
+	return fmt.Sprintf(`
+	resource ibm_dl_gateway test_dl_macsec_gateway {
+		bgp_asn              = 64999
+		global               = true
+		metered              = false
+		name                 = "%s"
+		speed_mbps           = 10000
+		type                 = "dedicated"
+		cross_connect_router = "LAB-xcr01.dal09"
+		location_name        = "dal09"
+		customer_name        = "Customer1"
+		carrier_name         = "Carrier1"
+		macsec_config {
+			active      = true
+			primary_cak = "%s"
+		}
+	}
+	`, gatewayname, primaryCak)
+}
+
 func testAccCheckIBMDLGatewayConfig(gatewayname, custname, carriername string) string {
 	return fmt.Sprintf(`
 	data "ibm_dl_routers" "test1" {
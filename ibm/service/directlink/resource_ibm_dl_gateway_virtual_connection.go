@@ -162,7 +162,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	getGatewayVirtualConnectionOptions.SetID(ID)
 	instance, response, err := directLink.GetGatewayVirtualConnection(getGatewayVirtualConnectionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -293,7 +293,7 @@ func resourceIBMdlGatewayVCExists(d *schema.ResourceData, meta interface{}) (boo
 	getVCOptions.SetGatewayID(gatewayId)
 	_, response, err := directLink.GetGatewayVirtualConnection(getVCOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return false, nil
 		}
@@ -232,7 +232,7 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 	log.Printf("[INFO] Created Direct Link Provider Gateway : %s", *gateway.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if _, ok := d.GetOk(dlTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -261,7 +261,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 
 	instance, response, err := directLink.GetProviderGateway(getOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -424,7 +424,7 @@ func resourceIBMdlProviderGatewayExists(d *schema.ResourceData, meta interface{}
 	getOptions := directLink.NewGetProviderGatewayOptions(ID)
 	_, response, err := directLink.GetProviderGateway(getOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return false, nil
 		}
@@ -273,7 +273,7 @@ func resouceIBMPrivateDNSCustomResolverRead(context context.Context, d *schema.R
 	result, response, err := sess.GetCustomResolverWithContext(context, opt)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
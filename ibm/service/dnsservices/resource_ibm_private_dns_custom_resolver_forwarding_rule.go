@@ -136,7 +136,7 @@ func resourceIbmDnsCrForwardingRuleRead(context context.Context, d *schema.Resou
 	result, resp, err := dnsSvcsClient.GetForwardingRuleWithContext(context, opt)
 
 	if err != nil || result == nil {
-		if resp != nil && resp.StatusCode == 404 {
+		if flex.IsResourceNotFound(resp) {
 			d.SetId("")
 			return nil
 		}
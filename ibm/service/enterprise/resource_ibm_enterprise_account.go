@@ -11,8 +11,10 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
@@ -161,6 +163,17 @@ func resourceIbmEnterpriseAccountCreate(context context.Context, d *schema.Resou
 			return diag.FromErr(err)
 		}
 		d.SetId(d.Get("account_id").(string))
+
+		// The import is asynchronous and places the account directly under the enterprise
+		account, err := waitForEnterpriseAccountImport(context, enterpriseManagementClient, d.Id(), d.Get("enterprise_id").(string), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for account (%s) to be imported: %s", d.Id(), err))
+		}
+		if parent := d.Get("parent").(string); account.Parent == nil || *account.Parent != parent {
+			if err := moveEnterpriseAccount(context, enterpriseManagementClient, d.Id(), parent, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	} else if checkCreateAccount(d) {
 		createAccountOptions := &enterprisemanagementv1.CreateAccountOptions{}
 		createAccountOptions.SetParent(d.Get("parent").(string))
@@ -194,7 +207,7 @@ func resourceIbmEnterpriseAccountRead(context context.Context, d *schema.Resourc
 
 	account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -289,9 +302,7 @@ func resourceIbmEnterpriseAccountUpdate(context context.Context, d *schema.Resou
 	//}
 
 	if hasChange {
-		response, err := enterpriseManagementClient.UpdateAccountWithContext(context, updateAccountOptions)
-		if err != nil {
-			log.Printf("[DEBUG] UpdateAccountWithContext failed %s\n%s", err, response)
+		if err := moveEnterpriseAccount(context, enterpriseManagementClient, d.Id(), *updateAccountOptions.Parent, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -305,3 +316,71 @@ func resourceIbmEnterpriseAccountDelete(context context.Context, d *schema.Resou
 
 	return nil
 }
+
+// moveEnterpriseAccount moves the account to a new parent and waits until the move is visible, so
+// that resources created under the new parent right after the move see the account there.
+func moveEnterpriseAccount(context context.Context, client *enterprisemanagementv1.EnterpriseManagementV1, accountID, parent string, timeout time.Duration) error {
+	updateAccountOptions := &enterprisemanagementv1.UpdateAccountOptions{}
+	updateAccountOptions.SetAccountID(accountID)
+	updateAccountOptions.SetParent(parent)
+	response, err := client.UpdateAccountWithContext(context, updateAccountOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateAccountWithContext failed %s\n%s", err, response)
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"moving"},
+		Target:  []string{"moved"},
+		Refresh: func() (interface{}, string, error) {
+			getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+			getAccountOptions.SetAccountID(accountID)
+			account, response, err := client.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] GetAccountWithContext failed %s\n%s", err, response)
+			}
+			if account.Parent != nil && *account.Parent == parent {
+				return account, "moved", nil
+			}
+			return account, "moving", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for account (%s) to move to %s: %s", accountID, parent, err)
+	}
+	return nil
+}
+
+func waitForEnterpriseAccountImport(context context.Context, client *enterprisemanagementv1.EnterpriseManagementV1, accountID, enterpriseID string, timeout time.Duration) (*enterprisemanagementv1.Account, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"importing"},
+		Target:  []string{"imported"},
+		Refresh: func() (interface{}, string, error) {
+			getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+			getAccountOptions.SetAccountID(accountID)
+			account, response, err := client.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				// The account is not visible through the enterprise until the import completes
+				if response != nil && (response.StatusCode == 403 || response.StatusCode == 404) {
+					return &enterprisemanagementv1.Account{}, "importing", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] GetAccountWithContext failed %s\n%s", err, response)
+			}
+			if account.EnterpriseID != nil && *account.EnterpriseID == enterpriseID {
+				return account, "imported", nil
+			}
+			return account, "importing", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	account, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, err
+	}
+	return account.(*enterprisemanagementv1.Account), nil
+}
@@ -54,6 +54,35 @@ func TestAccIbmEnterpriseAccountBasic(t *testing.T) {
 	})
 }
 
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise" */
+func TestAccIbmEnterpriseAccountMove(t *testing.T) {
+	var conf enterprisemanagementv1.Account
+	name := fmt.Sprintf("tf-gen-account-name_%d", acctest.RandIntRange(10, 100))
+	groupName := fmt.Sprintf("tf-gen-account-group-name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmEnterpriseAccountConfigMove(name, groupName, "data.ibm_enterprises.enterprises_instance.enterprises[0].crn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmEnterpriseAccountExists("ibm_enterprise_account.enterprise_account", conf),
+					resource.TestCheckResourceAttrPair("ibm_enterprise_account.enterprise_account", "parent", "data.ibm_enterprises.enterprises_instance", "enterprises.0.crn"),
+				),
+			},
+			{
+				Config: testAccCheckIbmEnterpriseAccountConfigMove(name, groupName, "ibm_enterprise_account_group.enterprise_account_group.crn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmEnterpriseAccountParent("ibm_enterprise_account.enterprise_account", "ibm_enterprise_account_group.enterprise_account_group"),
+					resource.TestCheckResourceAttrPair("ibm_enterprise_account.enterprise_account", "parent", "ibm_enterprise_account_group.enterprise_account_group", "crn"),
+					resource.TestCheckResourceAttr("ibm_enterprise_account.enterprise_account", "name", name),
+				),
+			},
+		},
+	})
+}
+
 /*
 	To run this test case ensure the IC_API_KEY belongs to an enterprise.
 
@@ -102,6 +131,23 @@ func testAccCheckIbmEnterpriseAccountConfigUpdateBasic(name string) string {
 	`, name)
 }
 
+func testAccCheckIbmEnterpriseAccountConfigMove(name, groupName, parent string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		resource "ibm_enterprise_account_group" "enterprise_account_group" {
+			parent = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name = "%s"
+			primary_contact_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+		}
+		resource "ibm_enterprise_account" "enterprise_account" {
+			parent = %s
+			name = "%s"
+			owner_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+		}
+	`, groupName, parent, name)
+}
+
 func testAccCheckIbmAccountsDataSourceConfigImportBasic(accountToBeImported string) string {
 
 	return fmt.Sprintf(`
@@ -141,3 +187,36 @@ func testAccCheckIbmEnterpriseAccountExists(n string, obj enterprisemanagementv1
 		return nil
 	}
 }
+
+func testAccCheckIbmEnterpriseAccountParent(n, parentName string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		parent, ok := s.RootModule().Resources[parentName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", parentName)
+		}
+
+		enterpriseManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).EnterpriseManagementV1()
+		if err != nil {
+			return err
+		}
+
+		getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+
+		getAccountOptions.SetAccountID(rs.Primary.ID)
+
+		account, _, err := enterpriseManagementClient.GetAccount(getAccountOptions)
+		if err != nil {
+			return err
+		}
+
+		if account.Parent == nil || *account.Parent != parent.Primary.Attributes["crn"] {
+			return fmt.Errorf("Account %s was not moved to %s", rs.Primary.ID, parent.Primary.Attributes["crn"])
+		}
+		return nil
+	}
+}
@@ -142,7 +142,7 @@ func dataSourceIBMEnAPNSDestinationRead(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.GetDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetDestination failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetDestination", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
@@ -52,7 +52,7 @@ func dataSourceIBMEnIntegrationRead(context context.Context, d *schema.ResourceD
 
 	result, response, err := enClient.GetIntegrationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetIntegration failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetIntegration", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
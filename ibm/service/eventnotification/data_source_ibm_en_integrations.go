@@ -93,7 +93,7 @@ func dataSourceIBMEnIntegrationsRead(context context.Context, d *schema.Resource
 		integrationList = result
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("ListIntegrationsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListIntegrationsWithContext", err, response)
 		}
 
 		offset = offset + limit
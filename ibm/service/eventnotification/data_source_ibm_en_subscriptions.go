@@ -123,7 +123,7 @@ func dataSourceIBMEnSubscriptionsRead(context context.Context, d *schema.Resourc
 		subscriptionList = result
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("ListSubscriptionsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListSubscriptionsWithContext", err, response)
 		}
 
 		offset = offset + limit
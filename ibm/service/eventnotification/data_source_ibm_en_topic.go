@@ -170,7 +170,7 @@ func dataSourceIBMEnTopicRead(context context.Context, d *schema.ResourceData, m
 	result, response, err := enClient.GetTopicWithContext(context, options)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetTopicWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTopicWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
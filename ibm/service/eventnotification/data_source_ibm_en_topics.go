@@ -111,7 +111,7 @@ func dataSourceIBMEnTopicsRead(context context.Context, d *schema.ResourceData,
 		topicList = result
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("ListTopicsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListTopicsWithContext", err, response)
 		}
 		offset = offset + limit
 
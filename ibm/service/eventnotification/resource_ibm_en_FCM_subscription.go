@@ -101,7 +101,7 @@ func resourceIBMEnFCMSubscriptionCreate(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSubscriptionWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -127,11 +127,11 @@ func resourceIBMEnFCMSubscriptionRead(context context.Context, d *schema.Resourc
 
 	result, response, err := enClient.GetSubscriptionWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSubscriptionWithContext", err, response)
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -214,7 +214,7 @@ func resourceIBMEnFCMSubscriptionUpdate(context context.Context, d *schema.Resou
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSubscriptionWithContext", err, response)
 		}
 
 		return resourceIBMEnFCMSubscriptionRead(context, d, meta)
@@ -241,11 +241,11 @@ func resourceIBMEnFCMSubscriptionDelete(context context.Context, d *schema.Resou
 
 	response, err := enClient.DeleteSubscriptionWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSubscriptionWithContext", err, response)
 	}
 
 	d.SetId("")
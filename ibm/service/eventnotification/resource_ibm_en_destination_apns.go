@@ -182,7 +182,7 @@ func resourceIBMEnAPNSDestinationCreate(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateDestinationWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -208,11 +208,11 @@ func resourceIBMEnAPNSDestinationRead(context context.Context, d *schema.Resourc
 
 	result, response, err := enClient.GetDestinationWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetDestinationWithContext", err, response)
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -305,7 +305,7 @@ func resourceIBMEnAPNSDestinationUpdate(context context.Context, d *schema.Resou
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateDestinationWithContext", err, response)
 		}
 
 		return resourceIBMEnAPNSDestinationRead(context, d, meta)
@@ -332,11 +332,11 @@ func resourceIBMEnAPNSDestinationDelete(context context.Context, d *schema.Resou
 
 	response, err := enClient.DeleteDestinationWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteDestinationWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -123,7 +123,7 @@ func resourceIBMEnCFDestinationCreate(context context.Context, d *schema.Resourc
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateDestinationWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -149,11 +149,11 @@ func resourceIBMEnCFDestinationRead(context context.Context, d *schema.ResourceD
 
 	result, response, err := enClient.GetDestinationWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetDestinationWithContext", err, response)
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -228,7 +228,7 @@ func resourceIBMEnCFDestinationUpdate(context context.Context, d *schema.Resourc
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateDestinationWithContext", err, response)
 		}
 
 		return resourceIBMEnCFDestinationRead(context, d, meta)
@@ -255,11 +255,11 @@ func resourceIBMEnCFDestinationDelete(context context.Context, d *schema.Resourc
 
 	response, err := enClient.DeleteDestinationWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteDestinationWithContext", err, response)
 	}
 
 	d.SetId("")
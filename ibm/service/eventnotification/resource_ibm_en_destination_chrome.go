@@ -129,7 +129,7 @@ func resourceIBMEnChromeDestinationCreate(context context.Context, d *schema.Res
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateDestinationWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -155,11 +155,11 @@ func resourceIBMEnChromeDestinationRead(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.GetDestinationWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetDestinationWithContext", err, response)
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -233,7 +233,7 @@ func resourceIBMEnChromeDestinationUpdate(context context.Context, d *schema.Res
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateDestinationWithContext", err, response)
 		}
 
 		return resourceIBMEnChromeDestinationRead(context, d, meta)
@@ -260,11 +260,11 @@ func resourceIBMEnChromeDestinationDelete(context context.Context, d *schema.Res
 
 	response, err := enClient.DeleteDestinationWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteDestinationWithContext", err, response)
 	}
 
 	d.SetId("")
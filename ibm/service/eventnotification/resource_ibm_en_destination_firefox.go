@@ -123,7 +123,7 @@ func resourceIBMEnFirefoxDestinationCreate(context context.Context, d *schema.Re
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateDestinationWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -149,11 +149,11 @@ func resourceIBMEnFirefoxDestinationRead(context context.Context, d *schema.Reso
 
 	result, response, err := enClient.GetDestinationWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetDestinationWithContext", err, response)
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -227,7 +227,7 @@ func resourceIBMEnFirefoxDestinationUpdate(context context.Context, d *schema.Re
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateDestinationWithContext", err, response)
 		}
 
 		return resourceIBMEnFirefoxDestinationRead(context, d, meta)
@@ -254,11 +254,11 @@ func resourceIBMEnFirefoxDestinationDelete(context context.Context, d *schema.Re
 
 	response, err := enClient.DeleteDestinationWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteDestinationWithContext", err, response)
 	}
 
 	d.SetId("")
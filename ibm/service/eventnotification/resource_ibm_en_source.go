@@ -78,7 +78,7 @@ func resourceIBMEnSourceCreate(context context.Context, d *schema.ResourceData,
 
 	result, response, err := enClient.CreateSourcesWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSourcesWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSourcesWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -104,11 +104,11 @@ func resourceIBMEnSourceRead(context context.Context, d *schema.ResourceData, me
 
 	result, response, err := enClient.GetSourceWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSourceWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSourceWithContext", err, response)
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -165,7 +165,7 @@ func resourceIBMEnSourceUpdate(context context.Context, d *schema.ResourceData,
 
 		_, response, err := enClient.UpdateSourceWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSourceWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSourceWithContext", err, response)
 		}
 
 		return resourceIBMEnSourceRead(context, d, meta)
@@ -192,11 +192,11 @@ func resourceIBMEnSourceDelete(context context.Context, d *schema.ResourceData,
 
 	response, err := enClient.DeleteSourceWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSourceWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSourceWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -157,7 +157,7 @@ func resourceIBMEnEmailSubscriptionCreate(context context.Context, d *schema.Res
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSubscriptionWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -183,11 +183,11 @@ func resourceIBMEnEmailSubscriptionRead(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.GetSubscriptionWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSubscriptionWithContext", err, response)
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -273,7 +273,7 @@ func resourceIBMEnEmailSubscriptionUpdate(context context.Context, d *schema.Res
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSubscriptionWithContext", err, response)
 		}
 
 		return resourceIBMEnEmailSubscriptionRead(context, d, meta)
@@ -300,11 +300,11 @@ func resourceIBMEnEmailSubscriptionDelete(context context.Context, d *schema.Res
 
 	response, err := enClient.DeleteSubscriptionWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSubscriptionWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -131,7 +131,7 @@ func resourceIBMEnSMSSubscriptionCreate(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSubscriptionWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -157,11 +157,11 @@ func resourceIBMEnSMSSubscriptionRead(context context.Context, d *schema.Resourc
 
 	result, response, err := enClient.GetSubscriptionWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSubscriptionWithContext", err, response)
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -247,7 +247,7 @@ func resourceIBMEnSMSSubscriptionUpdate(context context.Context, d *schema.Resou
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSubscriptionWithContext", err, response)
 		}
 
 		return resourceIBMEnSMSSubscriptionRead(context, d, meta)
@@ -274,11 +274,11 @@ func resourceIBMEnSMSSubscriptionDelete(context context.Context, d *schema.Resou
 
 	response, err := enClient.DeleteSubscriptionWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSubscriptionWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -120,7 +120,7 @@ func resourceIBMEnWebhookSubscriptionCreate(context context.Context, d *schema.R
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSubscriptionWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -146,11 +146,11 @@ func resourceIBMEnWebhookSubscriptionRead(context context.Context, d *schema.Res
 
 	result, response, err := enClient.GetSubscriptionWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSubscriptionWithContext", err, response)
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -236,7 +236,7 @@ func resourceIBMEnWebhookSubscriptionUpdate(context context.Context, d *schema.R
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSubscriptionWithContext", err, response)
 		}
 
 		return resourceIBMEnWebhookSubscriptionRead(context, d, meta)
@@ -263,11 +263,11 @@ func resourceIBMEnWebhookSubscriptionDelete(context context.Context, d *schema.R
 
 	response, err := enClient.DeleteSubscriptionWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSubscriptionWithContext", err, response)
 	}
 
 	d.SetId("")
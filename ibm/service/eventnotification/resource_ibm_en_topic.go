@@ -177,7 +177,7 @@ func resourceIBMEnTopicCreate(context context.Context, d *schema.ResourceData, m
 	result, response, err := enClient.CreateTopicWithContext(context, options)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateTopicWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateTopicWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -204,12 +204,12 @@ func resourceIBMEnTopicRead(context context.Context, d *schema.ResourceData, met
 
 	result, response, err := enClient.GetTopicWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.FromErr(fmt.Errorf("GetTopicWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTopicWithContext", err, response)
 	}
 
 	d.Set("instance_guid", options.InstanceID)
@@ -294,7 +294,7 @@ func resourceIBMEnTopicUpdate(context context.Context, d *schema.ResourceData, m
 
 	_, response, err := enClient.ReplaceTopicWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("ReplaceTopicWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ReplaceTopicWithContext", err, response)
 	}
 
 	return resourceIBMEnTopicRead(context, d, meta)
@@ -318,11 +318,11 @@ func resourceIBMEnTopicDelete(context context.Context, d *schema.ResourceData, m
 
 	response, err := enClient.DeleteTopicWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteTopicWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteTopicWithContext", err, response)
 	}
 
 	d.SetId("")
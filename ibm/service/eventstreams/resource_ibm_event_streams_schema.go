@@ -224,11 +224,11 @@ func resourceIBMEventStreamsSchemaRead(context context.Context, d *schema.Resour
 	avroSchema, response, err := schemaregistryClient.GetLatestSchemaWithContext(context, getSchemaOptions)
 	if err != nil || avroSchema == nil {
 		log.Printf("[DEBUG] GetSchemaWithContext failed with error: %s and response: \n%s", err, response)
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSchemaWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSchemaWithContext", err, response)
 	}
 
 	s, err := json.Marshal(avroSchema)
@@ -295,8 +295,7 @@ func resourceIBMEventStreamsSchemaDelete(context context.Context, d *schema.Reso
 
 	response, err := schemaregistryClient.DeleteSchemaWithContext(context, deleteSchemaOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteSchemaWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSchemaWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSchemaWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -25,6 +25,7 @@ const (
 	tagType      = "tag_type"
 	acccountID   = "acccount_id"
 	service      = "service"
+	replace      = "replace"
 )
 
 func ResourceIBMResourceTag() *schema.Resource {
@@ -68,6 +69,12 @@ func ResourceIBMResourceTag() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_resource_tag", tagType),
 				Description:  "Type of the tag. Only allowed values are: user, or service or access (default value : user)",
 			},
+			replace: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the tags of the tag type that are attached to the resource and not in tags are detached, so that the tags of the resource are exactly the tags of the configuration",
+			},
 			acccountID: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -115,17 +122,6 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 	var rType, tType string
 	resources := []globaltaggingv1.Resource{}
 
-	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
-	if err != nil {
-		return err
-	}
-	accountID := userDetails.UserAccount
-
-	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
-	}
-
 	resourceID := d.Get(resourceID).(string)
 	if v, ok := d.GetOk(resourceType); ok && v != nil {
 		rType = v.(string)
@@ -134,37 +130,34 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 	r := globaltaggingv1.Resource{ResourceID: flex.PtrToString(resourceID), ResourceType: flex.PtrToString(rType)}
 	resources = append(resources, r)
 
-	var add []string
-	if v, ok := d.GetOk(tags); ok {
-		tags := v.(*schema.Set)
-		for _, t := range tags.List() {
-			add = append(add, fmt.Sprint(t))
-		}
-	}
-
-	schematicTags := os.Getenv("IC_ENV_TAGS")
-	var envTags []string
-	if schematicTags != "" {
-		envTags = strings.Split(schematicTags, ",")
-		add = append(add, envTags...)
-	}
-
-	AttachTagOptions := &globaltaggingv1.AttachTagOptions{}
-	AttachTagOptions.Resources = resources
-	AttachTagOptions.TagNames = add
 	if v, ok := d.GetOk(tagType); ok && v != nil {
 		tType = v.(string)
-		AttachTagOptions.TagType = flex.PtrToString(tType)
+	}
 
-		if tType == service {
-			AttachTagOptions.AccountID = flex.PtrToString(accountID)
+	if d.Get(replace).(bool) {
+		err := flex.ReplaceGlobalTagsUsingCRN(meta, d.Get(tags).(*schema.Set), resourceID, rType, tType)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error replacing resource tags: %s", err)
+		}
+	} else {
+		var add []string
+		if v, ok := d.GetOk(tags); ok {
+			tags := v.(*schema.Set)
+			for _, t := range tags.List() {
+				add = append(add, fmt.Sprint(t))
+			}
+		}
+
+		schematicTags := os.Getenv("IC_ENV_TAGS")
+		var envTags []string
+		if schematicTags != "" {
+			envTags = strings.Split(schematicTags, ",")
+			add = append(add, envTags...)
 		}
-	}
 
-	if len(add) > 0 {
-		_, resp, err := gtClient.AttachTag(AttachTagOptions)
+		err := flex.AttachGlobalTags(meta, resources, add, tType)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error attaching resource tags : %v\n%s", resp, err)
+			return fmt.Errorf("[ERROR] Error attaching resource tags: %s", err)
 		}
 	}
 
@@ -212,10 +205,14 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting resource tags for: %s with error : %s", rID, err)
 	}
+	if d.Get(replace).(bool) {
+		tagList = flex.RemoveReplacedTags(meta, tagList, d.Get(tags).(*schema.Set), tType)
+	}
 
 	d.Set(resourceID, rID)
 	d.Set(resourceType, rType)
 	d.Set(tags, tagList)
+	d.Set(replace, d.Get(replace).(bool))
 
 	return nil
 }
@@ -238,7 +235,12 @@ func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) erro
 		tType = v.(string)
 	}
 
-	if _, ok := d.GetOk(tags); ok {
+	if d.Get(replace).(bool) {
+		err := flex.ReplaceGlobalTagsUsingCRN(meta, d.Get(tags).(*schema.Set), rID, rType, tType)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error replacing resource tags: %s", err)
+		}
+	} else if _, ok := d.GetOk(tags); ok {
 		oldList, newList := d.GetChange(tags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, rID, rType, tType)
 		if err != nil {
@@ -41,6 +41,38 @@ func TestAccResourceTag_Basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceTag_Replace(t *testing.T) {
+	name := fmt.Sprintf("tf-satellitelocation-%d", acctest.RandIntRange(10, 100))
+	managed_from := "wdc04"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+
+			{
+				Config: testAccCheckResourceTagCreate(name, managed_from),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceTagExists("ibm_resource_tag.tag"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckResourceTagReplace(name, managed_from),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceTagExists("ibm_resource_tag.tag"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "replace", "true"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "1"),
+				),
+			},
+			{
+				Config:   testAccCheckResourceTagReplace(name, managed_from),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckResourceTagExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		var resourceID string
@@ -92,3 +124,25 @@ func testAccCheckResourceTagCreate(name, managed_from string) string {
 	}
 `, name, managed_from)
 }
+
+func testAccCheckResourceTagReplace(name, managed_from string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_satellite_location" "location" {
+		location      = "%s"
+		managed_from  = "%s"
+		description	  = "satellite service"	
+		zones		  = ["us-east-1", "us-east-2", "us-east-3"]
+	}
+
+	data "ibm_satellite_location" "test_location" {
+		location  = ibm_satellite_location.location.id
+	}
+
+	resource "ibm_resource_tag" "tag" {
+		resource_id = data.ibm_satellite_location.test_location.crn
+		tags        = ["env:prod"]
+		replace     = true
+	}
+`, name, managed_from)
+}
@@ -6,7 +6,6 @@ package hpcs
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -184,8 +183,7 @@ func DataSourceIbmKeyTemplateRead(context context.Context, d *schema.ResourceDat
 
 	template, response, err := ukoClient.GetKeyTemplateWithContext(context, getKeyTemplateOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetKeyTemplateWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, d.Get("uko_vault").(string), *getKeyTemplateOptions.ID))
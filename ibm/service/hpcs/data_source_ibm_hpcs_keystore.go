@@ -6,7 +6,6 @@ package hpcs
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -230,8 +229,7 @@ func DataSourceIbmKeystoreRead(context context.Context, d *schema.ResourceData,
 	keystoreIntf, response, err := ukoClient.GetKeystoreWithContext(context, getKeystoreOptions)
 	keystore := keystoreIntf.(*ukov4.Keystore)
 	if err != nil {
-		log.Printf("[DEBUG] GetKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeystoreWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetKeystoreWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, vault_id, *getKeystoreOptions.ID))
@@ -6,7 +6,6 @@ package hpcs
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -285,8 +284,7 @@ func DataSourceIbmManagedKeyRead(context context.Context, d *schema.ResourceData
 
 	managedKey, response, err := ukoClient.GetManagedKeyWithContext(context, getManagedKeyOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetManagedKeyWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, vault_id, *getManagedKeyOptions.ID))
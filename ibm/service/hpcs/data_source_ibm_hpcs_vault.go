@@ -6,7 +6,6 @@ package hpcs
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -97,8 +96,7 @@ func DataSourceIbmVaultRead(context context.Context, d *schema.ResourceData, met
 
 	vault, response, err := ukoClient.GetVaultWithContext(context, getVaultOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVaultWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVaultWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, vault_id, *getVaultOptions.ID))
@@ -433,7 +433,7 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 
 	// Update Tags for this Resource using Global Tagging APIs
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -6,7 +6,6 @@ package hpcs
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -241,8 +240,7 @@ func ResourceIbmKeyTemplateCreate(context context.Context, d *schema.ResourceDat
 
 	template, response, err := ukoClient.CreateKeyTemplateWithContext(context, createKeyTemplateOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateKeyTemplateWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, d.Get("uko_vault").(string), *template.ID))
@@ -274,12 +272,11 @@ func ResourceIbmKeyTemplateRead(context context.Context, d *schema.ResourceData,
 
 	template, response, err := ukoClient.GetKeyTemplateWithContext(context, getKeyTemplateOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetKeyTemplateWithContext", err, response)
 	}
 
 	if err = d.Set("uko_vault", getKeyTemplateOptions.UKOVault); err != nil {
@@ -387,8 +384,7 @@ func ResourceIbmKeyTemplateUpdate(context context.Context, d *schema.ResourceDat
 	if hasChange {
 		_, response, err := ukoClient.UpdateKeyTemplateWithContext(context, updateKeyTemplateOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateKeyTemplateWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateKeyTemplateWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateKeyTemplateWithContext", err, response)
 		}
 	}
 
@@ -423,8 +419,7 @@ func ResourceIbmKeyTemplateDelete(context context.Context, d *schema.ResourceDat
 
 	response, err := ukoClient.DeleteKeyTemplateWithContext(context, deleteKeyTemplateOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteKeyTemplateWithContext", err, response)
 	}
 
 	d.SetId("")
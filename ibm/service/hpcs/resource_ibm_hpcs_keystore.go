@@ -6,7 +6,6 @@ package hpcs
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -257,8 +256,7 @@ func ResourceIbmKeystoreCreate(context context.Context, d *schema.ResourceData,
 
 	keystoreIntf, response, err := ukoClient.CreateKeystoreWithContext(context, createKeystoreOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateKeystoreWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateKeystoreWithContext", err, response)
 	}
 
 	keystore := keystoreIntf.(*ukov4.Keystore)
@@ -291,12 +289,11 @@ func ResourceIbmKeystoreRead(context context.Context, d *schema.ResourceData, me
 
 	keystoreIntf, response, err := ukoClient.GetKeystoreWithContext(context, getKeystoreOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeystoreWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetKeystoreWithContext", err, response)
 	}
 
 	keystore := keystoreIntf.(*ukov4.Keystore)
@@ -438,8 +435,7 @@ func ResourceIbmKeystoreUpdate(context context.Context, d *schema.ResourceData,
 	if hasChange {
 		_, response, err := ukoClient.UpdateKeystoreWithContext(context, updateKeystoreOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateKeystoreWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateKeystoreWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateKeystoreWithContext", err, response)
 		}
 	}
 
@@ -474,8 +470,7 @@ func ResourceIbmKeystoreDelete(context context.Context, d *schema.ResourceData,
 
 	response, err := ukoClient.DeleteKeystoreWithContext(context, deleteKeystoreOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteKeystoreWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteKeystoreWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package hpcs
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -373,8 +372,7 @@ func ResourceIbmManagedKeyCreate(context context.Context, d *schema.ResourceData
 
 	managedKey, response, err := ukoClient.CreateManagedKeyWithContext(context, createManagedKeyOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateManagedKeyWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, uko_vault, *managedKey.ID))
@@ -406,12 +404,11 @@ func ResourceIbmManagedKeyRead(context context.Context, d *schema.ResourceData,
 
 	managedKey, response, err := ukoClient.GetManagedKeyWithContext(context, getManagedKeyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetManagedKeyWithContext", err, response)
 	}
 
 	if err = d.Set("uko_vault", getManagedKeyOptions.UKOVault); err != nil {
@@ -569,8 +566,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 	if hasChange {
 		_, response, err := ukoClient.UpdateManagedKeyWithContext(context, updateManagedKeyOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateManagedKeyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateManagedKeyWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateManagedKeyWithContext", err, response)
 		}
 		etag = response.Headers.Get("Etag")
 	}
@@ -590,8 +586,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 
 				_, response, err := ukoClient.DeactivateManagedKeyWithContext(context, deactivateManagedKeyOptions)
 				if err != nil {
-					log.Printf("[DEBUG] DeactivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DeactivateManagedKeyWithContext failed %s\n%s", err, response))
+					return flex.ServiceErrorDiags("DeactivateManagedKeyWithContext", err, response)
 				}
 			} else {
 				return diag.FromErr(fmt.Errorf("Deactivate managed key failed: Cannot deactivate key not in active state"))
@@ -606,8 +601,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 
 				_, response, err := ukoClient.DestroyManagedKeyWithContext(context, destroyManagedKeyOptions)
 				if err != nil {
-					log.Printf("[DEBUG] DestroyManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DestroyManagedKeyWithContext failed %s\n%s", err, response))
+					return flex.ServiceErrorDiags("DestroyManagedKeyWithContext", err, response)
 				}
 			} else {
 				return diag.FromErr(fmt.Errorf("Destroy managed key failed: Cannot destroy key not in deactivated state"))
@@ -622,8 +616,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 
 				_, response, err := ukoClient.ActivateManagedKeyWithContext(context, activateManagedKeyOptions)
 				if err != nil {
-					log.Printf("[DEBUG] ActivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("ActivateManagedKeyWithContext failed %s\n%s", err, response))
+					return flex.ServiceErrorDiags("ActivateManagedKeyWithContext", err, response)
 				}
 			} else {
 				return diag.FromErr(fmt.Errorf("Activate managed key failed: Cannot activate key not in deactivated or pre_activation state"))
@@ -668,8 +661,7 @@ func ResourceIbmManagedKeyDelete(context context.Context, d *schema.ResourceData
 
 	response, err := ukoClient.DeleteManagedKeyWithContext(context, deleteManagedKeyOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteManagedKeyWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -150,8 +150,7 @@ func ResourceIbmVaultCreate(context context.Context, d *schema.ResourceData, met
 
 	vault, response, err := ukoClient.CreateVaultWithContext(context, createVaultOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateVaultWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateVaultWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instance_id, *vault.ID))
@@ -181,12 +180,11 @@ func ResourceIbmVaultRead(context context.Context, d *schema.ResourceData, meta
 
 	vault, response, err := ukoClient.GetVaultWithContext(context, getVaultOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVaultWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVaultWithContext", err, response)
 	}
 
 	if err = d.Set("vault_id", vault.ID); err != nil {
@@ -257,8 +255,7 @@ func ResourceIbmVaultUpdate(context context.Context, d *schema.ResourceData, met
 	if hasChange {
 		_, response, err := ukoClient.UpdateVaultWithContext(context, updateVaultOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateVaultWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateVaultWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateVaultWithContext", err, response)
 		}
 	}
 
@@ -290,8 +287,7 @@ func ResourceIbmVaultDelete(context context.Context, d *schema.ResourceData, met
 
 	response, err := ukoClient.DeleteVaultWithContext(context, deleteVaultOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteVaultWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteVaultWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,7 +44,7 @@ func resourceIBMIAMAccessGroupAccountSettingGet(context context.Context, d *sche
 	getAccessGroupOptions := iamAccessGroupsClient.NewGetAccountSettingsOptions(userDetails.UserAccount)
 	accountSetting, detailedResponse, err := iamAccessGroupsClient.GetAccountSettings(getAccessGroupOptions)
 	if err != nil || accountSetting == nil {
-		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
+		if flex.IsResourceNotFound(detailedResponse) {
 			d.SetId("")
 			return nil
 		}
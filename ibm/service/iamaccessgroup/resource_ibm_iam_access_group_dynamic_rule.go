@@ -159,7 +159,7 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 	rule, detailResponse, err := iamAccessGroupsClient.GetAccessGroupRule(getAccessGroupRuleOptions)
 
 	if err != nil || rule == nil {
-		if detailResponse != nil && detailResponse.StatusCode == 404 {
+		if flex.IsResourceNotFound(detailResponse) {
 			d.SetId("")
 			return nil
 		} else {
@@ -243,7 +243,7 @@ func resourceIBMIAMDynamicRuleDelete(d *schema.ResourceData, meta interface{}) e
 	removeAccessGroupRuleOptions := iamAccessGroupsClient.NewRemoveAccessGroupRuleOptions(grpID, ruleID)
 	detailedResponse, err := iamAccessGroupsClient.RemoveAccessGroupRule(removeAccessGroupRuleOptions)
 	if err != nil {
-		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
+		if flex.IsResourceNotFound(detailedResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -272,7 +272,7 @@ func resourceIBMIAMDynamicRuleExists(d *schema.ResourceData, meta interface{}) (
 	getAccessGroupRuleOptions := iamAccessGroupsClient.NewGetAccessGroupRuleOptions(grpID, ruleID)
 	rule, detailResponse, err := iamAccessGroupsClient.GetAccessGroupRule(getAccessGroupRuleOptions)
 
-	if detailResponse != nil && detailResponse.StatusCode == 404 {
+	if flex.IsResourceNotFound(detailResponse) {
 		d.SetId("")
 		return false, nil
 	}
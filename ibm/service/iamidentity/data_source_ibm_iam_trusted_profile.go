@@ -6,7 +6,6 @@ package iamidentity
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -152,8 +151,7 @@ func dataSourceIBMIamTrustedProfileRead(context context.Context, d *schema.Resou
 
 	trustedProfile, response, err := iamIdentityClient.GetProfile(getProfileOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfile failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetProfile", err, response)
 	}
 
 	d.SetId(*trustedProfile.ID)
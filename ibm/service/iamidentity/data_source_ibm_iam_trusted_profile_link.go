@@ -6,7 +6,6 @@ package iamidentity
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -114,8 +113,7 @@ func dataSourceIBMIamTrustedProfileLinkRead(context context.Context, d *schema.R
 
 	profileLink, response, err := iamIdentityClient.GetLink(getLinkOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetLink failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLink failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetLink", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getLinkOptions.ProfileID, *getLinkOptions.LinkID))
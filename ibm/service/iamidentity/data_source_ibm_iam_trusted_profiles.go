@@ -6,7 +6,6 @@ package iamidentity
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -204,8 +203,7 @@ func dataSourceIBMIamTrustedProfileListRead(context context.Context, d *schema.R
 
 		trustedProfiles, response, err := iamIdentityClient.ListProfiles(listProfileOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ListProfile failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListProfile failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListProfile", err, response)
 		}
 		start = flex.GetNextIAM(trustedProfiles.Next)
 		allrecs = append(allrecs, trustedProfiles.Profiles...)
@@ -6,10 +6,10 @@ package iamidentity
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -137,8 +137,7 @@ func dataSourceIBMIamTrustedProfileClaimRuleListRead(context context.Context, d
 
 	profileClaimRuleList, response, err := iamIdentityClient.ListClaimRules(listClaimRulesOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListClaimRules failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListClaimRules failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListClaimRules", err, response)
 	}
 
 	d.SetId(dataSourceIBMIamTrustedProfileClaimRuleListID(d))
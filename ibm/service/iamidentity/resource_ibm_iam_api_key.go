@@ -170,7 +170,7 @@ func resourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData, m
 
 	apiKey, response, err := iamIdentityClient.GetAPIKey(getApiKeyOptions)
 	if err != nil || apiKey == nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -111,7 +112,7 @@ func resourceIBMIAMServiceIDRead(context context.Context, d *schema.ResourceData
 	}
 	serviceID, resp, err := iamIdentityClient.GetServiceID(&getServiceIDOptions)
 	if err != nil || serviceID == nil {
-		if resp != nil && resp.StatusCode == 404 {
+		if flex.IsResourceNotFound(resp) {
 			d.SetId("")
 			return nil
 		}
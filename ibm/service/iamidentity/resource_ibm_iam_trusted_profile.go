@@ -6,7 +6,6 @@ package iamidentity
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -147,8 +146,7 @@ func resourceIBMIamTrustedProfileCreate(context context.Context, d *schema.Resou
 
 	trustedProfile, response, err := iamIdentityClient.CreateProfile(createProfileOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProfileWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateProfileWithContext", err, response)
 	}
 
 	d.SetId(*trustedProfile.ID)
@@ -168,12 +166,11 @@ func resourceIBMIamTrustedProfileRead(context context.Context, d *schema.Resourc
 
 	trustedProfile, response, err := iamIdentityClient.GetProfile(getProfileOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfile failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetProfile", err, response)
 	}
 
 	if err = d.Set("name", trustedProfile.Name); err != nil {
@@ -293,8 +290,7 @@ func resourceIBMIamTrustedProfileUpdate(context context.Context, d *schema.Resou
 
 	_, response, err := iamIdentityClient.UpdateProfile(updateProfileOptions)
 	if err != nil {
-		log.Printf("[DEBUG] UpdateProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateProfile failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("UpdateProfile", err, response)
 	}
 
 	return resourceIBMIamTrustedProfileRead(context, d, meta)
@@ -312,8 +308,7 @@ func resourceIBMIamTrustedProfileDelete(context context.Context, d *schema.Resou
 
 	response, err := iamIdentityClient.DeleteProfile(deleteProfileOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProfile failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteProfile", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package iamidentity
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -162,8 +161,7 @@ func resourceIBMIamTrustedProfileClaimRuleCreate(context context.Context, d *sch
 
 	profileClaimRule, response, err := iamIdentityClient.CreateClaimRule(createClaimRuleOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateClaimRule failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateClaimRule", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", profile, *profileClaimRule.ID))
@@ -237,12 +235,11 @@ func resourceIBMIamTrustedProfileClaimRuleRead(context context.Context, d *schem
 
 	profileClaimRule, response, err := iamIdentityClient.GetClaimRule(getClaimRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetClaimRule failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetClaimRule", err, response)
 	}
 
 	if err = d.Set("profile_id", getClaimRuleOptions.ProfileID); err != nil {
@@ -375,8 +372,7 @@ func resourceIBMIamTrustedProfileClaimRuleUpdate(context context.Context, d *sch
 
 	_, response, err := iamIdentityClient.UpdateClaimRule(updateClaimRuleOptions)
 	if err != nil {
-		log.Printf("[DEBUG] UpdateClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateClaimRule failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("UpdateClaimRule", err, response)
 	}
 
 	return resourceIBMIamTrustedProfileClaimRuleRead(context, d, meta)
@@ -399,8 +395,7 @@ func resourceIBMIamTrustedProfileClaimRuleDelete(context context.Context, d *sch
 
 	response, err := iamIdentityClient.DeleteClaimRule(deleteClaimRuleOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteClaimRule failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteClaimRule", err, response)
 	}
 
 	d.SetId("")
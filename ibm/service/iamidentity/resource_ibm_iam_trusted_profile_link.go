@@ -6,7 +6,6 @@ package iamidentity
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -130,8 +129,7 @@ func resourceIBMIamTrustedProfileLinkCreate(context context.Context, d *schema.R
 
 	profileLink, response, err := iamIdentityClient.CreateLink(createLinkOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateLink failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateLink failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateLink", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", profile, *profileLink.ID))
@@ -167,12 +165,11 @@ func resourceIBMIamTrustedProfileLinkRead(context context.Context, d *schema.Res
 
 	profileLink, response, err := iamIdentityClient.GetLink(getLinkOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetLink failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLink failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetLink", err, response)
 	}
 
 	if err = d.Set("profile_id", getLinkOptions.ProfileID); err != nil {
@@ -233,8 +230,7 @@ func resourceIBMIamTrustedProfileLinkDelete(context context.Context, d *schema.R
 
 	response, err := iamIdentityClient.DeleteLink(deleteLinkOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteLink failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteLink failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteLink", err, response)
 	}
 
 	d.SetId("")
@@ -172,7 +172,7 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 
 	role, response, err := iamPolicyManagementClient.GetRole(roleOptions)
 	if err != nil || role == nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -217,7 +217,7 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 
 		role, response, err := iamPolicyManagementClient.GetRole(roleGetOptions)
 		if err != nil || role == nil {
-			if response != nil && response.StatusCode == 404 {
+			if flex.IsResourceNotFound(response) {
 				d.SetId("")
 				return nil
 			}
@@ -6,7 +6,6 @@ package kubernetes
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -118,8 +117,7 @@ func resourceIbmContainerNlbDnsCreate(context context.Context, d *schema.Resourc
 	}
 	response, err := satClient.UpdateDNSWithIPWithContext(context, registerDNSWithIPOptions)
 	if err != nil {
-		log.Printf("[DEBUG] RegisterDNSWithIPWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("RegisterDNSWithIPWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("RegisterDNSWithIPWithContext", err, response)
 	}
 
 	d.SetId(*registerDNSWithIPOptions.IdOrName)
@@ -198,8 +196,7 @@ func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.Resourc
 				unregisterDNSWithIPOptions.SetNlbIP(r)
 				response, err := satClient.UnregisterDNSWithIPWithContext(context, unregisterDNSWithIPOptions)
 				if err != nil {
-					log.Printf("[DEBUG] UnregisterDNSWithIPWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("UnregisterDNSWithIPWithContext failed %s\n%s", err, response))
+					return flex.ServiceErrorDiags("UnregisterDNSWithIPWithContext", err, response)
 				}
 			}
 		}
@@ -213,8 +210,7 @@ func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.Resourc
 			updateDNSWithIPOptions.SetNlbIPArray(add)
 			response, err := satClient.UpdateDNSWithIPWithContext(context, updateDNSWithIPOptions)
 			if err != nil {
-				log.Printf("[DEBUG] RegisterDNSWithIPWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("RegisterDNSWithIPWithContext failed %s\n%s", err, response))
+				return flex.ServiceErrorDiags("RegisterDNSWithIPWithContext", err, response)
 			}
 		}
 	}
@@ -244,8 +240,7 @@ func resourceIbmContainerNlbDnsDelete(context context.Context, d *schema.Resourc
 			unregisterDNSWithIPOptions.SetNlbIP(i.(string))
 			response, err := satClient.UnregisterDNSWithIPWithContext(context, unregisterDNSWithIPOptions)
 			if err != nil {
-				log.Printf("[DEBUG] UnregisterDNSWithIPWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("UnregisterDNSWithIPWithContext failed %s\n%s", err, response))
+				return flex.ServiceErrorDiags("UnregisterDNSWithIPWithContext", err, response)
 			}
 		}
 	}
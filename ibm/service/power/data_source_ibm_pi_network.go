@@ -79,6 +79,14 @@ func DataSourceIBMPINetwork() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"mtu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dhcp_managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -124,6 +132,8 @@ func dataSourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("dns", networkdata.DNSServers)
 	}
 	d.Set("jumbo", networkdata.Jumbo)
+	d.Set("mtu", networkMtu(networkdata.Jumbo))
+	d.Set("dhcp_managed", networkdata.DhcpManaged)
 
 	return nil
 
@@ -138,6 +138,17 @@ const (
 	Attr_SPPPlacementGroupPolicy  = "policy"
 	Attr_SPPPlacementGroupName    = "name"
 
+	// Workspace
+	Arg_WorkspaceName             = "pi_name"
+	Arg_WorkspaceDatacenter       = "pi_datacenter"
+	Arg_WorkspaceResourceGroupID  = "pi_resource_group_id"
+	Arg_WorkspacePlan             = "pi_plan"
+	Arg_WorkspaceServiceEndpoints = "pi_service_endpoints"
+	Attr_WorkspaceCRN             = "crn"
+	Attr_WorkspaceStatus          = "status"
+	WorkspaceServiceName          = "power-iaas"
+	WorkspacePlanDefault          = "power-virtual-server-group"
+
 	// status
 	// common status states
 	StatusShutoff = "SHUTOFF"
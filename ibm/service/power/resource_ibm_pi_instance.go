@@ -711,6 +711,18 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange(helpers.PIInstancePinPolicy) {
+		// pinning controls whether the instance can be migrated off its host
+		body := &models.PVMInstanceUpdate{
+			PinPolicy: models.PinPolicy(d.Get(helpers.PIInstancePinPolicy).(string)),
+		}
+		// This is a synchronous process hence no need to check for health status
+		_, err = client.Update(instanceID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange(helpers.PIPlacementGroupID) {
 
 		pgClient := st.NewIBMPIPlacementGroupClient(ctx, sess, cloudInstanceID)
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
@@ -27,6 +28,11 @@ import (
 const (
 	piEndingIPAaddress   = "pi_ending_ip_address"
 	piStartingIPAaddress = "pi_starting_ip_address"
+	piNetworkMtu         = "pi_network_mtu"
+
+	// Power networks support the default MTU or jumbo frames
+	piNetworkMtuDefault = 1450
+	piNetworkMtuJumbo   = 9000
 )
 
 func ResourceIBMPINetwork() *schema.Resource {
@@ -66,6 +72,7 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
+				ForceNew:    true,
 				Description: "PI network CIDR",
 			},
 			helpers.PINetworkGateway: {
@@ -75,10 +82,21 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Description: "PI network gateway",
 			},
 			helpers.PINetworkJumbo: {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Computed:    true,
-				Description: "PI network enable MTU Jumbo option",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{piNetworkMtu},
+				Description:   "PI network enable MTU Jumbo option",
+			},
+			piNetworkMtu: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{helpers.PINetworkJumbo},
+				ValidateFunc:  validation.IntInSlice([]int{piNetworkMtuDefault, piNetworkMtuJumbo}),
+				Description:   "PI network maximum transmission unit, 1450 by default or 9000 for jumbo frames",
 			},
 			helpers.PICloudInstanceId: {
 				Type:        schema.TypeString,
@@ -117,6 +135,11 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Computed:    true,
 				Description: "VLAN Id value",
 			},
+			"dhcp_managed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the network is managed by a DHCP server",
+			},
 		},
 	}
 }
@@ -142,9 +165,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	if v, ok := d.GetOk(helpers.PINetworkJumbo); ok {
-		body.Jumbo = v.(bool)
-	}
+	body.Jumbo = expandNetworkJumbo(d)
 
 	if networktype == "vlan" {
 		var networkcidr string
@@ -216,6 +237,8 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(helpers.PINetworkName, networkdata.Name)
 	d.Set(helpers.PINetworkType, networkdata.Type)
 	d.Set(helpers.PINetworkJumbo, networkdata.Jumbo)
+	d.Set(piNetworkMtu, networkMtu(networkdata.Jumbo))
+	d.Set("dhcp_managed", networkdata.DhcpManaged)
 	d.Set(helpers.PINetworkGateway, networkdata.Gateway)
 	ipRangesMap := []map[string]interface{}{}
 	if networkdata.IPAddressRanges != nil {
@@ -366,6 +389,23 @@ func generateIPData(cdir string) (gway, firstip, lastip string, err error) {
 
 }
 
+// expandNetworkJumbo returns the jumbo flag for a network create request, the
+// client has no MTU field so pi_network_mtu is translated to the jumbo flag
+func expandNetworkJumbo(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk(piNetworkMtu); ok {
+		return v.(int) == piNetworkMtuJumbo
+	}
+	return d.Get(helpers.PINetworkJumbo).(bool)
+}
+
+// networkMtu returns the MTU matching the jumbo flag of a network
+func networkMtu(jumbo *bool) int {
+	if jumbo != nil && *jumbo {
+		return piNetworkMtuJumbo
+	}
+	return piNetworkMtuDefault
+}
+
 func getIPAddressRanges(ipAddressRanges []interface{}) []*models.IPAddressRange {
 	ipRanges := make([]*models.IPAddressRange, 0, len(ipAddressRanges))
 	for _, v := range ipAddressRanges {
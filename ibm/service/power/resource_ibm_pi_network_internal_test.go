@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandNetworkJumbo(t *testing.T) {
+	testCases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected bool
+	}{
+		{"default", map[string]interface{}{}, false},
+		{"mtu default without jumbo", map[string]interface{}{piNetworkMtu: piNetworkMtuDefault}, false},
+		{"mtu jumbo without jumbo", map[string]interface{}{piNetworkMtu: piNetworkMtuJumbo}, true},
+		{"jumbo without mtu", map[string]interface{}{helpers.PINetworkJumbo: true}, true},
+	}
+	for _, tc := range testCases {
+		d := schema.TestResourceDataRaw(t, ResourceIBMPINetwork().Schema, tc.raw)
+		if got := expandNetworkJumbo(d); got != tc.expected {
+			t.Errorf("%s: expected jumbo %t, got %t", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestNetworkMtu(t *testing.T) {
+	jumbo, noJumbo := true, false
+	if got := networkMtu(nil); got != piNetworkMtuDefault {
+		t.Errorf("expected %d for unset jumbo, got %d", piNetworkMtuDefault, got)
+	}
+	if got := networkMtu(&noJumbo); got != piNetworkMtuDefault {
+		t.Errorf("expected %d for jumbo false, got %d", piNetworkMtuDefault, got)
+	}
+	if got := networkMtu(&jumbo); got != piNetworkMtuJumbo {
+		t.Errorf("expected %d for jumbo true, got %d", piNetworkMtuJumbo, got)
+	}
+}
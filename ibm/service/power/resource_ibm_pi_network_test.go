@@ -88,6 +88,51 @@ func TestAccIBMPINetworkGatewaybasic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMPINetworkMtubasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-network-%d", acctest.RandIntRange(10, 100))
+	var networkID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPINetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkMtuConfig(name, "192.168.17.0/24", 9000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkExists("ibm_pi_network.power_networks"),
+					testAccCheckIBMPIResourceID("ibm_pi_network.power_networks", &networkID),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_network.power_networks", "pi_network_mtu", "9000"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_network.power_networks", "pi_network_jumbo", "true"),
+					resource.TestCheckResourceAttrSet("ibm_pi_network.power_networks", "dhcp_managed"),
+				),
+			},
+			{
+				// the network update API cannot change the MTU, the network is replaced
+				Config: testAccCheckIBMPINetworkMtuConfig(name, "192.168.17.0/24", 1450),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkExists("ibm_pi_network.power_networks"),
+					testAccCheckIBMPIResourceReplaced("ibm_pi_network.power_networks", &networkID),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_network.power_networks", "pi_network_mtu", "1450"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_network.power_networks", "pi_network_jumbo", "false"),
+				),
+			},
+			{
+				// the network update API cannot change the CIDR, the network is replaced
+				Config: testAccCheckIBMPINetworkMtuConfig(name, "192.168.18.0/24", 1450),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkExists("ibm_pi_network.power_networks"),
+					testAccCheckIBMPIResourceReplaced("ibm_pi_network.power_networks", &networkID),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_network.power_networks", "pi_cidr", "192.168.18.0/24"),
+				),
+			},
+		},
+	})
+}
 func testAccCheckIBMPINetworkDestroy(s *terraform.State) error {
 
 	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
@@ -190,3 +235,40 @@ func testAccCheckIBMPINetworkConfigGatewayUpdateDNS(name string) string {
 		}
 	`, acc.Pi_cloud_instance_id, name)
 }
+
+func testAccCheckIBMPIResourceID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIBMPIResourceReplaced(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("%s (%s) was updated in place, expected a replacement", n, *id)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIBMPINetworkMtuConfig(name, cidr string, mtu int) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_network" "power_networks" {
+			pi_cloud_instance_id = "%s"
+			pi_network_name      = "%s"
+			pi_network_type      = "vlan"
+			pi_cidr              = "%s"
+			pi_network_mtu       = %d
+		}
+	`, acc.Pi_cloud_instance_id, name, cidr, mtu)
+}
@@ -24,13 +24,11 @@ func ResourceIBMPIPlacementGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPIPlacementGroupCreate,
 		ReadContext:   resourceIBMPIPlacementGroupRead,
-		UpdateContext: resourceIBMPIPlacementGroupUpdate,
 		DeleteContext: resourceIBMPIPlacementGroupDelete,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
-			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
@@ -39,12 +37,14 @@ func ResourceIBMPIPlacementGroup() *schema.Resource {
 			helpers.PIPlacementGroupName: {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Name of the placement group",
 			},
 
 			helpers.PIPlacementGroupPolicy: {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{"affinity", "anti-affinity"}),
 				Description:  "Policy of the placement group",
 			},
@@ -52,6 +52,7 @@ func ResourceIBMPIPlacementGroup() *schema.Resource {
 			helpers.PICloudInstanceId: {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "PI cloud instance ID",
 			},
 
@@ -126,10 +127,6 @@ func resourceIBMPIPlacementGroupRead(ctx context.Context, d *schema.ResourceData
 
 }
 
-func resourceIBMPIPlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceIBMPIPlacementGroupRead(ctx, d, meta)
-}
-
 func resourceIBMPIPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -87,6 +87,35 @@ func TestAccIBMPIPlacementGroupBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIPlacementGroupPolicyReplace(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-placement-group-%d", acctest.RandIntRange(10, 100))
+	var placementGroupID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIPlacementGroupPolicyConfig(name, "affinity"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIPlacementGroupExists("ibm_pi_placement_group.power_placement_group"),
+					testAccCheckIBMPIResourceID("ibm_pi_placement_group.power_placement_group", &placementGroupID),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIPlacementGroupPolicyConfig(name, "anti-affinity"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIPlacementGroupExists("ibm_pi_placement_group.power_placement_group"),
+					testAccCheckIBMPIResourceReplaced("ibm_pi_placement_group.power_placement_group", &placementGroupID),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_placement_group.power_placement_group", "pi_placement_group_policy", "anti-affinity"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIPlacementGroupDestroy(s *terraform.State) error {
 
 	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
@@ -383,6 +412,16 @@ func testAccCheckIBMPIPlacementGroupConfig(name string, policy string) string {
 	`, acc.Pi_cloud_instance_id, name, policy, acc.Pi_image, acc.Pi_network_name)
 }
 
+func testAccCheckIBMPIPlacementGroupPolicyConfig(name string, policy string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_placement_group" "power_placement_group" {
+			pi_cloud_instance_id      = "%s"
+			pi_placement_group_name   = "%s"
+			pi_placement_group_policy = "%s"
+		}
+	`, acc.Pi_cloud_instance_id, name, policy)
+}
+
 func testAccCheckIBMPIPlacementGroupAddMemberConfig(name string, policy string) string {
 	return fmt.Sprintf(`
 		resource "ibm_pi_key" "key" {
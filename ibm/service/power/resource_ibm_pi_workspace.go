@@ -0,0 +1,310 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMPIWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIWorkspaceCreate,
+		ReadContext:   resourceIBMPIWorkspaceRead,
+		UpdateContext: resourceIBMPIWorkspaceUpdate,
+		DeleteContext: resourceIBMPIWorkspaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_WorkspaceName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Name of the workspace",
+			},
+			Arg_WorkspaceDatacenter: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Datacenter in which the workspace is provisioned, for example dal12",
+			},
+
+			// Optional Arguments
+			Arg_WorkspaceResourceGroupID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group of the workspace, the default resource group is used when omitted",
+			},
+			Arg_WorkspacePlan: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     WorkspacePlanDefault,
+				Description: "Name of the service plan of the workspace",
+			},
+			Arg_WorkspaceServiceEndpoints: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "public-and-private"}),
+				Description:  "Types of service endpoints of the workspace. Possible values are 'public', 'private', 'public-and-private'",
+			},
+
+			// Attributes
+			Attr_WorkspaceCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the workspace",
+			},
+			Attr_WorkspaceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the workspace",
+			},
+		},
+	}
+}
+
+func resourceIBMPIWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	name := d.Get(Arg_WorkspaceName).(string)
+	datacenter := d.Get(Arg_WorkspaceDatacenter).(string)
+	plan := d.Get(Arg_WorkspacePlan).(string)
+
+	serviceOff, err := rsCatRepo.FindByName(WorkspaceServiceName, true)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error retrieving service offering %s: %s", WorkspaceServiceName, err)
+	}
+	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error retrieving plan %s: %s", plan, err)
+	}
+	deployments, err := rsCatRepo.ListDeployments(servicePlan)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error retrieving deployment for plan %s: %s", plan, err)
+	}
+	deployments, supportedLocations := resourcecontroller.FilterDeployments(deployments, datacenter)
+	if len(deployments) == 0 {
+		return diag.Errorf("[ERROR] No deployment found for plan %s in datacenter %s, valid datacenters are: %q", plan, datacenter, flattenWorkspaceLocations(supportedLocations))
+	}
+
+	rsInst := &rc.CreateResourceInstanceOptions{
+		Name:           &name,
+		Target:         &deployments[0].CatalogCRN,
+		ResourcePlanID: &servicePlan,
+	}
+	if rg, ok := d.GetOk(Arg_WorkspaceResourceGroupID); ok {
+		rsInst.ResourceGroup = flex.PtrToString(rg.(string))
+	} else {
+		defaultRg, err := flex.DefaultResourceGroup(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rsInst.ResourceGroup = &defaultRg
+	}
+	if endpoints, ok := d.GetOk(Arg_WorkspaceServiceEndpoints); ok {
+		rsInst.Parameters = map[string]interface{}{
+			"service-endpoints": endpoints.(string),
+		}
+	}
+
+	instance, resp, err := rsConClient.CreateResourceInstanceWithContext(ctx, rsInst)
+	if err != nil {
+		log.Printf("[DEBUG] create workspace failed %v", err)
+		return diag.Errorf("[ERROR] Error creating workspace %s: %s with resp code: %s", name, err, resp)
+	}
+
+	d.SetId(*instance.GUID)
+
+	_, err = waitForIBMPIWorkspaceStatus(ctx, rsConClient, d.Id(), []string{resourcecontroller.RsInstanceProvisioningStatus, resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{
+		ID: flex.PtrToString(d.Id()),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[DEBUG] workspace does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving workspace %s: %s with resp code: %s", d.Id(), err, resp)
+	}
+	if instance.State != nil && *instance.State == resourcecontroller.RsInstanceRemovedStatus {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(Arg_WorkspaceName, instance.Name)
+	d.Set(Arg_WorkspaceResourceGroupID, instance.ResourceGroupID)
+	d.Set(Arg_WorkspaceDatacenter, instance.RegionID)
+	if endpoints, ok := instance.Parameters["service-endpoints"]; ok {
+		d.Set(Arg_WorkspaceServiceEndpoints, endpoints)
+	}
+	d.Set(Attr_WorkspaceCRN, instance.CRN)
+	d.Set(Attr_WorkspaceStatus, instance.State)
+
+	return nil
+}
+
+func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(Arg_WorkspaceName, Arg_WorkspaceServiceEndpoints) {
+		body := &rc.UpdateResourceInstanceOptions{
+			ID: flex.PtrToString(d.Id()),
+		}
+		if d.HasChange(Arg_WorkspaceName) {
+			body.Name = flex.PtrToString(d.Get(Arg_WorkspaceName).(string))
+		}
+		if d.HasChange(Arg_WorkspaceServiceEndpoints) {
+			body.Parameters = map[string]interface{}{
+				"service-endpoints": d.Get(Arg_WorkspaceServiceEndpoints).(string),
+			}
+		}
+		_, resp, err := rsConClient.UpdateResourceInstanceWithContext(ctx, body)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error updating workspace %s: %s with resp code: %s", d.Id(), err, resp)
+		}
+
+		_, err = waitForIBMPIWorkspaceStatus(ctx, rsConClient, d.Id(), []string{resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	recursive := true
+	resp, err := rsConClient.DeleteResourceInstanceWithContext(ctx, &rc.DeleteResourceInstanceOptions{
+		ID:        flex.PtrToString(d.Id()),
+		Recursive: &recursive,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[DEBUG] workspace does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error deleting workspace %s: %s with resp code: %s", d.Id(), err, resp)
+	}
+
+	_, err = waitForIBMPIWorkspaceDeleted(ctx, rsConClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForIBMPIWorkspaceStatus(ctx context.Context, client *rc.ResourceControllerV2, id string, pending []string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{resourcecontroller.RsInstanceSuccessStatus},
+		Refresh: func() (interface{}, string, error) {
+			instance, resp, err := client.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{
+				ID: &id,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Get the workspace %s failed with resp code: %s, err: %v", id, resp, err)
+			}
+			if *instance.State == resourcecontroller.RsInstanceFailStatus {
+				return instance, *instance.State, fmt.Errorf("[ERROR] The workspace %s failed to provision", id)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func waitForIBMPIWorkspaceDeleted(ctx context.Context, client *rc.ResourceControllerV2, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus, resourcecontroller.RsInstanceSuccessStatus},
+		Target:  []string{resourcecontroller.RsInstanceRemovedStatus, resourcecontroller.RsInstanceReclamation},
+		Refresh: func() (interface{}, string, error) {
+			instance, resp, err := client.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{
+				ID: &id,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					return instance, resourcecontroller.RsInstanceRemovedStatus, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Get the workspace %s failed with resp code: %s, err: %v", id, resp, err)
+			}
+			if *instance.State == resourcecontroller.RsInstanceFailStatus {
+				return instance, *instance.State, fmt.Errorf("[ERROR] The workspace %s failed to delete", id)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func flattenWorkspaceLocations(locations map[string]bool) []string {
+	result := make([]string, 0, len(locations))
+	for l := range locations {
+		result = append(result, l)
+	}
+	return result
+}
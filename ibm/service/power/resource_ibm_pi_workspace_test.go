@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIWorkspaceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-workspace-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIWorkspaceConfig(name, "public"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists("ibm_pi_workspace.powervs_service_instance"),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "pi_name", name),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "status", "active"),
+					resource.TestCheckResourceAttrSet("ibm_pi_workspace.powervs_service_instance", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIWorkspaceConfig(name, "public-and-private"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists("ibm_pi_workspace.powervs_service_instance"),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "pi_service_endpoints", "public-and-private"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIWorkspaceConfig(name, endpoints string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_workspace" "powervs_service_instance" {
+			pi_name              = "%s"
+			pi_datacenter        = "%s"
+			pi_service_endpoints = "%s"
+		}
+	`, name, acc.Pi_workspace_datacenter, endpoints)
+}
+
+func testAccCheckIBMPIWorkspaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+		client, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetResourceInstance(&rc.GetResourceInstanceOptions{
+			ID: &rs.Primary.ID,
+		})
+		return err
+	}
+}
+
+func testAccCheckIBMPIWorkspaceDestroy(s *terraform.State) error {
+	client, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_workspace" {
+			continue
+		}
+		instance, _, err := client.GetResourceInstance(&rc.GetResourceInstanceOptions{
+			ID: &rs.Primary.ID,
+		})
+		if err == nil && *instance.State == "active" {
+			return fmt.Errorf("PI Workspace still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -148,7 +148,7 @@ func resourceIBMCrNamespaceRead(context context.Context, d *schema.ResourceData,
 	namespaceDetailsList, response, err := containerRegistryClient.ListNamespaceDetailsWithContext(context, listNamespaceDetailsOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
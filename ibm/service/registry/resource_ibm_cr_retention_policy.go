@@ -82,7 +82,7 @@ func resourceIBMCrRetentionPolicyRead(context context.Context, d *schema.Resourc
 
 	retentionPolicy, response, err := containerRegistryClient.GetRetentionPolicyWithContext(context, getRetentionPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -101,9 +101,10 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				ConflictsWith: []string{"parameters_json"},
 			},
 			"parameters_json": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ConflictsWith: []string{"parameters"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"parameters"},
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
 				StateFunc: func(v interface{}) string {
 					json, err := flex.NormalizeJSONString(v)
 					if err != nil {
@@ -520,7 +521,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -878,8 +879,11 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		ID: &instanceID,
 	}
 
+	// Plan and parameter changes are applied asynchronously by the service broker while the
+	// instance stays active, so the last operation tells when the update is done
+	brokerUpdate := d.HasChanges("plan", "parameters", "parameters_json", "service_endpoints")
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus},
+		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceProvisioningStatus},
 		Target:  []string{RsInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
 			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
@@ -892,6 +896,18 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 			if *instance.State == RsInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %v", d.Id(), err)
 			}
+			if brokerUpdate && instance.LastOperation != nil && instance.LastOperation.State != nil {
+				switch *instance.LastOperation.State {
+				case rc.ResourceInstanceLastOperationStateInProgressConst:
+					return instance, RsInstanceProgressStatus, nil
+				case rc.ResourceInstanceLastOperationStateFailedConst:
+					description := ""
+					if instance.LastOperation.Description != nil {
+						description = *instance.LastOperation.Description
+					}
+					return instance, RsInstanceFailStatus, fmt.Errorf("[ERROR] The update of resource instance %s failed: %s", d.Id(), description)
+				}
+			}
 			return instance, *instance.State, nil
 		},
 		Timeout:    d.Timeout(schema.TimeoutUpdate),
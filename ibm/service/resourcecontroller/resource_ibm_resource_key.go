@@ -75,6 +75,13 @@ func ResourceIBMResourceKey() *schema.Resource {
 				Description:      "Arbitrary parameters to pass. Must be a JSON object",
 			},
 
+			"store_credentials": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to store the credentials of the key in the Terraform state. When false, credentials and credentials_json are left empty",
+			},
+
 			"credentials": {
 				Description: "Credentials asociated with the key",
 				Type:        schema.TypeMap,
@@ -285,6 +292,9 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceIBMResourceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("store_credentials") {
+		return resourceIBMResourceKeyRead(d, meta)
+	}
 	return nil
 }
 
@@ -302,17 +312,28 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil || resourceKey == nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource key: %s with resp : %s", err, resp)
 	}
-	var credInterface map[string]interface{}
-	cred, _ := json.Marshal(resourceKey.Credentials)
-	json.Unmarshal(cred, &credInterface)
-	d.Set("credentials", flex.Flatten(credInterface))
-
-	creds, err := json.Marshal(resourceKey.Credentials)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+	// Imported keys have no store_credentials yet, they keep the default
+	storeCredentials, ok := d.GetOkExists("store_credentials")
+	if !ok {
+		storeCredentials = true
+		d.Set("store_credentials", true)
 	}
-	if err = d.Set("credentials_json", string(creds)); err != nil {
-		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+	if storeCredentials.(bool) {
+		var credInterface map[string]interface{}
+		cred, _ := json.Marshal(resourceKey.Credentials)
+		json.Unmarshal(cred, &credInterface)
+		d.Set("credentials", flex.Flatten(credInterface))
+
+		creds, err := json.Marshal(resourceKey.Credentials)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+		}
+		if err = d.Set("credentials_json", string(creds)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+		}
+	} else {
+		d.Set("credentials", nil)
+		d.Set("credentials_json", "")
 	}
 	d.Set("name", *resourceKey.Name)
 	d.Set("status", *resourceKey.State)
@@ -47,6 +47,35 @@ func TestAccIBMResourceKey_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceKey_WithoutStoredCredentials(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyStoreCredentials(resourceName, resourceKey, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "store_credentials", "false"),
+					resource.TestCheckNoResourceAttr("ibm_resource_key.resourceKey", "credentials.%"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "credentials_json", ""),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceKeyStoreCredentials(resourceName, resourceKey, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "store_credentials", "true"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "credentials_json"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMResourceKey_With_Tags(t *testing.T) {
 	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
 	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
@@ -197,6 +226,24 @@ func testAccCheckIBMResourceKeyBasic(resourceName, resourceKey string) string {
 	`, resourceName, resourceKey)
 }
 
+func testAccCheckIBMResourceKeyStoreCredentials(resourceName, resourceKey string, storeCredentials bool) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_resource_instance" "resource" {
+			name              = "%s"
+			service           = "cloud-object-storage"
+			plan              = "standard"
+			location          = "global"
+		}
+		resource "ibm_resource_key" "resourceKey" {
+			name                 = "%s"
+			resource_instance_id = ibm_resource_instance.resource.id
+			role                 = "Reader"
+			store_credentials    = %t
+		}
+	`, resourceName, resourceKey, storeCredentials)
+}
+
 func testAccCheckIBMResourceKeyWithCustomRole(resourceName, resourceKey, crName, displayName string) string {
 	return fmt.Sprintf(`
 		
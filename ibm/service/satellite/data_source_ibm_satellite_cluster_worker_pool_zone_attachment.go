@@ -7,6 +7,7 @@ import (
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -80,8 +81,7 @@ func dataSourceIBMSatelliteClusterWorkerPoolAttachmentRead(context context.Conte
 			log.Printf("[DEBUG] Satellite cluster workerpool zone attachment record is not found %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("Satellite cluster workerpool zone attachment record is not found %s\n%s", err, response))
 		}
-		log.Printf("[DEBUG] GetWorkerPoolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkerPoolWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetWorkerPoolWithContext", err, response)
 	}
 
 	if getWorkerPoolResponse != nil && getWorkerPoolResponse.Zones != nil {
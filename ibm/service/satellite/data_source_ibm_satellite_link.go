@@ -6,10 +6,10 @@ package satellite
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -144,8 +144,7 @@ func dataSourceIbmSatelliteLinkRead(context context.Context, d *schema.ResourceD
 
 	location, response, err := satelliteLinkClient.GetLinkWithContext(context, getLinkOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetLinkWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLinkWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetLinkWithContext", err, response)
 	}
 
 	d.SetId(*location.LocationID)
@@ -6,7 +6,6 @@ package satellite
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -83,8 +82,7 @@ func resourceIbmSatelliteClusterWorkerPoolZoneAttachmentCreate(context context.C
 
 	response, err := satClient.CreateSatelliteWorkerPoolZone(createSatelliteWorkerPoolZoneOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateSatelliteWorkerPoolZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSatelliteWorkerPoolZoneWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSatelliteWorkerPoolZoneWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, workerpool, zone))
@@ -109,12 +107,11 @@ func resourceIbmSatelliteClusterWorkerPoolZoneAttachmentRead(context context.Con
 
 	getWorkerPoolResponse, response, err := satClient.GetWorkerPoolWithContext(context, getWorkerPoolOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetWorkerPool1WithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkerPool1WithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetWorkerPool1WithContext", err, response)
 	}
 
 	if getWorkerPoolResponse != nil && getWorkerPoolResponse.Zones != nil {
@@ -152,8 +149,7 @@ func resourceIbmSatelliteClusterWorkerPoolZoneAttachmentDelete(context context.C
 
 	response, err := satClient.RemoveWorkerPoolZoneWithContext(context, removeWorkerPoolZoneOptions)
 	if err != nil {
-		log.Printf("[DEBUG] RemoveWorkerPoolZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("RemoveWorkerPoolZoneWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("RemoveWorkerPoolZoneWithContext", err, response)
 	}
 
 	d.SetId("")
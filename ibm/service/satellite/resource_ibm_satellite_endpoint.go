@@ -6,7 +6,6 @@ package satellite
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -444,8 +443,7 @@ func resourceIbmSatelliteEndpointCreate(context context.Context, d *schema.Resou
 
 	endpoint, response, err := satelliteLinkClient.CreateEndpointsWithContext(context, createEndpointsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateEndpointsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateEndpointsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateEndpointsWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createEndpointsOptions.LocationID, *endpoint.EndpointID))
@@ -592,12 +590,11 @@ func resourceIbmSatelliteEndpointRead(context context.Context, d *schema.Resourc
 
 	endpoint, response, err := satelliteLinkClient.GetEndpointsWithContext(context, getEndpointsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] ListEndpointsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListEndpointsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListEndpointsWithContext", err, response)
 	}
 
 	if endpoint.EndpointID != nil {
@@ -896,8 +893,7 @@ func resourceIbmSatelliteEndpointUpdate(context context.Context, d *schema.Resou
 	if hasChange {
 		_, response, err := satelliteLinkClient.UpdateEndpointsWithContext(context, updateEndpointsOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateEndpointsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateEndpointsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateEndpointsWithContext", err, response)
 		}
 	}
 
@@ -1025,8 +1021,7 @@ func resourceIbmSatelliteEndpointDelete(context context.Context, d *schema.Resou
 
 	_, response, err := satelliteLinkClient.DeleteEndpointsWithContext(context, deleteEndpointsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteEndpointsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteEndpointsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteEndpointsWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -209,7 +209,7 @@ func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
 	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
+		if flex.IsResourceNotFound(resp) {
 			d.SetId("")
 			return nil
 		}
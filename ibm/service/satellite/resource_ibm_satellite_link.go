@@ -157,8 +157,7 @@ func resourceIbmSatelliteLinkCreate(context context.Context, d *schema.ResourceD
 
 	location, response, err := satelliteLinkClient.CreateLinkWithContext(context, createLinkOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateLinkWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateLinkWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateLinkWithContext", err, response)
 	}
 
 	d.SetId(*location.LocationID)
@@ -183,12 +182,11 @@ func resourceIbmSatelliteLinkRead(context context.Context, d *schema.ResourceDat
 
 	link, response, err := satelliteLinkClient.GetLinkWithContext(context, getLinkOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetLinkWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLinkWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetLinkWithContext", err, response)
 	}
 
 	getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
@@ -197,11 +195,11 @@ func resourceIbmSatelliteLinkRead(context context.Context, d *schema.ResourceDat
 
 	locInstance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
 	if err != nil || locInstance == nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSatelliteLocation failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSatelliteLocation", err, response)
 	}
 
 	d.Set("crn", *locInstance.Crn)
@@ -298,8 +296,7 @@ func resourceIbmSatelliteLinkUpdate(context context.Context, d *schema.ResourceD
 	if hasChange {
 		_, response, err := satelliteLinkClient.UpdateLinkWithContext(context, updateLinkOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateLinkWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateLinkWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateLinkWithContext", err, response)
 		}
 	}
 
@@ -318,8 +315,7 @@ func resourceIbmSatelliteLinkDelete(context context.Context, d *schema.ResourceD
 
 	_, response, err := satelliteLinkClient.DeleteLinkWithContext(context, deleteLinkOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteLinkWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteLinkWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteLinkWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -284,7 +284,7 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 	log.Printf("[INFO] Created satellite location : %s", satLocation)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -315,7 +315,7 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 
 	instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
 	if err != nil || instance == nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -66,8 +66,7 @@ func resourceIbmSatelliteLocationNlbDnsCreate(context context.Context, d *schema
 
 	mscRegisterResp, response, err := satClient.RegisterMultishiftClusterWithContext(context, registerMultishiftClusterOptions)
 	if err != nil {
-		log.Printf("[DEBUG] RegisterMultishiftClusterWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("RegisterMultishiftClusterWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("RegisterMultishiftClusterWithContext", err, response)
 	}
 	d.SetId(*mscRegisterResp.Controller)
 
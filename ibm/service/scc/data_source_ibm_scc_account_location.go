@@ -6,9 +6,9 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -84,8 +84,7 @@ func dataSourceIbmSccAccountLocationRead(context context.Context, d *schema.Reso
 
 	location, response, err := adminServiceApiClient.GetLocationWithContext(context, getLocationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetLocationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLocationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetLocationWithContext", err, response)
 	}
 
 	d.SetId(*location.ID)
@@ -6,10 +6,10 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -47,8 +47,7 @@ func dataSourceIbmSccAccountLocationSettingsRead(context context.Context, d *sch
 
 	accountSettings, response, err := adminServiceApiClient.GetSettingsWithContext(context, getSettingsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSettingsWithContext", err, response)
 	}
 
 	locationSettings := accountSettings.Location
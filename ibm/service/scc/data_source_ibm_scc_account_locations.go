@@ -6,10 +6,10 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -91,8 +91,7 @@ func dataSourceIbmSccAccountLocationsRead(context context.Context, d *schema.Res
 
 	locations, response, err := adminServiceApiClient.ListLocationsWithContext(context, listLocationsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListLocationsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLocationsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListLocationsWithContext", err, response)
 	}
 
 	d.SetId(dataSourceIbmSccAccountLocationsID(d))
@@ -6,7 +6,6 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -203,8 +202,7 @@ func dataSourceIBMSccPostureCollectorRead(context context.Context, d *schema.Res
 
 	collector, response, err := postureManagementClient.GetCollectorWithContext(context, CollectorOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetCollectorWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCollectorWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetCollectorWithContext", err, response)
 	}
 
 	d.SetId(*collector.ID)
@@ -6,7 +6,6 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -284,8 +283,7 @@ func dataSourceIBMSccPostureListCollectorsRead(context context.Context, d *schem
 
 	collectorList, response, err := postureManagementClient.ListCollectorsWithContext(context, listCollectorsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListCollectorsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListCollectorsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListCollectorsWithContext", err, response)
 	}
 
 	d.SetId(dataSourceIBMSccPostureListCollectorsID(d))
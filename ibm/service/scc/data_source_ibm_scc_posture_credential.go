@@ -6,7 +6,6 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -222,8 +221,7 @@ func dataSourceIBMSccPostureCredentialRead(context context.Context, d *schema.Re
 
 	credential, response, err := postureManagementClient.GetCredentialWithContext(context, CredentialOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCredentialWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetCredentialWithContext", err, response)
 	}
 
 	d.SetId(*credential.ID)
@@ -6,13 +6,13 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/url"
 	"reflect"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -285,8 +285,7 @@ func dataSourceIBMSccPostureListCredentialsRead(context context.Context, d *sche
 	result, response, err := postureManagementClient.ListCredentialsWithContext(context, listCredentialsOptions)
 	credentialList = result
 	if err != nil {
-		log.Printf("[DEBUG] ListCredentialsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListCredentialsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListCredentialsWithContext", err, response)
 	}
 
 	d.SetId(dataSourceIBMSccPostureListCredentialsID(d))
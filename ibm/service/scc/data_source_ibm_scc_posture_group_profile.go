@@ -6,13 +6,13 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/url"
 	"reflect"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -175,8 +175,7 @@ func dataSourceIBMSccPostureGroupProfileDetailsRead(context context.Context, d *
 		result, response, err := postureManagementClient.GetProfileControlsWithContext(context, getProfileControlsOptions)
 		controlList = result
 		if err != nil {
-			log.Printf("[DEBUG] GetProfileControlsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetProfileControlsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("GetProfileControlsWithContext", err, response)
 		}
 		offset = dataSourceControlListGetNext(result.Next)
 		finalList = append(finalList, result.Controls...)
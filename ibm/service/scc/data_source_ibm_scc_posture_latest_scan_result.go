@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package scc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+)
+
+func DataSourceIBMSccPostureLatestScanResult() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMSccPostureLatestScanResultRead,
+
+		Schema: map[string]*schema.Schema{
+			"scope_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The scope ID of the scan.",
+			},
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The profile ID that the scan validated.",
+			},
+			"scan_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the latest scan of the scope that validated the profile.",
+			},
+			"scan_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the scan.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the scan completed.",
+			},
+			"score": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The percentage of applicable controls that passed.",
+			},
+			"controls_pass_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of controls that passed.",
+			},
+			"controls_fail_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of controls that failed.",
+			},
+			"controls_unable_to_perform_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of controls that could not be validated.",
+			},
+			"controls_not_applicable_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of controls that do not apply to the scope.",
+			},
+			"controls_total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of controls.",
+			},
+			"failed_controls": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The controls that failed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The scan summary control ID.",
+						},
+						"external_control_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The external control ID.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The control description.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMSccPostureLatestScanResultRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	postureManagementClient, err := meta.(conns.ClientSession).PostureManagementV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting userDetails %s", err))
+	}
+	accountID := userDetails.UserAccount
+
+	scopeID := d.Get("scope_id").(string)
+	profileID := d.Get("profile_id").(string)
+
+	listLatestScansOptions := &posturemanagementv2.ListLatestScansOptions{}
+	listLatestScansOptions.SetAccountID(accountID)
+
+	var latestScan *posturemanagementv2.ScanItem
+	var offset int64
+	for {
+		listLatestScansOptions.Offset = &offset
+		listLatestScansOptions.Limit = core.Int64Ptr(int64(100))
+		result, response, err := postureManagementClient.ListLatestScansWithContext(context, listLatestScansOptions)
+		if err != nil {
+			return flex.ServiceErrorDiags("ListLatestScansWithContext", err, response)
+		}
+		for i := range result.LatestScans {
+			scan := result.LatestScans[i]
+			if scan.ScopeID == nil || *scan.ScopeID != scopeID || !dataSourceIBMSccPostureScanHasProfile(scan, profileID) {
+				continue
+			}
+			if latestScan == nil || dataSourceIBMSccPostureScanEndedAfter(scan, *latestScan) {
+				latestScan = &scan
+			}
+		}
+		offset = dataSourceScanListGetNext(result.Next)
+		if offset == 0 {
+			break
+		}
+	}
+
+	if latestScan == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] No scan found for scope %s and profile %s", scopeID, profileID))
+	}
+
+	scansSummaryOptions := &posturemanagementv2.ScansSummaryOptions{}
+	scansSummaryOptions.SetAccountID(accountID)
+	scansSummaryOptions.SetScanID(*latestScan.ScanID)
+	scansSummaryOptions.SetProfileID(profileID)
+
+	summary, response, err := postureManagementClient.ScansSummaryWithContext(context, scansSummaryOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("ScansSummaryWithContext", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *latestScan.ScanID, profileID))
+
+	if err = d.Set("scan_id", latestScan.ScanID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting scan_id: %s", err))
+	}
+	if err = d.Set("scan_name", latestScan.ScanName); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting scan_name: %s", err))
+	}
+	if latestScan.EndTime != nil {
+		if err = d.Set("end_time", latestScan.EndTime.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting end_time: %s", err))
+		}
+	}
+
+	counts := map[string]int{}
+	failedControls := []map[string]interface{}{}
+	for _, control := range summary.Controls {
+		status := ""
+		if control.Status != nil {
+			status = *control.Status
+		}
+		counts[status]++
+		if status == "fail" {
+			failedControls = append(failedControls, map[string]interface{}{
+				"id":                  control.ID,
+				"external_control_id": control.ExternalControlID,
+				"description":         control.Description,
+			})
+		}
+	}
+
+	// Controls that do not apply to the scope do not count towards the score. A scan
+	// without applicable controls scores 0 so that it never passes a threshold.
+	total := len(summary.Controls)
+	score := 0.0
+	if applicable := total - counts["not_applicable"]; applicable > 0 {
+		score = float64(counts[posturemanagementv2.ControlStatusPassConst]) * 100 / float64(applicable)
+	}
+
+	if err = d.Set("score", score); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting score: %s", err))
+	}
+	if err = d.Set("controls_pass_count", counts[posturemanagementv2.ControlStatusPassConst]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting controls_pass_count: %s", err))
+	}
+	if err = d.Set("controls_fail_count", counts["fail"]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting controls_fail_count: %s", err))
+	}
+	if err = d.Set("controls_unable_to_perform_count", counts[posturemanagementv2.ControlStatusUnableToPerformConst]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting controls_unable_to_perform_count: %s", err))
+	}
+	if err = d.Set("controls_not_applicable_count", counts["not_applicable"]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting controls_not_applicable_count: %s", err))
+	}
+	if err = d.Set("controls_total_count", total); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting controls_total_count: %s", err))
+	}
+	if err = d.Set("failed_controls", failedControls); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting failed_controls: %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMSccPostureScanHasProfile(scan posturemanagementv2.ScanItem, profileID string) bool {
+	for _, profile := range scan.Profiles {
+		if profile.ID != nil && *profile.ID == profileID {
+			return true
+		}
+	}
+	return false
+}
+
+func dataSourceIBMSccPostureScanEndedAfter(scan, other posturemanagementv2.ScanItem) bool {
+	if scan.EndTime == nil {
+		return false
+	}
+	if other.EndTime == nil {
+		return true
+	}
+	return time.Time(*scan.EndTime).After(time.Time(*other.EndTime))
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package scc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSccPostureLatestScanResultDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSccPostureLatestScanResultDataSourceConfigBasic(acc.Scc_posture_scope_id, acc.Scc_posture_profile_id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_scc_posture_latest_scan_result.scan_result", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_scc_posture_latest_scan_result.scan_result", "scan_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_scc_posture_latest_scan_result.scan_result", "score"),
+					resource.TestCheckResourceAttrSet("data.ibm_scc_posture_latest_scan_result.scan_result", "controls_total_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSccPostureLatestScanResultDataSourceConfigBasic(scopeId string, profileId string) string {
+	return fmt.Sprintf(`
+		data "ibm_scc_posture_latest_scan_result" "scan_result" {
+			scope_id = "%s"
+			profile_id = "%s"
+		}
+	`, scopeId, profileId)
+}
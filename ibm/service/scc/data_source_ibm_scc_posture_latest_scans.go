@@ -6,13 +6,13 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/url"
 	"reflect"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -251,8 +251,7 @@ func dataSourceIBMSccPostureListLatestScansRead(context context.Context, d *sche
 		result, response, err := postureManagementClient.ListLatestScansWithContext(context, listLatestScansOptions)
 		scanList = result
 		if err != nil {
-			log.Printf("[DEBUG] ListLatestScansWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListLatestScansWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListLatestScansWithContext", err, response)
 		}
 		offset = dataSourceScanListGetNext(result.Next)
 		if suppliedFilter {
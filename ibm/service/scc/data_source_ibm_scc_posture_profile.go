@@ -6,7 +6,6 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -115,8 +114,7 @@ func dataSourceIBMSccPostureProfileDetailsRead(context context.Context, d *schem
 
 	profile, response, err := postureManagementClient.GetProfileWithContext(context, getProfileOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetProfileWithContext", err, response)
 	}
 
 	d.SetId(*profile.ID)
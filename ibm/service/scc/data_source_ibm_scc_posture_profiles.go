@@ -6,13 +6,13 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/url"
 	"reflect"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -171,8 +171,7 @@ func dataSourceIBMSccPostureListProfilesRead(context context.Context, d *schema.
 		result, response, err := postureManagementClient.ListProfilesWithContext(context, listProfilesOptions)
 		profileList = result
 		if err != nil {
-			log.Printf("[DEBUG] ListProfilesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListProfilesWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListProfilesWithContext", err, response)
 		}
 		offset = dataSourceProfileListGetNext(result.Next)
 		finalList = append(finalList, result.Profiles...)
@@ -6,13 +6,13 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/url"
 	"reflect"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -319,8 +319,7 @@ func dataSourceIBMSccPostureScanSummariesRead(context context.Context, d *schema
 		result, response, err := postureManagementClient.ScanSummariesWithContext(context, scanSummariesOptions)
 		summaryList = result
 		if err != nil {
-			log.Printf("[DEBUG] ScanSummariesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ScanSummariesWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ScanSummariesWithContext", err, response)
 		}
 		offset = dataSourceSummaryListGetNext(result.Next)
 		finalList = append(finalList, result.Summaries...)
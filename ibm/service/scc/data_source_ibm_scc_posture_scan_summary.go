@@ -6,9 +6,9 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -210,8 +210,7 @@ func dataSourceIBMSccPostureScansSummaryRead(context context.Context, d *schema.
 
 	summary, response, err := postureManagementClient.ScansSummaryWithContext(context, scansSummaryOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ScansSummaryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ScansSummaryWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ScansSummaryWithContext", err, response)
 	}
 
 	d.SetId(*summary.ID)
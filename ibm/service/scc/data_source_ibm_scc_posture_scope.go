@@ -6,7 +6,6 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -816,8 +815,7 @@ func dataSourceIBMSccPostureScopeRead(context context.Context, d *schema.Resourc
 
 	scope, response, err := postureManagementClient.GetScopeDetailsWithContext(context, ScopeDetailsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetScopeDetailsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetScopeDetailsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetScopeDetailsWithContext", err, response)
 	}
 
 	d.SetId(*scope.ID)
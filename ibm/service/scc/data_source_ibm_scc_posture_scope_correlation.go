@@ -6,7 +6,6 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -65,8 +64,7 @@ func dataSourceIBMSccPostureScopeCorrelationRead(context context.Context, d *sch
 
 	scopeTaskStatus, response, err := postureManagementClient.GetCorrelationIDWithContext(context, getCorrelationIDOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetCorrelationIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCorrelationIDWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetCorrelationIDWithContext", err, response)
 	}
 
 	d.SetId(dataSourceIBMSccPostureScopeCorrelationID(d))
@@ -6,7 +6,6 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -342,8 +341,7 @@ func dataSourceIBMSccPostureListScopesRead(context context.Context, d *schema.Re
 
 	finalList, response, err := postureManagementClient.ListScopesWithContext(context, listScopesOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListScopesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListScopesWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListScopesWithContext", err, response)
 	}
 
 	scopeList := finalList
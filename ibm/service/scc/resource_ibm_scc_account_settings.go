@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -154,8 +155,7 @@ func resourceIbmSccAccountSettingsCreate(context context.Context, d *schema.Reso
 	if hasChange {
 		_, response, err = adminServiceApiClient.PatchAccountSettingsWithContext(context, patchAccountSettingsOptions)
 		if err != nil {
-			log.Printf("[DEBUG] PatchAccountSettingsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("PatchAccountSettingsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("PatchAccountSettingsWithContext", err, response)
 		}
 	}
 	// Set the ID of the Terraform object
@@ -189,12 +189,11 @@ func resourceIbmSccAccountSettingsRead(context context.Context, d *schema.Resour
 	// Return back the current Settings according to GetSettings
 	accountSettings, response, err := adminServiceApiClient.GetSettingsWithContext(context, getSettingsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSettingsWithContext", err, response)
 	}
 
 	if accountSettings.Location != nil {
@@ -284,8 +283,7 @@ func resourceIbmSccAccountSettingsUpdate(context context.Context, d *schema.Reso
 	if hasChange {
 		_, response, err := adminServiceApiClient.PatchAccountSettingsWithContext(context, patchAccountSettingsOptions)
 		if err != nil {
-			log.Printf("[DEBUG] PatchAccountSettingsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("PatchAccountSettingsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("PatchAccountSettingsWithContext", err, response)
 		}
 	}
 
@@ -310,8 +308,7 @@ func resourceIbmSccAccountSettingsDelete(context context.Context, d *schema.Reso
 
 	_, response, err := adminServiceApiClient.GetSettingsWithContext(context, getSettingsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] PatchAccountSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("PatchAccountSettingsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("PatchAccountSettingsWithContext", err, response)
 	}
 	// Set the object to a empty string so Terraform deletes the object
 	d.SetId("")
@@ -6,9 +6,9 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -136,8 +136,7 @@ func resourceIBMSccPostureCollectorsCreate(context context.Context, d *schema.Re
 
 	collector, response, err := postureManagementClient.CreateCollectorWithContext(context, createCollectorOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateCollectorWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateCollectorWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateCollectorWithContext", err, response)
 	}
 
 	d.SetId(*collector.ID)
@@ -163,12 +162,11 @@ func resourceIBMSccPostureCollectorsRead(context context.Context, d *schema.Reso
 
 	collector, response, err := postureManagementClient.GetCollectorWithContext(context, getCollectorsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetCollectorWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCollectorWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetCollectorWithContext", err, response)
 	}
 	d.SetId(*(collector.ID))
 	return nil
@@ -196,8 +194,7 @@ func resourceIBMSccPostureCollectorsUpdate(context context.Context, d *schema.Re
 		//updateCollectorOptions.CollectorUpdatePatch, _ = patchVals.AsPatch()
 		_, response, err := postureManagementClient.UpdateCollectorWithContext(context, updateCollectorOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateCollectorWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateCollectorWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateCollectorWithContext", err, response)
 		}
 	}
 
@@ -222,8 +219,7 @@ func resourceIBMSccPostureCollectorsDelete(context context.Context, d *schema.Re
 
 	response, err := postureManagementClient.DeleteCollectorWithContext(context, deleteCollectorOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteCollectorWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteCollectorWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteCollectorWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,9 +6,9 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -143,8 +143,7 @@ func resourceIBMSccPostureCredentialsCreate(context context.Context, d *schema.R
 
 	credential, response, err := postureManagementClient.CreateCredentialWithContext(context, createCredentialOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateCredentialWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateCredentialWithContext", err, response)
 	}
 
 	d.SetId(*credential.ID)
@@ -190,12 +189,11 @@ func resourceIBMSccPostureCredentialsRead(context context.Context, d *schema.Res
 
 	credential, response, err := postureManagementClient.GetCredentialWithContext(context, getCredentialsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCredentialWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetCredentialWithContext", err, response)
 	}
 	d.SetId(*(credential.ID))
 	return nil
@@ -245,8 +243,7 @@ func resourceIBMSccPostureCredentialsUpdate(context context.Context, d *schema.R
 
 	_, response, err := postureManagementClient.UpdateCredentialWithContext(context, updateCredentialOptions)
 	if err != nil {
-		log.Printf("[DEBUG] UpdateCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateCredentialWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("UpdateCredentialWithContext", err, response)
 	}
 
 	return resourceIBMSccPostureCredentialsRead(context, d, meta)
@@ -270,8 +267,7 @@ func resourceIBMSccPostureCredentialsDelete(context context.Context, d *schema.R
 
 	response, err := postureManagementClient.DeleteCredentialWithContext(context, deleteCredentialOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteCredentialWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteCredentialWithContext", err, response)
 	}
 
 	d.SetId("")
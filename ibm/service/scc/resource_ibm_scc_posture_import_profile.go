@@ -115,8 +115,7 @@ func resourceIBMSccPostureProfileImport(context context.Context, d *schema.Resou
 
 	profile, response, err := postureManagementClient.ImportProfilesWithContext(context, importProfilesOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ImportProfilesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ImportProfilesWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ImportProfilesWithContext", err, response)
 	}
 
 	d.SetId(*profile.ProfileID)
@@ -144,8 +143,7 @@ func resourceIBMSccPostureProfileImportRead(context context.Context, d *schema.R
 
 	profile, response, err := postureManagementClient.GetProfileWithContext(context, getProfileOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetProfileWithContext", err, response)
 	}
 
 	d.SetId(*profile.ID)
@@ -6,10 +6,10 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
 	"github.com/go-openapi/strfmt"
@@ -184,8 +184,7 @@ func resourceIBMSccPostureScanInitiateValidation(context context.Context, d *sch
 
 	result, response, err := postureManagementClient.CreateValidationWithContext(context, createValidationOptions)
 	if result == nil || err != nil {
-		log.Printf("[DEBUG] CreateValidationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateValidationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateValidationWithContext", err, response)
 	}
 
 	if *result.Result {
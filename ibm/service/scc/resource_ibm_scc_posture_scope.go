@@ -6,9 +6,9 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -129,8 +129,7 @@ func resourceIBMSccPostureScopesCreate(context context.Context, d *schema.Resour
 
 	scope, response, err := postureManagementClient.CreateScopeWithContext(context, createScopeOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateScopeWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateScopeWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateScopeWithContext", err, response)
 	}
 
 	d.SetId(*scope.ID)
@@ -157,12 +156,11 @@ func resourceIBMSccPostureScopesRead(context context.Context, d *schema.Resource
 	scope, response, err := postureManagementClient.GetScopeDetailsWithContext(context, getScopesOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetScopeDetailsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetScopeDetailsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetScopeDetailsWithContext", err, response)
 	}
 	d.SetId(*scope.ID)
 
@@ -199,8 +197,7 @@ func resourceIBMSccPostureScopesUpdate(context context.Context, d *schema.Resour
 	if hasChange {
 		_, response, err := postureManagementClient.UpdateScopeDetailsWithContext(context, updateScopeDetailsOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateScopeDetailsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateScopeDetailsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateScopeDetailsWithContext", err, response)
 		}
 	}
 
@@ -225,8 +222,7 @@ func resourceIBMSccPostureScopesDelete(context context.Context, d *schema.Resour
 
 	response, err := postureManagementClient.DeleteScopeWithContext(context, deleteScopeOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteScopeWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteScopeWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteScopeWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -157,6 +159,7 @@ func ResourceIBMSccRule() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
+			resourceIBMSccRuleValidateRequiredConfig,
 			// update the version number via API GET if any of the fields are changed
 			customdiff.ComputedIf("version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("name") || diff.HasChange("description") ||
@@ -221,6 +224,75 @@ func getRequiredConfigSchema(currentDepth int) map[string]*schema.Schema {
 	return baseMap
 }
 
+// resourceIBMSccRuleValidateRequiredConfig checks the and/or tree of required_config at plan time,
+// the API only reports the first problem it finds after the rule is submitted
+func resourceIBMSccRuleValidateRequiredConfig(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("required_config") {
+		return nil
+	}
+	requiredConfig := diff.Get("required_config").([]interface{})
+	if len(requiredConfig) == 0 || requiredConfig[0] == nil {
+		return nil
+	}
+	return validateIBMSccRuleCondition("required_config", requiredConfig[0].(map[string]interface{}))
+}
+
+func validateIBMSccRuleCondition(path string, condition map[string]interface{}) error {
+	and, _ := condition["and"].([]interface{})
+	or, _ := condition["or"].([]interface{})
+	if len(and) > 0 && len(or) > 0 {
+		return fmt.Errorf("%s: 'or' and 'and' cannot be set at the same level", path)
+	}
+
+	property, _ := condition["property"].(string)
+	operator, _ := condition["operator"].(string)
+	value, _ := condition["value"].(string)
+
+	logicalOperator, conditions := "and", and
+	if len(or) > 0 {
+		logicalOperator, conditions = "or", or
+	}
+	if len(conditions) > 0 {
+		if property != "" || operator != "" || value != "" {
+			return fmt.Errorf("%s: 'property','value','operator' should be nested inside 'and'/'or' or be by itself", path)
+		}
+		for i, c := range conditions {
+			conditionPath := fmt.Sprintf("%s.%s.%d", path, logicalOperator, i)
+			if c == nil {
+				return fmt.Errorf("%s: %s block needs to be populated", conditionPath, logicalOperator)
+			}
+			if err := validateIBMSccRuleCondition(conditionPath, c.(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if property == "" {
+		return fmt.Errorf("%s: 'property' is required when no 'and' or 'or' conditions are set", path)
+	}
+	if strings.ContainsAny(property, " \t\n") {
+		return fmt.Errorf("%s: 'property' %q must not contain whitespace", path, property)
+	}
+	if operator == "" {
+		return fmt.Errorf("%s: 'operator' is required for property %q", path, property)
+	}
+	switch {
+	case strings.HasPrefix(operator, "is_"):
+		if value != "" {
+			return fmt.Errorf("%s: operator %q does not take a 'value'", path, operator)
+		}
+	case strings.HasPrefix(operator, "num_"):
+		// an empty value is not known until apply
+		if value != "" {
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("%s: operator %q requires a numeric 'value', got %q", path, operator, value)
+			}
+		}
+	}
+	return nil
+}
+
 func resourceIBMSccRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	configurationGovernanceClient, err := meta.(conns.ClientSession).ConfigurationGovernanceV1()
 	if err != nil {
@@ -238,8 +310,7 @@ func resourceIBMSccRuleCreate(context context.Context, d *schema.ResourceData, m
 
 	createRulesResponse, response, err := configurationGovernanceClient.CreateRulesWithContext(context, createRulesOptions)
 	if err != nil || response.GetStatusCode() == 207 || response.StatusCode > 300 {
-		log.Printf("[DEBUG] CreateRulesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRulesWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateRulesWithContext", err, response)
 	}
 
 	d.SetId(*createRulesResponse.Rules[0].Rule.RuleID)
@@ -261,12 +332,11 @@ func resourceIBMSccRuleRead(context context.Context, d *schema.ResourceData, met
 	log.Println("[DEBUG] Grabbed a response from the Read Operation")
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetRuleWithContext", err, response)
 	}
 
 	if err = d.Set("account_id", rule.AccountID); err != nil {
@@ -385,8 +455,7 @@ func resourceIBMSccRuleUpdate(context context.Context, d *schema.ResourceData, m
 		updateRuleOptions.SetIfMatch(d.Get("version").(string))
 		_, response, err := configurationGovernanceClient.UpdateRuleWithContext(context, updateRuleOptions)
 		if err != nil || response.GetStatusCode() == 207 || response.StatusCode > 300 {
-			log.Printf("[DEBUG] UpdateRuleWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateRuleWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateRuleWithContext", err, response)
 		}
 	}
 
@@ -405,8 +474,7 @@ func resourceIBMSccRuleDelete(context context.Context, d *schema.ResourceData, m
 
 	response, err := configurationGovernanceClient.DeleteRuleWithContext(context, deleteRuleOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRuleWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteRuleWithContext", err, response)
 	}
 
 	d.SetId("")
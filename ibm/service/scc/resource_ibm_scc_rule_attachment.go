@@ -6,7 +6,6 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -128,8 +127,7 @@ func resourceIBMSccRuleAttachmentCreate(context context.Context, d *schema.Resou
 
 	createRuleAttachmentsResponse, response, err := configurationGovernanceClient.CreateRuleAttachmentsWithContext(context, createRuleAttachmentsOptions)
 	if err != nil || response.StatusCode > 300 {
-		log.Printf("[DEBUG] CreateRuleAttachmentsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRuleAttachmentsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateRuleAttachmentsWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createRuleAttachmentsOptions.RuleID, *createRuleAttachmentsResponse.Attachments[0].AttachmentID))
@@ -155,12 +153,11 @@ func resourceIBMSccRuleAttachmentRead(context context.Context, d *schema.Resourc
 
 	ruleAttachment, response, err := configurationGovernanceClient.GetRuleAttachmentWithContext(context, getRuleAttachmentOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetRuleAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleAttachmentWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetRuleAttachmentWithContext", err, response)
 	}
 
 	// TODO: handle argument of type []interface{}
@@ -247,8 +244,7 @@ func resourceIBMSccRuleAttachmentUpdate(context context.Context, d *schema.Resou
 
 		_, response, err := configurationGovernanceClient.UpdateRuleAttachmentWithContext(context, updateRuleAttachmentOptions)
 		if err != nil || response.StatusCode > 300 {
-			log.Printf("[DEBUG] UpdateRuleAttachmentWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateRuleAttachmentWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateRuleAttachmentWithContext", err, response)
 		}
 	}
 
@@ -273,8 +269,7 @@ func resourceIBMSccRuleAttachmentDelete(context context.Context, d *schema.Resou
 
 	response, err := configurationGovernanceClient.DeleteRuleAttachmentWithContext(context, deleteRuleAttachmentOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteRuleAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRuleAttachmentWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteRuleAttachmentWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,6 +6,7 @@ package scc_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -39,6 +40,74 @@ func TestAccIBMSccRuleBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMSccRuleInvalidRequiredConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMSccRuleConfigRequiredConfig(`
+					and {
+						property = "location"
+						operator = "string_equals"
+						value    = "us-south"
+					}
+					and {
+						or {
+							property = "storage_class"
+							operator = "is_empty"
+							value    = "smart"
+						}
+					}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`required_config.and.1.or.0: operator "is_empty" does not take a 'value'`),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMSccRuleConfigRequiredConfig(`
+					or {
+						property = "retention_days"
+						operator = "num_greater_than"
+						value    = "thirty"
+					}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`required_config.or.0: operator "num_greater_than" requires a numeric 'value'`),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMSccRuleConfigRequiredConfig(`
+					property = "location"
+					operator = "string_equals"
+					value    = "us-south"
+					and {
+						property = "storage_class"
+						operator = "string_equals"
+						value    = "smart"
+					}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'property','value','operator' should be nested inside 'and'/'or' or be by itself`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSccRuleConfigRequiredConfig(requiredConfig string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_scc_rule" "scc_rule" {
+		account_id = "%s"
+		name = "scc_tf_sample_rule"
+		description = "description"
+		target {
+			service_name = "cloud-object-storage"
+			resource_kind = "bucket"
+		}
+		required_config {
+			description = "test config"
+			%s
+		}
+	}
+	`, os.Getenv("SCC_GOVERNANCE_ACCOUNT_ID"), requiredConfig)
+}
+
 func testAccCheckIBMSccRuleConfigBasic() string {
 	// Check if the user has a SCC_GOVERANCE_ACCOUNT_ID
 	account_id := os.Getenv("SCC_GOVERNANCE_ACCOUNT_ID")
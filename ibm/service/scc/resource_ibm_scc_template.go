@@ -6,12 +6,12 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/scc-go-sdk/v3/configurationgovernancev1"
@@ -131,8 +131,7 @@ func resourceIBMSccTemplateCreate(context context.Context, d *schema.ResourceDat
 
 	createTemplatesResponse, response, err := configurationGovernanceClient.CreateTemplatesWithContext(context, createTemplatesOptions)
 	if err != nil || response.GetStatusCode() == 207 || response.StatusCode > 300 {
-		log.Printf("[DEBUG] CreateTemplatesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTemplatesWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateTemplatesWithContext", err, response)
 	}
 
 	d.SetId(*createTemplatesResponse.Templates[0].Template.TemplateID)
@@ -152,12 +151,11 @@ func resourceIBMSccTemplateRead(context context.Context, d *schema.ResourceData,
 
 	templateResponse, response, err := configurationGovernanceClient.GetTemplateWithContext(context, getTemplateOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTemplateWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTemplateWithContext", err, response)
 	}
 
 	// TODO: handle argument of type []interface{}
@@ -235,8 +233,7 @@ func resourceIBMSccTemplateUpdate(context context.Context, d *schema.ResourceDat
 
 		_, response, err := configurationGovernanceClient.UpdateTemplateWithContext(context, updateTemplateOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateTemplateWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTemplateWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateTemplateWithContext", err, response)
 		}
 	}
 
@@ -255,8 +252,7 @@ func resourceIBMSccTemplateDelete(context context.Context, d *schema.ResourceDat
 
 	response, err := configurationGovernanceClient.DeleteTemplateWithContext(context, deleteTemplateOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTemplateWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteTemplateWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package scc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -118,8 +117,7 @@ func resourceIBMSccTemplateAttachmentCreate(context context.Context, d *schema.R
 
 	createTemplateAttachmentsResponse, response, err := configurationGovernanceClient.CreateTemplateAttachmentsWithContext(context, createTemplateAttachmentsOptions)
 	if err != nil || response.StatusCode > 300 {
-		log.Printf("[DEBUG] CreateTemplateAttachmentsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTemplateAttachmentsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateTemplateAttachmentsWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createTemplateAttachmentsOptions.TemplateID, *createTemplateAttachmentsResponse.Attachments[0].AttachmentID))
@@ -145,12 +143,11 @@ func resourceIBMSccTemplateAttachmentRead(context context.Context, d *schema.Res
 
 	templateAttachment, response, err := configurationGovernanceClient.GetTemplateAttachmentWithContext(context, getTemplateAttachmentOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetTemplateAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTemplateAttachmentWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetTemplateAttachmentWithContext", err, response)
 	}
 
 	// TODO: handle argument of type []interface{}
@@ -238,8 +235,7 @@ func resourceIBMSccTemplateAttachmentUpdate(context context.Context, d *schema.R
 
 		_, response, err := configurationGovernanceClient.UpdateTemplateAttachmentWithContext(context, updateTemplateAttachmentOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateTemplateAttachmentWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTemplateAttachmentWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateTemplateAttachmentWithContext", err, response)
 		}
 	}
 
@@ -264,8 +260,7 @@ func resourceIBMSccTemplateAttachmentDelete(context context.Context, d *schema.R
 
 	response, err := configurationGovernanceClient.DeleteTemplateAttachmentWithContext(context, deleteTemplateAttachmentOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteTemplateAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTemplateAttachmentWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteTemplateAttachmentWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package schematics
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -2831,8 +2830,7 @@ func dataSourceIBMSchematicsJobRead(context context.Context, d *schema.ResourceD
 
 	job, response, err := schematicsClient.GetJobWithContext(context, getJobOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetJobWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetJobWithContext", err, response)
 	}
 
 	d.SetId(*getJobOptions.JobID)
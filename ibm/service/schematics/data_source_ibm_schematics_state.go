@@ -8,7 +8,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -76,8 +75,7 @@ func dataSourceIBMSchematicsStateRead(context context.Context, d *schema.Resourc
 
 	_, response, _ := schematicsClient.GetWorkspaceTemplateStateWithContext(context, getWorkspaceTemplateStateOptions)
 	if response.StatusCode != 200 {
-		log.Printf("[DEBUG] GetWorkspaceTemplateStateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkspaceTemplateStateWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetWorkspaceTemplateStateWithContext", err, response)
 	}
 
 	d.SetId(dataSourceIBMSchematicsStateID(d))
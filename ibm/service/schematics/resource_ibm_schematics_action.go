@@ -6,7 +6,6 @@ package schematics
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -1086,8 +1085,7 @@ func resourceIBMSchematicsActionCreate(context context.Context, d *schema.Resour
 
 	action, response, err := schematicsClient.CreateActionWithContext(context, createActionOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateActionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateActionWithContext", err, response)
 	}
 
 	d.SetId(*action.ID)
@@ -1357,12 +1355,11 @@ func resourceIBMSchematicsActionRead(context context.Context, d *schema.Resource
 
 	action, response, err := schematicsClient.GetActionWithContext(context, getActionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetActionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetActionWithContext", err, response)
 	}
 	if err = d.Set("name", action.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
@@ -1933,8 +1930,7 @@ func resourceIBMSchematicsActionUpdate(context context.Context, d *schema.Resour
 	if hasChange {
 		_, response, err := schematicsClient.UpdateActionWithContext(context, updateActionOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateActionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateActionWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateActionWithContext", err, response)
 		}
 	}
 
@@ -1958,8 +1954,7 @@ func resourceIBMSchematicsActionDelete(context context.Context, d *schema.Resour
 
 	response, err := schematicsClient.DeleteActionWithContext(context, deleteActionOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteActionWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteActionWithContext", err, response)
 	}
 
 	d.SetId("")
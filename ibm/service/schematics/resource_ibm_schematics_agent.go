@@ -0,0 +1,342 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+func ResourceIBMSchematicsAgent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsAgentCreate,
+		ReadContext:   resourceIBMSchematicsAgentRead,
+		UpdateContext: resourceIBMSchematicsAgentUpdate,
+		DeleteContext: resourceIBMSchematicsAgentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the agent (must be unique, for an account).",
+			},
+			"agent_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The location where agent is deployed in the user environment.",
+			},
+			"location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_agent", "location"),
+				Description:  "List of locations supported by IBM Cloud Schematics service.  While creating your workspace or action, choose the right region, since it cannot be changed.  Note, this does not limit the location of the IBM Cloud resources, provisioned using Schematics.",
+			},
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IAM trusted profile id, used by the Agent instance.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Agent description.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The resource-group name for the agent.  By default, Agent will be registered in Default Resource Group.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Tags for the agent.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"user_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_agent", "user_state"),
+				Description:  "User defined status of the agent. Allowable values are `enable` and `disable`.",
+			},
+			"agent_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent crn, obtained from the Schematics agent deployment configuration.",
+			},
+			"registered_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent creation date-time.",
+			},
+			"registered_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of an user who created the agent.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent registration updation time.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of user who updated the agent registration.",
+			},
+			"connection_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Agent connection status, `Connected` when Schematics is able to connect to the agent.",
+			},
+			"connection_checked_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the connection state was last checked.",
+			},
+			"system_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the agent registration.",
+			},
+			"system_state_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent status message.",
+			},
+		},
+	}
+}
+
+func ResourceIBMSchematicsAgentValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "location",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "eu-de, eu-gb, us-east, us-south",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "user_state",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disable, enable",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_schematics_agent", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMSchematicsAgentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	region := d.Get("location").(string)
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+	if updatedURL {
+		schematicsClient.Service.Options.URL = schematicsURL
+	}
+
+	registerAgentOptions := &schematicsv1.RegisterAgentOptions{}
+
+	registerAgentOptions.SetName(d.Get("name").(string))
+	registerAgentOptions.SetAgentLocation(d.Get("agent_location").(string))
+	registerAgentOptions.SetLocation(region)
+	registerAgentOptions.SetProfileID(d.Get("profile_id").(string))
+	if _, ok := d.GetOk("description"); ok {
+		registerAgentOptions.SetDescription(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("resource_group"); ok {
+		registerAgentOptions.SetResourceGroup(d.Get("resource_group").(string))
+	}
+	if _, ok := d.GetOk("tags"); ok {
+		registerAgentOptions.SetTags(flex.ExpandStringList(d.Get("tags").([]interface{})))
+	}
+	if _, ok := d.GetOk("user_state"); ok {
+		registerAgentOptions.SetUserState(&schematicsv1.AgentUserState{
+			State: core.StringPtr(d.Get("user_state").(string)),
+		})
+	}
+
+	agent, response, err := schematicsClient.RegisterAgentWithContext(context, registerAgentOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("RegisterAgentWithContext", err, response)
+	}
+
+	d.SetId(*agent.ID)
+
+	return resourceIBMSchematicsAgentRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	agentIDSplit := strings.Split(d.Id(), ".")
+	region := agentIDSplit[0]
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+	if updatedURL {
+		schematicsClient.Service.Options.URL = schematicsURL
+	}
+
+	getAgentOptions := &schematicsv1.GetAgentOptions{}
+
+	getAgentOptions.SetAgentID(d.Id())
+
+	agent, response, err := schematicsClient.GetAgentWithContext(context, getAgentOptions)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return flex.ServiceErrorDiags("GetAgentWithContext", err, response)
+	}
+	if err = d.Set("name", agent.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("agent_location", agent.AgentLocation); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_location: %s", err))
+	}
+	if err = d.Set("location", agent.Location); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting location: %s", err))
+	}
+	if err = d.Set("profile_id", agent.ProfileID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting profile_id: %s", err))
+	}
+	if err = d.Set("description", agent.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+	if err = d.Set("resource_group", agent.ResourceGroup); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
+	}
+	if agent.Tags != nil {
+		if err = d.Set("tags", agent.Tags); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting tags: %s", err))
+		}
+	}
+	if agent.UserState != nil {
+		if err = d.Set("user_state", agent.UserState.State); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting user_state: %s", err))
+		}
+	}
+	if err = d.Set("agent_crn", agent.AgentCrn); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_crn: %s", err))
+	}
+	if err = d.Set("registered_at", flex.DateTimeToString(agent.RegisteredAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting registered_at: %s", err))
+	}
+	if err = d.Set("registered_by", agent.RegisteredBy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting registered_by: %s", err))
+	}
+	if err = d.Set("updated_at", flex.DateTimeToString(agent.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+	if err = d.Set("updated_by", agent.UpdatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_by: %s", err))
+	}
+	if agent.ConnectionState != nil {
+		if err = d.Set("connection_state", agent.ConnectionState.State); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting connection_state: %s", err))
+		}
+		if err = d.Set("connection_checked_at", flex.DateTimeToString(agent.ConnectionState.CheckedAt)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting connection_checked_at: %s", err))
+		}
+	}
+	if agent.SystemState != nil {
+		if err = d.Set("system_state", agent.SystemState.State); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting system_state: %s", err))
+		}
+		if err = d.Set("system_state_message", agent.SystemState.Message); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting system_state_message: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSchematicsAgentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	agentIDSplit := strings.Split(d.Id(), ".")
+	region := agentIDSplit[0]
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+	if updatedURL {
+		schematicsClient.Service.Options.URL = schematicsURL
+	}
+
+	if d.HasChanges("name", "agent_location", "profile_id", "description", "resource_group", "tags", "user_state") {
+		// the registration is replaced as a whole, the required fields are always sent
+		updateAgentRegistrationOptions := &schematicsv1.UpdateAgentRegistrationOptions{}
+
+		updateAgentRegistrationOptions.SetAgentID(d.Id())
+		updateAgentRegistrationOptions.SetName(d.Get("name").(string))
+		updateAgentRegistrationOptions.SetAgentLocation(d.Get("agent_location").(string))
+		updateAgentRegistrationOptions.SetLocation(d.Get("location").(string))
+		updateAgentRegistrationOptions.SetProfileID(d.Get("profile_id").(string))
+		updateAgentRegistrationOptions.SetDescription(d.Get("description").(string))
+		if _, ok := d.GetOk("resource_group"); ok {
+			updateAgentRegistrationOptions.SetResourceGroup(d.Get("resource_group").(string))
+		}
+		updateAgentRegistrationOptions.SetTags(flex.ExpandStringList(d.Get("tags").([]interface{})))
+		if _, ok := d.GetOk("user_state"); ok {
+			updateAgentRegistrationOptions.SetUserState(&schematicsv1.AgentUserState{
+				State: core.StringPtr(d.Get("user_state").(string)),
+			})
+		}
+
+		_, response, err := schematicsClient.UpdateAgentRegistrationWithContext(context, updateAgentRegistrationOptions)
+		if err != nil {
+			return flex.ServiceErrorDiags("UpdateAgentRegistrationWithContext", err, response)
+		}
+	}
+
+	return resourceIBMSchematicsAgentRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	agentIDSplit := strings.Split(d.Id(), ".")
+	region := agentIDSplit[0]
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+	if updatedURL {
+		schematicsClient.Service.Options.URL = schematicsURL
+	}
+
+	deleteAgentOptions := &schematicsv1.DeleteAgentOptions{}
+
+	deleteAgentOptions.SetAgentID(d.Id())
+
+	response, err := schematicsClient.DeleteAgentWithContext(context, deleteAgentOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("DeleteAgentWithContext", err, response)
+	}
+
+	d.SetId("")
+
+	return nil
+}
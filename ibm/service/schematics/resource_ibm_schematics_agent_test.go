@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+func TestAccIBMSchematicsAgentBasic(t *testing.T) {
+	var conf schematicsv1.Agent
+	name := fmt.Sprintf("tf-agent-%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	descriptionUpdate := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMSchematicsAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsAgentConfig(name, description, "enable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMSchematicsAgentExists("ibm_schematics_agent.schematics_agent", conf),
+					resource.TestCheckResourceAttr("ibm_schematics_agent.schematics_agent", "name", name),
+					resource.TestCheckResourceAttr("ibm_schematics_agent.schematics_agent", "description", description),
+					resource.TestCheckResourceAttr("ibm_schematics_agent.schematics_agent", "user_state", "enable"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_agent.schematics_agent", "registered_at"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsAgentConfig(name, descriptionUpdate, "disable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_agent.schematics_agent", "description", descriptionUpdate),
+					resource.TestCheckResourceAttr("ibm_schematics_agent.schematics_agent", "user_state", "disable"),
+				),
+			},
+			{
+				ResourceName:      "ibm_schematics_agent.schematics_agent",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsAgentConfig(name string, description string, userState string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_schematics_agent" "schematics_agent" {
+			name           = "%s"
+			description    = "%s"
+			agent_location = "us-south"
+			location       = "us-south"
+			profile_id     = "%s"
+			user_state     = "%s"
+			tags           = ["env:test"]
+		}
+	`, name, description, acc.SchematicsAgentProfileID, userState)
+}
+
+func testAccCheckIBMSchematicsAgentExists(n string, obj schematicsv1.Agent) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		schematicsClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SchematicsV1()
+		if err != nil {
+			return err
+		}
+
+		getAgentOptions := &schematicsv1.GetAgentOptions{}
+
+		getAgentOptions.SetAgentID(rs.Primary.ID)
+
+		agent, _, err := schematicsClient.GetAgent(getAgentOptions)
+		if err != nil {
+			return err
+		}
+
+		obj = *agent
+		return nil
+	}
+}
+
+func testAccCheckIBMSchematicsAgentDestroy(s *terraform.State) error {
+	schematicsClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_schematics_agent" {
+			continue
+		}
+
+		getAgentOptions := &schematicsv1.GetAgentOptions{}
+
+		getAgentOptions.SetAgentID(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := schematicsClient.GetAgent(getAgentOptions)
+
+		if err == nil {
+			return fmt.Errorf("schematics_agent still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for schematics_agent (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
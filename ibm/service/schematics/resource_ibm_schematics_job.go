@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -28,6 +30,11 @@ func ResourceIBMSchematicsJob() *schema.Resource {
 		DeleteContext: resourceIBMSchematicsJobDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"command_object": {
 				Type:         schema.TypeString,
@@ -302,6 +309,12 @@ func ResourceIBMSchematicsJob() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_schematics_job", "location"),
 				Description:  "List of locations supported by IBM Cloud Schematics service.  While creating your workspace or action, choose the right region, since it cannot be changed.  Note, this does not limit the location of the IBM Cloud resources, provisioned using Schematics.",
 			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the job to finish, the job logs are written to the Terraform log and the apply fails if the job fails.",
+			},
 			"status": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -2938,15 +2951,87 @@ func resourceIBMSchematicsJobCreate(context context.Context, d *schema.ResourceD
 
 	job, response, err := schematicsClient.CreateJobWithContext(context, createJobOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateJobWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateJobWithContext", err, response)
 	}
 
 	d.SetId(*job.ID)
 
+	if d.Get("wait_for_completion").(bool) {
+		_, err = waitForIBMSchematicsJobCompletion(context, schematicsClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMSchematicsJobRead(context, d, meta)
 }
 
+// waitForIBMSchematicsJobCompletion polls the job until it finishes, new job log output is written to
+// the Terraform log on every poll
+func waitForIBMSchematicsJobCompletion(context context.Context, schematicsClient *schematicsv1.SchematicsV1, jobID string, timeout time.Duration) (interface{}, error) {
+	logOffset := 0
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			schematicsv1.JobStatusWorkspace_StatusCode_JobPending,
+			schematicsv1.JobStatusWorkspace_StatusCode_JobInProgress,
+		},
+		Target: []string{schematicsv1.JobStatusWorkspace_StatusCode_JobFinished},
+		Refresh: func() (interface{}, string, error) {
+			getJobOptions := &schematicsv1.GetJobOptions{}
+			getJobOptions.SetJobID(jobID)
+			job, response, err := schematicsClient.GetJobWithContext(context, getJobOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] GetJobWithContext failed %s\n%s", err, response)
+			}
+
+			listJobLogsOptions := &schematicsv1.ListJobLogsOptions{}
+			listJobLogsOptions.SetJobID(jobID)
+			jobLog, _, err := schematicsClient.ListJobLogsWithContext(context, listJobLogsOptions)
+			if err == nil && jobLog.Details != nil && len(*jobLog.Details) > logOffset {
+				log.Printf("[INFO] Schematics job %s log:\n%s", jobID, string((*jobLog.Details)[logOffset:]))
+				logOffset = len(*jobLog.Details)
+			}
+
+			statusCode, statusMessage := resourceIBMSchematicsJobStatusCode(job.Status)
+			switch statusCode {
+			case schematicsv1.JobStatusWorkspace_StatusCode_JobFailed, schematicsv1.JobStatusWorkspace_StatusCode_JobCancelled:
+				message := ""
+				if statusMessage != nil {
+					message = *statusMessage
+				}
+				return job, statusCode, fmt.Errorf("[ERROR] Schematics job %s ended with status %s: %s", jobID, statusCode, message)
+			case "":
+				// the status is not reported until the job is queued
+				return job, schematicsv1.JobStatusWorkspace_StatusCode_JobPending, nil
+			}
+			return job, statusCode, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+// resourceIBMSchematicsJobStatusCode returns the status of whichever kind of job the status describes
+func resourceIBMSchematicsJobStatusCode(status *schematicsv1.JobStatus) (string, *string) {
+	if status == nil {
+		return "", nil
+	}
+	switch {
+	case status.WorkspaceJobStatus != nil && status.WorkspaceJobStatus.StatusCode != nil:
+		return *status.WorkspaceJobStatus.StatusCode, status.WorkspaceJobStatus.StatusMessage
+	case status.ActionJobStatus != nil && status.ActionJobStatus.StatusCode != nil:
+		return *status.ActionJobStatus.StatusCode, status.ActionJobStatus.StatusMessage
+	case status.FlowJobStatus != nil && status.FlowJobStatus.StatusCode != nil:
+		return *status.FlowJobStatus.StatusCode, status.FlowJobStatus.StatusMessage
+	case status.SystemJobStatus != nil && status.SystemJobStatus.SystemStatusCode != nil:
+		return *status.SystemJobStatus.SystemStatusCode, status.SystemJobStatus.SystemStatusMessage
+	}
+	return "", nil
+}
+
 func resourceIBMSchematicsJobMapToVariableData(variableDataMap map[string]interface{}) schematicsv1.VariableData {
 	variableData := schematicsv1.VariableData{}
 
@@ -3917,12 +4002,11 @@ func resourceIBMSchematicsJobRead(context context.Context, d *schema.ResourceDat
 
 	job, response, err := schematicsClient.GetJobWithContext(context, getJobOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetJobWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetJobWithContext", err, response)
 	}
 	if err = d.Set("command_object", job.CommandObject); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting command_object: %s", err))
@@ -5061,8 +5145,14 @@ func resourceIBMSchematicsJobUpdate(context context.Context, d *schema.ResourceD
 
 	_, response, err := schematicsClient.UpdateJobWithContext(context, updateJobOptions)
 	if err != nil {
-		log.Printf("[DEBUG] UpdateJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateJobWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("UpdateJobWithContext", err, response)
+	}
+
+	if d.Get("wait_for_completion").(bool) {
+		_, err = waitForIBMSchematicsJobCompletion(context, schematicsClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceIBMSchematicsJobRead(context, d, meta)
@@ -5094,8 +5184,7 @@ func resourceIBMSchematicsJobDelete(context context.Context, d *schema.ResourceD
 
 	response, err := schematicsClient.DeleteJobWithContext(context, deleteJobOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteJobWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteJobWithContext", err, response)
 	}
 
 	d.SetId("")
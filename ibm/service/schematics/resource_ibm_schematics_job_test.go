@@ -55,6 +55,40 @@ func TestAccIBMSchematicsJobBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMSchematicsJobWaitForCompletion(t *testing.T) {
+	var conf schematicsv1.Job
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMSchematicsJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsJobWaitConfig("action", acc.ActionID, "ansible_playbook_run", "ssh_user.yml"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMSchematicsJobExists("ibm_schematics_job.schematics_job", conf),
+					resource.TestCheckResourceAttr("ibm_schematics_job.schematics_job", "wait_for_completion", "true"),
+					resource.TestCheckResourceAttr("ibm_schematics_job.schematics_job", "status.0.action_job_status.0.status_code", "job_finished"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsJobWaitConfig(commandObject string, commandObjectID string, commandName string, commandParameter string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_schematics_job" "schematics_job" {
+			command_object = "%s"
+			command_object_id = "%s"
+			command_name = "%s"
+			command_parameter = "%s"
+			location = "us"
+			wait_for_completion = true
+		}
+	`, commandObject, commandObjectID, commandName, commandParameter)
+}
+
 func testAccCheckIBMSchematicsJobConfig(commandObject string, commandObjectID string, commandName string, commandParameter string) string {
 	return fmt.Sprintf(`
 
@@ -0,0 +1,573 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+func ResourceIBMSchematicsPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsPolicyCreate,
+		ReadContext:   resourceIBMSchematicsPolicyRead,
+		UpdateContext: resourceIBMSchematicsPolicyUpdate,
+		DeleteContext: resourceIBMSchematicsPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of Schematics customization policy.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of Schematics customization policy.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The resource group name for the policy.  By default, Policy will be created in `default` Resource Group.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Tags for the Schematics customization policy.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"location": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "location"),
+				Description:  "List of locations supported by IBM Cloud Schematics service.  While creating your workspace or action, choose the right region, since it cannot be changed.  Note, this does not limit the location of the IBM Cloud resources, provisioned using Schematics.",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "state"),
+				Description:  "User defined status of the Schematics object.",
+			},
+			"policy_kind": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      schematicsv1.CreatePolicyOptions_PolicyKind_AgentAssignmentPolicy,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "policy_kind"),
+				Description:  "Policy kind or categories for managing and deriving policy decision  * `agent_assignment_policy` Agent assignment policy for job execution.",
+			},
+			"policy_target": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "The objects for the Schematics policy.",
+				Elem: &schema.Resource{
+					Schema: resourceIBMSchematicsPolicySelectorSchema("agent"),
+				},
+			},
+			"policy_parameter": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "The parameter to tune the Schematics policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_assignment_policy_parameter": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Parameters for the `agent_assignment_policy`.",
+							Elem: &schema.Resource{
+								Schema: resourceIBMSchematicsPolicySelectorSchema("workspace"),
+							},
+						},
+					},
+				},
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy CRN.",
+			},
+			"account": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Account id.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy creation time.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who created the policy.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy updation time.",
+			},
+		},
+	}
+}
+
+// resourceIBMSchematicsPolicySelectorSchema returns the schema shared by the policy target and the
+// agent assignment parameter, objectKind names the Schematics object the ids refer to
+func resourceIBMSchematicsPolicySelectorSchema(objectKind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"selector_kind": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "selector_kind"),
+			Description:  "Types of schematics object selector.",
+		},
+		"selector_ids": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: fmt.Sprintf("Static selectors of schematics %s ids for the Schematics policy.", objectKind),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"selector_scope": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: fmt.Sprintf("Selectors to dynamically list of schematics %s ids for the Schematics policy.", objectKind),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "kind"),
+						Description:  "The type of Schematics object selector.",
+					},
+					"tags": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "The tag based selector.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "The resource group based selector.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"locations": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "The location based selector.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMSchematicsPolicyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "location",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "eu-de, eu-gb, us-east, us-south",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "state",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disable, draft, live, locked",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "policy_kind",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "agent_assignment_policy",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "selector_kind",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "ids, scoped",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "kind",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "agent, workspace",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_schematics_policy", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMSchematicsPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if r, ok := d.GetOk("location"); ok {
+		region := r.(string)
+		schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+		if updatedURL {
+			schematicsClient.Service.Options.URL = schematicsURL
+		}
+	}
+	createPolicyOptions := &schematicsv1.CreatePolicyOptions{}
+
+	createPolicyOptions.SetPolicyKind(d.Get("policy_kind").(string))
+	if _, ok := d.GetOk("name"); ok {
+		createPolicyOptions.SetName(d.Get("name").(string))
+	}
+	if _, ok := d.GetOk("description"); ok {
+		createPolicyOptions.SetDescription(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("resource_group"); ok {
+		createPolicyOptions.SetResourceGroup(d.Get("resource_group").(string))
+	}
+	if _, ok := d.GetOk("tags"); ok {
+		createPolicyOptions.SetTags(flex.ExpandStringList(d.Get("tags").([]interface{})))
+	}
+	if _, ok := d.GetOk("location"); ok {
+		createPolicyOptions.SetLocation(d.Get("location").(string))
+	}
+	if _, ok := d.GetOk("state"); ok {
+		createPolicyOptions.SetState(&schematicsv1.UserState{
+			State: core.StringPtr(d.Get("state").(string)),
+		})
+	}
+	if _, ok := d.GetOk("policy_target"); ok {
+		createPolicyOptions.SetPolicyTarget(resourceIBMSchematicsPolicyMapToPolicyObjects(d.Get("policy_target.0").(map[string]interface{})))
+	}
+	if _, ok := d.GetOk("policy_parameter"); ok {
+		createPolicyOptions.SetPolicyParameter(resourceIBMSchematicsPolicyMapToPolicyParameter(d.Get("policy_parameter.0").(map[string]interface{})))
+	}
+
+	policy, response, err := schematicsClient.CreatePolicyWithContext(context, createPolicyOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("CreatePolicyWithContext", err, response)
+	}
+
+	d.SetId(*policy.ID)
+
+	return resourceIBMSchematicsPolicyRead(context, d, meta)
+}
+
+func resourceIBMSchematicsPolicyMapToPolicyObjects(policyObjectsMap map[string]interface{}) *schematicsv1.PolicyObjects {
+	policyObjects := &schematicsv1.PolicyObjects{}
+
+	if policyObjectsMap["selector_kind"] != nil && policyObjectsMap["selector_kind"].(string) != "" {
+		policyObjects.SelectorKind = core.StringPtr(policyObjectsMap["selector_kind"].(string))
+	}
+	if policyObjectsMap["selector_ids"] != nil {
+		policyObjects.SelectorIds = flex.ExpandStringList(policyObjectsMap["selector_ids"].([]interface{}))
+	}
+	if policyObjectsMap["selector_scope"] != nil {
+		policyObjects.SelectorScope = resourceIBMSchematicsPolicyMapToPolicyObjectSelectors(policyObjectsMap["selector_scope"].([]interface{}))
+	}
+
+	return policyObjects
+}
+
+func resourceIBMSchematicsPolicyMapToPolicyParameter(policyParameterMap map[string]interface{}) *schematicsv1.PolicyParameter {
+	policyParameter := &schematicsv1.PolicyParameter{}
+
+	if parameters, ok := policyParameterMap["agent_assignment_policy_parameter"].([]interface{}); ok && len(parameters) > 0 && parameters[0] != nil {
+		parameterMap := parameters[0].(map[string]interface{})
+		agentAssignmentPolicyParameter := &schematicsv1.AgentAssignmentPolicyParameter{}
+		if parameterMap["selector_kind"] != nil && parameterMap["selector_kind"].(string) != "" {
+			agentAssignmentPolicyParameter.SelectorKind = core.StringPtr(parameterMap["selector_kind"].(string))
+		}
+		if parameterMap["selector_ids"] != nil {
+			agentAssignmentPolicyParameter.SelectorIds = flex.ExpandStringList(parameterMap["selector_ids"].([]interface{}))
+		}
+		if parameterMap["selector_scope"] != nil {
+			agentAssignmentPolicyParameter.SelectorScope = resourceIBMSchematicsPolicyMapToPolicyObjectSelectors(parameterMap["selector_scope"].([]interface{}))
+		}
+		policyParameter.AgentAssignmentPolicyParameter = agentAssignmentPolicyParameter
+	}
+
+	return policyParameter
+}
+
+func resourceIBMSchematicsPolicyMapToPolicyObjectSelectors(selectorScope []interface{}) []schematicsv1.PolicyObjectSelector {
+	selectors := []schematicsv1.PolicyObjectSelector{}
+	for _, selectorScopeItem := range selectorScope {
+		selectorMap := selectorScopeItem.(map[string]interface{})
+		selector := schematicsv1.PolicyObjectSelector{}
+		if selectorMap["kind"] != nil && selectorMap["kind"].(string) != "" {
+			selector.Kind = core.StringPtr(selectorMap["kind"].(string))
+		}
+		if selectorMap["tags"] != nil {
+			selector.Tags = flex.ExpandStringList(selectorMap["tags"].([]interface{}))
+		}
+		if selectorMap["resource_groups"] != nil {
+			selector.ResourceGroups = flex.ExpandStringList(selectorMap["resource_groups"].([]interface{}))
+		}
+		if selectorMap["locations"] != nil {
+			selector.Locations = flex.ExpandStringList(selectorMap["locations"].([]interface{}))
+		}
+		selectors = append(selectors, selector)
+	}
+	return selectors
+}
+
+func resourceIBMSchematicsPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policyIDSplit := strings.Split(d.Id(), ".")
+	region := policyIDSplit[0]
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+	if updatedURL {
+		schematicsClient.Service.Options.URL = schematicsURL
+	}
+
+	getPolicyOptions := &schematicsv1.GetPolicyOptions{}
+
+	getPolicyOptions.SetPolicyID(d.Id())
+
+	policy, response, err := schematicsClient.GetPolicyWithContext(context, getPolicyOptions)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return flex.ServiceErrorDiags("GetPolicyWithContext", err, response)
+	}
+	if err = d.Set("name", policy.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("description", policy.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+	if err = d.Set("resource_group", policy.ResourceGroup); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
+	}
+	if policy.Tags != nil {
+		if err = d.Set("tags", policy.Tags); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting tags: %s", err))
+		}
+	}
+	if err = d.Set("location", policy.Location); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting location: %s", err))
+	}
+	if policy.State != nil {
+		if err = d.Set("state", policy.State.State); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting state: %s", err))
+		}
+	}
+	if err = d.Set("policy_kind", policy.PolicyKind); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting policy_kind: %s", err))
+	}
+	if policy.PolicyTarget != nil {
+		if err = d.Set("policy_target", []map[string]interface{}{resourceIBMSchematicsPolicyPolicyObjectsToMap(*policy.PolicyTarget)}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting policy_target: %s", err))
+		}
+	}
+	policyParameter := []map[string]interface{}{}
+	if policy.PolicyParameter != nil {
+		if policyParameterMap := resourceIBMSchematicsPolicyPolicyParameterToMap(*policy.PolicyParameter); len(policyParameterMap) > 0 {
+			policyParameter = append(policyParameter, policyParameterMap)
+		}
+	}
+	if err = d.Set("policy_parameter", policyParameter); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting policy_parameter: %s", err))
+	}
+	if err = d.Set("crn", policy.Crn); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
+	}
+	if err = d.Set("account", policy.Account); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account: %s", err))
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(policy.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("created_by", policy.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_by: %s", err))
+	}
+	if err = d.Set("updated_at", flex.DateTimeToString(policy.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMSchematicsPolicyPolicyObjectsToMap(policyObjects schematicsv1.PolicyObjects) map[string]interface{} {
+	policyObjectsMap := map[string]interface{}{}
+
+	if policyObjects.SelectorKind != nil {
+		policyObjectsMap["selector_kind"] = policyObjects.SelectorKind
+	}
+	if policyObjects.SelectorIds != nil {
+		policyObjectsMap["selector_ids"] = policyObjects.SelectorIds
+	}
+	if policyObjects.SelectorScope != nil {
+		policyObjectsMap["selector_scope"] = resourceIBMSchematicsPolicyPolicyObjectSelectorsToMap(policyObjects.SelectorScope)
+	}
+
+	return policyObjectsMap
+}
+
+func resourceIBMSchematicsPolicyPolicyParameterToMap(policyParameter schematicsv1.PolicyParameter) map[string]interface{} {
+	policyParameterMap := map[string]interface{}{}
+
+	if policyParameter.AgentAssignmentPolicyParameter != nil {
+		parameter := policyParameter.AgentAssignmentPolicyParameter
+		parameterMap := map[string]interface{}{}
+		if parameter.SelectorKind != nil {
+			parameterMap["selector_kind"] = parameter.SelectorKind
+		}
+		if parameter.SelectorIds != nil {
+			parameterMap["selector_ids"] = parameter.SelectorIds
+		}
+		if parameter.SelectorScope != nil {
+			parameterMap["selector_scope"] = resourceIBMSchematicsPolicyPolicyObjectSelectorsToMap(parameter.SelectorScope)
+		}
+		policyParameterMap["agent_assignment_policy_parameter"] = []map[string]interface{}{parameterMap}
+	}
+
+	return policyParameterMap
+}
+
+func resourceIBMSchematicsPolicyPolicyObjectSelectorsToMap(selectors []schematicsv1.PolicyObjectSelector) []map[string]interface{} {
+	selectorScope := []map[string]interface{}{}
+	for _, selector := range selectors {
+		selectorMap := map[string]interface{}{}
+		if selector.Kind != nil {
+			selectorMap["kind"] = selector.Kind
+		}
+		if selector.Tags != nil {
+			selectorMap["tags"] = selector.Tags
+		}
+		if selector.ResourceGroups != nil {
+			selectorMap["resource_groups"] = selector.ResourceGroups
+		}
+		if selector.Locations != nil {
+			selectorMap["locations"] = selector.Locations
+		}
+		selectorScope = append(selectorScope, selectorMap)
+	}
+	return selectorScope
+}
+
+func resourceIBMSchematicsPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policyIDSplit := strings.Split(d.Id(), ".")
+	region := policyIDSplit[0]
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+	if updatedURL {
+		schematicsClient.Service.Options.URL = schematicsURL
+	}
+
+	updatePolicyOptions := &schematicsv1.UpdatePolicyOptions{}
+
+	updatePolicyOptions.SetPolicyID(d.Id())
+	updatePolicyOptions.SetPolicyKind(d.Get("policy_kind").(string))
+
+	hasChange := false
+
+	if d.HasChange("name") {
+		updatePolicyOptions.SetName(d.Get("name").(string))
+		hasChange = true
+	}
+	if d.HasChange("description") {
+		updatePolicyOptions.SetDescription(d.Get("description").(string))
+		hasChange = true
+	}
+	if d.HasChange("resource_group") {
+		updatePolicyOptions.SetResourceGroup(d.Get("resource_group").(string))
+		hasChange = true
+	}
+	if d.HasChange("tags") {
+		updatePolicyOptions.SetTags(flex.ExpandStringList(d.Get("tags").([]interface{})))
+		hasChange = true
+	}
+	if d.HasChange("state") {
+		updatePolicyOptions.SetState(&schematicsv1.UserState{
+			State: core.StringPtr(d.Get("state").(string)),
+		})
+		hasChange = true
+	}
+	if d.HasChange("policy_kind") {
+		hasChange = true
+	}
+	if d.HasChange("policy_target") {
+		policyTarget := &schematicsv1.PolicyObjects{}
+		if _, ok := d.GetOk("policy_target"); ok {
+			policyTarget = resourceIBMSchematicsPolicyMapToPolicyObjects(d.Get("policy_target.0").(map[string]interface{}))
+		}
+		updatePolicyOptions.SetPolicyTarget(policyTarget)
+		hasChange = true
+	}
+	if d.HasChange("policy_parameter") {
+		policyParameter := &schematicsv1.PolicyParameter{}
+		if _, ok := d.GetOk("policy_parameter"); ok {
+			policyParameter = resourceIBMSchematicsPolicyMapToPolicyParameter(d.Get("policy_parameter.0").(map[string]interface{}))
+		}
+		updatePolicyOptions.SetPolicyParameter(policyParameter)
+		hasChange = true
+	}
+
+	if hasChange {
+		_, response, err := schematicsClient.UpdatePolicyWithContext(context, updatePolicyOptions)
+		if err != nil {
+			return flex.ServiceErrorDiags("UpdatePolicyWithContext", err, response)
+		}
+	}
+
+	return resourceIBMSchematicsPolicyRead(context, d, meta)
+}
+
+func resourceIBMSchematicsPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policyIDSplit := strings.Split(d.Id(), ".")
+	region := policyIDSplit[0]
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+	if updatedURL {
+		schematicsClient.Service.Options.URL = schematicsURL
+	}
+
+	deletePolicyOptions := &schematicsv1.DeletePolicyOptions{}
+
+	deletePolicyOptions.SetPolicyID(d.Id())
+
+	response, err := schematicsClient.DeletePolicyWithContext(context, deletePolicyOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("DeletePolicyWithContext", err, response)
+	}
+
+	d.SetId("")
+
+	return nil
+}
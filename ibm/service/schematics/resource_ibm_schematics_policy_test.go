@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+func TestAccIBMSchematicsPolicyBasic(t *testing.T) {
+	var conf schematicsv1.Policy
+	name := fmt.Sprintf("tf-policy-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-policy-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMSchematicsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsPolicyConfig(name, "env:dev"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMSchematicsPolicyExists("ibm_schematics_policy.schematics_policy", conf),
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "name", name),
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "policy_kind", "agent_assignment_policy"),
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "policy_parameter.0.agent_assignment_policy_parameter.0.selector_scope.0.tags.0", "env:dev"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsPolicyConfig(nameUpdate, "env:prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "policy_parameter.0.agent_assignment_policy_parameter.0.selector_scope.0.tags.0", "env:prod"),
+				),
+			},
+			{
+				ResourceName:      "ibm_schematics_policy.schematics_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsPolicyConfig(name string, tag string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_schematics_agent" "schematics_agent" {
+			name           = "%[1]s-agent"
+			agent_location = "us-south"
+			location       = "us-south"
+			profile_id     = "%[3]s"
+		}
+
+		resource "ibm_schematics_policy" "schematics_policy" {
+			name        = "%[1]s"
+			description = "Assign tagged workspaces to the agent"
+			location    = "us-south"
+			policy_kind = "agent_assignment_policy"
+			policy_target {
+				selector_kind = "ids"
+				selector_ids  = [ibm_schematics_agent.schematics_agent.id]
+			}
+			policy_parameter {
+				agent_assignment_policy_parameter {
+					selector_kind = "scoped"
+					selector_scope {
+						kind      = "workspace"
+						tags      = ["%[2]s"]
+						locations = ["us-south"]
+					}
+				}
+			}
+		}
+	`, name, tag, acc.SchematicsAgentProfileID)
+}
+
+func testAccCheckIBMSchematicsPolicyExists(n string, obj schematicsv1.Policy) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		schematicsClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SchematicsV1()
+		if err != nil {
+			return err
+		}
+
+		getPolicyOptions := &schematicsv1.GetPolicyOptions{}
+
+		getPolicyOptions.SetPolicyID(rs.Primary.ID)
+
+		policy, _, err := schematicsClient.GetPolicy(getPolicyOptions)
+		if err != nil {
+			return err
+		}
+
+		obj = *policy
+		return nil
+	}
+}
+
+func testAccCheckIBMSchematicsPolicyDestroy(s *terraform.State) error {
+	schematicsClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_schematics_policy" {
+			continue
+		}
+
+		getPolicyOptions := &schematicsv1.GetPolicyOptions{}
+
+		getPolicyOptions.SetPolicyID(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := schematicsClient.GetPolicy(getPolicyOptions)
+
+		if err == nil {
+			return fmt.Errorf("schematics_policy still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for schematics_policy (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -6,7 +6,6 @@ package schematics
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -166,8 +165,7 @@ func resourceIBMSchematicsResourceQueryCreate(context context.Context, d *schema
 
 	resourceQueryRecord, response, err := schematicsClient.CreateResourceQueryWithContext(context, createResourceQueryOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateResourceQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateResourceQueryWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateResourceQueryWithContext", err, response)
 	}
 
 	d.SetId(*resourceQueryRecord.ID)
@@ -233,12 +231,11 @@ func resourceIBMSchematicsResourceQueryRead(context context.Context, d *schema.R
 
 	resourceQueryRecord, response, err := schematicsClient.GetResourcesQueryWithContext(context, getResourcesQueryOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetResourcesQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetResourcesQueryWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetResourcesQueryWithContext", err, response)
 	}
 	if err = d.Set("type", resourceQueryRecord.Type); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
@@ -342,8 +339,7 @@ func resourceIBMSchematicsResourceQueryUpdate(context context.Context, d *schema
 
 	_, response, err := schematicsClient.ReplaceResourcesQueryWithContext(context, replaceResourcesQueryOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ReplaceResourcesQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceResourcesQueryWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ReplaceResourcesQueryWithContext", err, response)
 	}
 
 	return resourceIBMSchematicsResourceQueryRead(context, d, meta)
@@ -366,8 +362,7 @@ func resourceIBMSchematicsResourceQueryDelete(context context.Context, d *schema
 
 	response, err := schematicsClient.DeleteResourcesQueryWithContext(context, deleteResourcesQueryOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteResourcesQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteResourcesQueryWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteResourcesQueryWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package schematics
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -748,8 +747,7 @@ func resourceIBMSchematicsWorkspaceCreate(context context.Context, d *schema.Res
 
 	workspaceResponse, response, err := schematicsClient.CreateWorkspaceWithContext(context, createWorkspaceOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateWorkspaceWithContext", err, response)
 	}
 
 	d.SetId(*workspaceResponse.ID)
@@ -1087,12 +1085,11 @@ func resourceIBMSchematicsWorkspaceRead(context context.Context, d *schema.Resou
 
 	workspaceResponse, response, err := schematicsClient.GetWorkspaceWithContext(context, getWorkspaceOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetWorkspaceWithContext", err, response)
 	}
 	if workspaceResponse.AppliedShareddataIds != nil {
 		if err = d.Set("applied_shareddata_ids", workspaceResponse.AppliedShareddataIds); err != nil {
@@ -1811,16 +1808,14 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 			changed = true
 			_, response, err := schematicsClient.ReplaceWorkspaceWithContext(context, replaceWorkspaceOptions)
 			if err != nil {
-				log.Printf("[DEBUG] ReplaceWorkspaceWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("ReplaceWorkspaceWithContext failed %s\n%s", err, response))
+				return flex.ServiceErrorDiags("ReplaceWorkspaceWithContext", err, response)
 			}
 		}
 
 		if !changed && metadataChange {
 			_, response, err := schematicsClient.UpdateWorkspaceWithContext(context, updateWorkspaceOptions)
 			if err != nil {
-				log.Printf("[DEBUG] UpdateWorkspaceWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("UpdateWorkspaceWithContext failed %s\n%s", err, response))
+				return flex.ServiceErrorDiags("UpdateWorkspaceWithContext", err, response)
 			}
 		}
 
@@ -1846,8 +1841,7 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 
 				_, response, err := schematicsClient.ReplaceWorkspaceInputs(replaceWorkspaceInputsOptions)
 				if err != nil {
-					log.Printf("[DEBUG] ReplaceWorkspaceInputs failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("ReplaceWorkspaceInputs failed %s\n%s", err, response))
+					return flex.ServiceErrorDiags("ReplaceWorkspaceInputs", err, response)
 				}
 			}
 		}
@@ -1882,8 +1876,7 @@ func resourceIBMSchematicsWorkspaceDelete(context context.Context, d *schema.Res
 
 	_, response, err := schematicsClient.DeleteWorkspaceWithContext(context, deleteWorkspaceOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteWorkspaceWithContext", err, response)
 	}
 
 	d.SetId("")
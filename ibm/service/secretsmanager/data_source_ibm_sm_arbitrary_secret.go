@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -139,8 +138,7 @@ func dataSourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resou
 
 	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 	arbitrarySecret := secretIntf.(*secretsmanagerv2.ArbitrarySecret)
 
@@ -129,6 +129,9 @@ func dataSourceIbmSmConfigurationsRead(context context.Context, d *schema.Resour
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
 	if err = d.Set("configurations", mapSlice); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting configurations %s", err))
 	}
@@ -8,9 +8,9 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
@@ -42,8 +42,7 @@ func dataSourceIbmSmEnRegistrationRead(context context.Context, d *schema.Resour
 
 	notificationsRegistration, response, err := secretsManagerClient.GetNotificationsRegistrationWithContext(context, getNotificationsRegistrationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetNotificationsRegistrationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetNotificationsRegistrationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetNotificationsRegistrationWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", region, instanceId))
@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -201,8 +200,7 @@ func dataSourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.
 
 	iAMCredentialsSecretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
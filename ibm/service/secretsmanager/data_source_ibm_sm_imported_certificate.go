@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -180,6 +179,32 @@ func DataSourceIbmSmImportedCertificate() *schema.Resource {
 				Sensitive:   true,
 				Description: "The PEM-encoded contents of your certificate.",
 			},
+			"sha1_fingerprint": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-1 fingerprint of the certificate, as colon-separated hexadecimal pairs.",
+			},
+			"sha256_fingerprint": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate, as colon-separated hexadecimal pairs.",
+			},
+			"subject": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the subject of the certificate.",
+			},
+			"subject_alt_names": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Subject Alternative Names that are read from the certificate: DNS names, IP addresses, email addresses and URIs.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"days_until_expiry": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of whole days until the certificate expires, as of the last refresh.",
+			},
 			"intermediate": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -213,8 +238,7 @@ func dataSourceIbmSmImportedCertificateRead(context context.Context, d *schema.R
 
 	importedCertificateIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 
 	importedCertificate := importedCertificateIntf.(*secretsmanagerv2.ImportedCertificate)
@@ -336,6 +360,9 @@ func dataSourceIbmSmImportedCertificateRead(context context.Context, d *schema.R
 	if err = d.Set("certificate", importedCertificate.Certificate); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting certificate: %s", err))
 	}
+	if err = setCertificateAttributes(d, importedCertificate.Certificate); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if err = d.Set("intermediate", importedCertificate.Intermediate); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting intermediate: %s", err))
@@ -25,6 +25,7 @@ func TestAccIbmSmImportedCertificateDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_sm_imported_certificate.sm_imported_certificate", "created_by"),
 					resource.TestCheckResourceAttrSet("data.ibm_sm_imported_certificate.sm_imported_certificate", "created_at"),
 					resource.TestCheckResourceAttrSet("data.ibm_sm_imported_certificate.sm_imported_certificate", "crn"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_imported_certificate.sm_imported_certificate", "sha256_fingerprint"),
 					resource.TestCheckResourceAttrSet("data.ibm_sm_imported_certificate.sm_imported_certificate", "secret_group_id"),
 					resource.TestCheckResourceAttrSet("data.ibm_sm_imported_certificate.sm_imported_certificate", "secret_type"),
 					resource.TestCheckResourceAttrSet("data.ibm_sm_imported_certificate.sm_imported_certificate", "updated_at"),
@@ -5,8 +5,8 @@ package secretsmanager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -116,6 +116,12 @@ func DataSourceIbmSmKvSecret() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"data_json": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload data of a key-value secret as a JSON object.",
+			},
 		},
 	}
 }
@@ -137,8 +143,7 @@ func dataSourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData
 
 	kVSecretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 	kVSecret := kVSecretIntf.(*secretsmanagerv2.KVSecret)
 
@@ -226,6 +231,14 @@ func dataSourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting data %s", err))
 		}
+
+		dataJson, err := json.Marshal(kVSecret.Data)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error marshalling data_json: %s", err))
+		}
+		if err = d.Set("data_json", string(dataJson)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting data_json: %s", err))
+		}
 	}
 
 	return nil
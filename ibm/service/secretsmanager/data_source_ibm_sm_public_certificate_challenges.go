@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// DataSourceIbmSmPublicCertificateChallenges reads the DNS challenges of a public certificate
+// that is ordered with a manual DNS provider, so that the TXT records can be created in a
+// workspace that does not manage the certificate.
+func DataSourceIbmSmPublicCertificateChallenges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmPublicCertificateChallengesRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the public certificate.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The secret state that is based on NIST SP 800-57. States are integers and correspond to the `Pre-activation = 0`, `Active = 1`,  `Suspended = 2`, `Deactivated = 3`, and `Destroyed = 5` values.",
+			},
+			"state_description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A text representation of the secret state.",
+			},
+			"challenges": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DNS challenges of the current order of the certificate. It is empty when the certificate is not ordered with a manual DNS provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The challenge domain.",
+						},
+						"expiration": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The challenge expiration date. The date format follows RFC 3339.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The challenge status.",
+						},
+						"txt_record_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The TXT record name.",
+						},
+						"txt_record_value": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The TXT record value.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmPublicCertificateChallengesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+
+	secretId := d.Get("secret_id").(string)
+	getSecretMetadataOptions.SetID(secretId)
+
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("GetSecretMetadataWithContext", err, response)
+	}
+
+	publicCertificateMetadata, ok := secretMetadataIntf.(*secretsmanagerv2.PublicCertificateMetadata)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("[ERROR] The secret %s is not a public certificate", secretId))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	challenges := []map[string]interface{}{}
+	if publicCertificateMetadata.IssuanceInfo != nil {
+		for _, challengesItem := range publicCertificateMetadata.IssuanceInfo.Challenges {
+			challengesItemMap, err := dataSourceIbmSmPublicCertificateMetadataChallengeResourceToMap(&challengesItem)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			challenges = append(challenges, challengesItemMap)
+		}
+	}
+
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("state", publicCertificateMetadata.State); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting state: %s", err))
+	}
+	if err = d.Set("state_description", publicCertificateMetadata.StateDescription); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting state_description: %s", err))
+	}
+	if err = d.Set("challenges", challenges); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting challenges: %s", err))
+	}
+
+	return nil
+}
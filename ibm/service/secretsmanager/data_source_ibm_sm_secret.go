@@ -0,0 +1,368 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// DataSourceIbmSmSecret reads a secret of any type, by ID, by CRN or by its name and the name
+// of its secret group. Only the payload attributes that match the secret type are set.
+// A CRN also identifies the instance and region of the secret, so instance_id is not needed with it.
+func DataSourceIbmSmSecret() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Secrets Manager instance. It is required unless `crn` is set, in which case the instance of the CRN is used.",
+			},
+			"secret_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"secret_id", "crn", "name"},
+				Description:  "The ID of the secret.",
+			},
+			"crn": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"secret_id", "crn", "name"},
+				Description:  "A CRN that uniquely identifies the secret.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"secret_id", "crn", "name"},
+				Description:  "The human-readable name of the secret.",
+			},
+			"secret_group_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"name"},
+				Description:  "The name of the secret group of the secret, `default` if not specified.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The secret type. Use it to choose between secrets of different types with the same name.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A v4 UUID identifier, or `default` secret group.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An extended description of your secret.",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Labels that you can use to search for secrets in your instance.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The secret state that is based on NIST SP 800-57.",
+			},
+			"state_description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A text representation of the secret state.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was created. The date format follows RFC 3339.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
+			},
+			"expiration_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"versions_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions of the secret.",
+			},
+			"locks_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of locks of the secret.",
+			},
+			"payload": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload of an arbitrary secret.",
+			},
+			"data_json": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload data of a key-value secret as a JSON object.",
+			},
+			"username": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The username of a user credentials secret.",
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of a user credentials secret.",
+			},
+			"api_key_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the API key of an IAM credentials secret.",
+			},
+			"api_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key of an IAM credentials secret.",
+			},
+			"certificate": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM-encoded contents of a certificate.",
+			},
+			"intermediate": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM-encoded intermediate certificate of an imported or public certificate.",
+			},
+			"private_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded private key of a certificate.",
+			},
+			"issuing_ca": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM-encoded certificate of the certificate authority that issued a private certificate.",
+			},
+			"ca_chain": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The chain of certificate authorities of a private certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	if crn, ok := d.GetOk("crn"); ok {
+		id, err := secretIdFromCrn(crn.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		region = strings.Split(id, "/")[0]
+		instanceId = strings.Split(id, "/")[1]
+	}
+	if instanceId == "" {
+		return diag.FromErr(fmt.Errorf("[ERROR] instance_id is required unless crn is set"))
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId, err := dataSourceIbmSmSecretFindID(context, secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+
+	getSecretMetadataOptions.SetID(secretId)
+
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("GetSecretMetadataWithContext", err, response)
+	}
+
+	secretMetadata, err := dataSourceIbmSmSecretsSecretMetadataToMap(secretMetadataIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("secret_id", secretId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	for _, key := range []string{"crn", "name", "secret_type", "secret_group_id", "description", "labels", "state",
+		"state_description", "created_at", "updated_at", "expiration_date", "versions_total", "locks_total"} {
+		if err = d.Set(key, secretMetadata[key]); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", key, err))
+		}
+	}
+
+	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+	getSecretOptions.SetID(secretId)
+
+	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
+	}
+
+	payload := map[string]interface{}{}
+	switch secret := secretIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecret:
+		payload["payload"] = secret.Payload
+	case *secretsmanagerv2.KVSecret:
+		dataJson, err := json.Marshal(secret.Data)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error marshalling data_json: %s", err))
+		}
+		payload["data_json"] = string(dataJson)
+	case *secretsmanagerv2.UsernamePasswordSecret:
+		payload["username"] = secret.Username
+		payload["password"] = secret.Password
+	case *secretsmanagerv2.IAMCredentialsSecret:
+		payload["api_key_id"] = secret.ApiKeyID
+		payload["api_key"] = secret.ApiKey
+	case *secretsmanagerv2.ImportedCertificate:
+		payload["certificate"] = secret.Certificate
+		payload["intermediate"] = secret.Intermediate
+		payload["private_key"] = secret.PrivateKey
+	case *secretsmanagerv2.PublicCertificate:
+		payload["certificate"] = secret.Certificate
+		payload["intermediate"] = secret.Intermediate
+		payload["private_key"] = secret.PrivateKey
+	case *secretsmanagerv2.PrivateCertificate:
+		payload["certificate"] = secret.Certificate
+		payload["private_key"] = secret.PrivateKey
+		payload["issuing_ca"] = secret.IssuingCa
+		payload["ca_chain"] = secret.CaChain
+	}
+	for key, value := range payload {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", key, err))
+		}
+	}
+
+	return nil
+}
+
+// dataSourceIbmSmSecretFindID returns the ID of the secret that is selected by secret_id, crn, or
+// name and secret_group_name.
+func dataSourceIbmSmSecretFindID(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (string, error) {
+	if secretId, ok := d.GetOk("secret_id"); ok {
+		return secretId.(string), nil
+	}
+
+	if crn, ok := d.GetOk("crn"); ok {
+		// crn:v1:<cname>:<ctype>:secrets-manager:<region>:a/<account>:<instance>:secret:<id>
+		parts := strings.Split(crn.(string), ":")
+		if len(parts) < 2 || parts[len(parts)-2] != "secret" || parts[len(parts)-1] == "" {
+			return "", fmt.Errorf("[ERROR] %s is not the CRN of a secret", crn)
+		}
+		return parts[len(parts)-1], nil
+	}
+
+	name := d.Get("name").(string)
+	secretGroupId, err := dataSourceIbmSmSecretFindGroupID(context, secretsManagerClient, d.Get("secret_group_name").(string))
+	if err != nil {
+		return "", err
+	}
+
+	listSecretsOptions := &secretsmanagerv2.ListSecretsOptions{}
+
+	listSecretsOptions.SetGroups([]string{secretGroupId})
+	listSecretsOptions.SetSearch(name)
+
+	pager, err := secretsManagerClient.NewSecretsPager(listSecretsOptions)
+	if err != nil {
+		return "", err
+	}
+
+	allItems, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] SecretsPager.GetAll() failed %s", err)
+		return "", fmt.Errorf("SecretsPager.GetAll() failed %s", err)
+	}
+
+	// The search also matches labels and parts of names
+	secretType := d.Get("secret_type").(string)
+	var secretIds []string
+	for _, item := range allItems {
+		secretMetadata, err := dataSourceIbmSmSecretsSecretMetadataToMap(item)
+		if err != nil {
+			return "", err
+		}
+		if secretMetadata["name"] != name || (secretType != "" && secretMetadata["secret_type"] != secretType) {
+			continue
+		}
+		secretIds = append(secretIds, secretMetadata["id"].(string))
+	}
+
+	if len(secretIds) == 0 {
+		return "", fmt.Errorf("[ERROR] No secret named %s was found in the secret group %s", name, secretGroupId)
+	}
+	if len(secretIds) > 1 {
+		return "", fmt.Errorf("[ERROR] %d secrets named %s were found in the secret group %s, set secret_type to choose one of them", len(secretIds), name, secretGroupId)
+	}
+	return secretIds[0], nil
+}
+
+func dataSourceIbmSmSecretFindGroupID(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretGroupName string) (string, error) {
+	if secretGroupName == "" || secretGroupName == "default" {
+		return "default", nil
+	}
+
+	secretGroups, response, err := secretsManagerClient.ListSecretGroupsWithContext(context, &secretsmanagerv2.ListSecretGroupsOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretGroupsWithContext failed %s\n%s", err, response)
+		return "", fmt.Errorf("ListSecretGroupsWithContext failed %s\n%s", err, response)
+	}
+
+	for _, secretGroup := range secretGroups.SecretGroups {
+		if secretGroup.Name != nil && *secretGroup.Name == secretGroupName {
+			return *secretGroup.ID, nil
+		}
+	}
+	return "", fmt.Errorf("[ERROR] No secret group named %s was found", secretGroupName)
+}
@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -67,8 +66,7 @@ func dataSourceIbmSmSecretGroupRead(context context.Context, d *schema.ResourceD
 
 	secretGroup, response, err := secretsManagerClient.GetSecretGroupWithContext(context, getSecretGroupOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetSecretGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretGroupWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretGroupWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretGroupId))
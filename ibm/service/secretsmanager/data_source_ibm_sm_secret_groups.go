@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -79,8 +78,7 @@ func dataSourceIbmSmSecretGroupsRead(context context.Context, d *schema.Resource
 
 	secretGroupCollection, response, err := secretsManagerClient.ListSecretGroupsWithContext(context, listSecretGroupsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListSecretGroupsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListSecretGroupsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListSecretGroupsWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", region, instanceId))
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+func DataSourceIbmSmSecretLocks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretLocksRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the secret.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the secret version, or the `current` or `previous` alias. If it is not set, the locks of all the versions of the secret are listed.",
+			},
+			"search": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter locks that contain the specified string in the field \"name\".",
+			},
+			"locks": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A collection of secret locks.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A human-readable name to assign to the lock.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An extended description of the lock.",
+						},
+						"attributes": &schema.Schema{
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Optional information to associate with a lock, such as resources CRNs to be used by automation.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the lock was created. The date format follows RFC 3339.",
+						},
+						"updated_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the lock was recently modified. The date format follows RFC 3339.",
+						},
+						"created_by": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier that is associated with the entity that created the lock.",
+						},
+						"secret_group_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A v4 UUID identifier, or `default` secret group.",
+						},
+						"secret_version_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the secret version that the lock is attached to.",
+						},
+						"secret_version_alias": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A human-readable alias that describes the secret version.",
+						},
+					},
+				},
+			},
+			"total_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of locks.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretLocksRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+
+	var allItems []secretsmanagerv2.SecretLock
+	if versionId, ok := d.GetOk("version_id"); ok {
+		listSecretVersionLocksOptions := &secretsmanagerv2.ListSecretVersionLocksOptions{}
+
+		listSecretVersionLocksOptions.SetSecretID(secretId)
+		listSecretVersionLocksOptions.SetID(versionId.(string))
+		if search, ok := d.GetOk("search"); ok {
+			listSecretVersionLocksOptions.SetSearch(search.(string))
+		}
+
+		pager, err := secretsManagerClient.NewSecretVersionLocksPager(listSecretVersionLocksOptions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		allItems, err = pager.GetAllWithContext(context)
+		if err != nil {
+			log.Printf("[DEBUG] SecretVersionLocksPager.GetAll() failed %s", err)
+			return diag.FromErr(fmt.Errorf("SecretVersionLocksPager.GetAll() failed %s", err))
+		}
+	} else {
+		listSecretLocksOptions := &secretsmanagerv2.ListSecretLocksOptions{}
+
+		listSecretLocksOptions.SetID(secretId)
+		if search, ok := d.GetOk("search"); ok {
+			listSecretLocksOptions.SetSearch(search.(string))
+		}
+
+		pager, err := secretsManagerClient.NewSecretLocksPager(listSecretLocksOptions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		allItems, err = pager.GetAllWithContext(context)
+		if err != nil {
+			log.Printf("[DEBUG] SecretLocksPager.GetAll() failed %s", err)
+			return diag.FromErr(fmt.Errorf("SecretLocksPager.GetAll() failed %s", err))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	locks := []map[string]interface{}{}
+	for _, lock := range allItems {
+		locks = append(locks, dataSourceIbmSmSecretLocksSecretLockToMap(&lock))
+	}
+
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("locks", locks); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting locks: %s", err))
+	}
+	if err = d.Set("total_count", len(locks)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting total_count: %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIbmSmSecretLocksSecretLockToMap(model *secretsmanagerv2.SecretLock) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	if model.Name != nil {
+		modelMap["name"] = *model.Name
+	}
+	if model.Description != nil {
+		modelMap["description"] = *model.Description
+	}
+	if model.Attributes != nil {
+		modelMap["attributes"] = flex.Flatten(model.Attributes)
+	}
+	if model.CreatedAt != nil {
+		modelMap["created_at"] = model.CreatedAt.String()
+	}
+	if model.UpdatedAt != nil {
+		modelMap["updated_at"] = model.UpdatedAt.String()
+	}
+	if model.CreatedBy != nil {
+		modelMap["created_by"] = *model.CreatedBy
+	}
+	if model.SecretGroupID != nil {
+		modelMap["secret_group_id"] = *model.SecretGroupID
+	}
+	if model.SecretVersionID != nil {
+		modelMap["secret_version_id"] = *model.SecretVersionID
+	}
+	if model.SecretVersionAlias != nil {
+		modelMap["secret_version_alias"] = *model.SecretVersionAlias
+	}
+	return modelMap
+}
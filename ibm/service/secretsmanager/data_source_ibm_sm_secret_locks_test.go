@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretLocksDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretLocksDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_locks.sm_secret_locks", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_locks.sm_secret_locks", "locks.0.name", "terraform-test-lock-ds"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_locks.sm_secret_locks", "locks.0.secret_version_alias", "current"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretLocksDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-secret-locks-ds"
+			payload = "secret-credentials"
+		}
+
+		resource "ibm_sm_secret_lock" "sm_secret_lock" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+			name = "terraform-test-lock-ds"
+		}
+
+		data "ibm_sm_secret_locks" "sm_secret_locks" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_secret_lock.sm_secret_lock.secret_id
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
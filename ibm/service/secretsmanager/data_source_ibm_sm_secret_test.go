@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_sm_secret.by_name", "secret_type", "arbitrary"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret.by_name", "payload", "secret-credentials"),
+					resource.TestCheckResourceAttrPair("data.ibm_sm_secret.by_name", "secret_id", "ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance", "secret_id"),
+					resource.TestCheckResourceAttrPair("data.ibm_sm_secret.by_name", "secret_group_id", "ibm_sm_secret_group.sm_secret_group_instance", "secret_group_id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret.by_crn", "secret_type", "kv"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret.by_crn", "data_json", `{"key":"value"}`),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret.by_crn", "payload", ""),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret.by_crn", "instance_id", acc.SecretsManagerInstanceID),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret.by_crn", "region", acc.SecretsManagerInstanceRegion),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_secret_group" "sm_secret_group_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name          = "terraform-test-secret-ds-group"
+		}
+
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-secret-ds"
+			payload = "secret-credentials"
+			secret_group_id = ibm_sm_secret_group.sm_secret_group_instance.secret_group_id
+		}
+
+		resource "ibm_sm_kv_secret" "sm_kv_secret_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-secret-ds"
+			data = {"key":"value"}
+		}
+
+		data "ibm_sm_secret" "by_name" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.name
+			secret_group_name = ibm_sm_secret_group.sm_secret_group_instance.name
+		}
+
+		data "ibm_sm_secret" "by_crn" {
+			crn = ibm_sm_kv_secret.sm_kv_secret_instance.crn
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,377 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// DataSourceIbmSmSecretVersion reads a version of a secret of any type. The payload is only
+// read when include_payload is set, because reading it marks the version as downloaded.
+func DataSourceIbmSmSecretVersion() *schema.Resource {
+	versionSchema := dataSourceIbmSmSecretVersionMetadataSchema()
+	versionSchema["secret_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The ID of the secret.",
+	}
+	versionSchema["version_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "current",
+		Description: "The ID of the secret version, or the `current` or `previous` alias.",
+	}
+	versionSchema["include_payload"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to read the payload of the secret version.",
+	}
+	versionSchema["resolved_version_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the secret version that version_id refers to.",
+	}
+	for key, payloadSchema := range dataSourceIbmSmSecretVersionPayloadSchema() {
+		versionSchema[key] = payloadSchema
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretVersionRead,
+		Schema:      versionSchema,
+	}
+}
+
+func dataSourceIbmSmSecretVersionMetadataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"secret_type": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The secret type.",
+		},
+		"secret_name": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The human-readable name of the secret.",
+		},
+		"secret_group_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "A v4 UUID identifier, or `default` secret group.",
+		},
+		"alias": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "A human-readable alias that describes the secret version, `current` or `previous`.",
+		},
+		"created_by": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier that is associated with the entity that created the secret version.",
+		},
+		"created_at": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date when the secret version was created. The date format follows RFC 3339.",
+		},
+		"auto_rotated": &schema.Schema{
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether the version of the secret was created by automatic rotation.",
+		},
+		"downloaded": &schema.Schema{
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether the secret data that is associated with the secret version was retrieved.",
+		},
+		"payload_available": &schema.Schema{
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether the payload of the secret version is available.",
+		},
+		"expiration_date": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date that the secret version expires. The date format follows RFC 3339.",
+		},
+		"serial_number": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique serial number of a certificate version.",
+		},
+		"validity": &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The date and time that a certificate version is valid.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"not_before": &schema.Schema{
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The date-time format follows RFC 3339.",
+					},
+					"not_after": &schema.Schema{
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The date-time format follows RFC 3339.",
+					},
+				},
+			},
+		},
+		"api_key_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the API key of an IAM credentials secret version.",
+		},
+		"service_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The service ID of an IAM credentials secret version.",
+		},
+		"version_custom_metadata": &schema.Schema{
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "The secret version metadata that a user can customize.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretVersionPayloadSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"payload": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The payload of an arbitrary secret version.",
+		},
+		"data_json": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The payload data of a key-value secret version as a JSON object.",
+		},
+		"username": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The username of a user credentials secret version.",
+		},
+		"password": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The password of a user credentials secret version.",
+		},
+		"api_key": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The API key of an IAM credentials secret version.",
+		},
+		"certificate": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The PEM-encoded contents of a certificate version.",
+		},
+		"intermediate": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The PEM-encoded intermediate certificate of an imported or public certificate version.",
+		},
+		"private_key": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The PEM-encoded private key of a certificate version.",
+		},
+		"issuing_ca": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The PEM-encoded certificate of the certificate authority that issued a private certificate version.",
+		},
+		"ca_chain": &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The chain of certificate authorities of a private certificate version.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretVersionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	versionId := d.Get("version_id").(string)
+
+	var versionIntf interface{}
+	if d.Get("include_payload").(bool) {
+		getSecretVersionOptions := &secretsmanagerv2.GetSecretVersionOptions{}
+
+		getSecretVersionOptions.SetSecretID(secretId)
+		getSecretVersionOptions.SetID(versionId)
+
+		secretVersion, response, err := secretsManagerClient.GetSecretVersionWithContext(context, getSecretVersionOptions)
+		if err != nil {
+			return flex.ServiceErrorDiags("GetSecretVersionWithContext", err, response)
+		}
+		versionIntf = secretVersion
+	} else {
+		getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+
+		getSecretVersionMetadataOptions.SetSecretID(secretId)
+		getSecretVersionMetadataOptions.SetID(versionId)
+
+		secretVersionMetadata, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+		if err != nil {
+			return flex.ServiceErrorDiags("GetSecretVersionMetadataWithContext", err, response)
+		}
+		versionIntf = secretVersionMetadata
+	}
+
+	secretVersion, err := dataSourceIbmSmSecretVersionToSecretVersion(versionIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instanceId, secretId, *secretVersion.ID))
+
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("resolved_version_id", secretVersion.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resolved_version_id: %s", err))
+	}
+	for key, value := range dataSourceIbmSmSecretVersionMetadataToMap(secretVersion) {
+		if key == "id" {
+			continue
+		}
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", key, err))
+		}
+	}
+	if d.Get("include_payload").(bool) {
+		payload, err := dataSourceIbmSmSecretVersionPayloadToMap(secretVersion)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for key, value := range payload {
+			if err = d.Set(key, value); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting %s: %s", key, err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// dataSourceIbmSmSecretVersionToSecretVersion converts a secret version or secret version
+// metadata of any secret type to the SecretVersion model that has the fields of all types.
+func dataSourceIbmSmSecretVersionToSecretVersion(versionIntf interface{}) (*secretsmanagerv2.SecretVersion, error) {
+	versionJson, err := json.Marshal(versionIntf)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling secret version: %s", err)
+	}
+	secretVersion := &secretsmanagerv2.SecretVersion{}
+	if err = json.Unmarshal(versionJson, secretVersion); err != nil {
+		return nil, fmt.Errorf("Error unmarshalling secret version: %s", err)
+	}
+	return secretVersion, nil
+}
+
+func dataSourceIbmSmSecretVersionMetadataToMap(model *secretsmanagerv2.SecretVersion) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	if model.ID != nil {
+		modelMap["id"] = *model.ID
+	}
+	if model.SecretType != nil {
+		modelMap["secret_type"] = *model.SecretType
+	}
+	if model.SecretName != nil {
+		modelMap["secret_name"] = *model.SecretName
+	}
+	if model.SecretGroupID != nil {
+		modelMap["secret_group_id"] = *model.SecretGroupID
+	}
+	if model.Alias != nil {
+		modelMap["alias"] = *model.Alias
+	}
+	if model.CreatedBy != nil {
+		modelMap["created_by"] = *model.CreatedBy
+	}
+	if model.CreatedAt != nil {
+		modelMap["created_at"] = model.CreatedAt.String()
+	}
+	if model.AutoRotated != nil {
+		modelMap["auto_rotated"] = *model.AutoRotated
+	}
+	if model.Downloaded != nil {
+		modelMap["downloaded"] = *model.Downloaded
+	}
+	if model.PayloadAvailable != nil {
+		modelMap["payload_available"] = *model.PayloadAvailable
+	}
+	if model.ExpirationDate != nil {
+		modelMap["expiration_date"] = model.ExpirationDate.String()
+	}
+	if model.SerialNumber != nil {
+		modelMap["serial_number"] = *model.SerialNumber
+	}
+	if model.Validity != nil {
+		modelMap["validity"] = []map[string]interface{}{
+			{
+				"not_before": flex.DateTimeToString(model.Validity.NotBefore),
+				"not_after":  flex.DateTimeToString(model.Validity.NotAfter),
+			},
+		}
+	}
+	if model.ApiKeyID != nil {
+		modelMap["api_key_id"] = *model.ApiKeyID
+	}
+	if model.ServiceID != nil {
+		modelMap["service_id"] = *model.ServiceID
+	}
+	if model.VersionCustomMetadata != nil {
+		modelMap["version_custom_metadata"] = flex.Flatten(model.VersionCustomMetadata)
+	}
+	return modelMap
+}
+
+func dataSourceIbmSmSecretVersionPayloadToMap(model *secretsmanagerv2.SecretVersion) (map[string]interface{}, error) {
+	modelMap := map[string]interface{}{
+		"payload":      model.Payload,
+		"username":     model.Username,
+		"password":     model.Password,
+		"api_key":      model.ApiKey,
+		"certificate":  model.Certificate,
+		"intermediate": model.Intermediate,
+		"private_key":  model.PrivateKey,
+		"issuing_ca":   model.IssuingCa,
+		"ca_chain":     model.CaChain,
+	}
+	if model.Data != nil {
+		dataJson, err := json.Marshal(model.Data)
+		if err != nil {
+			return nil, fmt.Errorf("Error marshalling data_json: %s", err)
+		}
+		modelMap["data_json"] = string(dataJson)
+	}
+	return modelMap, nil
+}
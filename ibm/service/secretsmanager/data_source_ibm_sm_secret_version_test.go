@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretVersionDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionDataSourceConfigSecret("first-payload"),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionDataSourceConfigSecret("second-payload") + testAccCheckIbmSmSecretVersionDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version.current", "alias", "current"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version.current", "secret_type", "arbitrary"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version.current", "payload", "second-payload"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secret_version.current", "resolved_version_id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version.previous", "alias", "previous"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version.previous", "payload", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretVersionDataSourceConfigSecret(payload string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-secret-version-ds"
+			payload = "%s"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, payload)
+}
+
+func testAccCheckIbmSmSecretVersionDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_sm_secret_version" "current" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+			include_payload = true
+		}
+
+		data "ibm_sm_secret_version" "previous" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+			version_id = "previous"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+func DataSourceIbmSmSecretVersions() *schema.Resource {
+	versionSchema := dataSourceIbmSmSecretVersionMetadataSchema()
+	versionSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the secret version.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the secret.",
+			},
+			"versions": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The metadata of the versions of the secret.",
+				Elem: &schema.Resource{
+					Schema: versionSchema,
+				},
+			},
+			"total_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of versions of the secret.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretVersionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+
+	listSecretVersionsOptions := &secretsmanagerv2.ListSecretVersionsOptions{}
+
+	listSecretVersionsOptions.SetSecretID(secretId)
+
+	secretVersionMetadataCollection, response, err := secretsManagerClient.ListSecretVersionsWithContext(context, listSecretVersionsOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("ListSecretVersionsWithContext", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	versions := []map[string]interface{}{}
+	for _, versionIntf := range secretVersionMetadataCollection.Versions {
+		secretVersion, err := dataSourceIbmSmSecretVersionToSecretVersion(versionIntf)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		versions = append(versions, dataSourceIbmSmSecretVersionMetadataToMap(secretVersion))
+	}
+
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("versions", versions); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting versions: %s", err))
+	}
+	if err = d.Set("total_count", flex.IntValue(secretVersionMetadataCollection.TotalCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting total_count: %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretVersionsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionsDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "versions.0.alias", "current"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secret_versions.sm_secret_versions", "versions.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretVersionsDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-secret-versions-ds"
+			payload = "secret-credentials"
+		}
+
+		data "ibm_sm_secret_versions" "sm_secret_versions" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// DataSourceIbmSmSecretsByLabels reads the secrets that have all the given labels, keyed by the
+// secret name, so that they can be used with for_each without their IDs.
+func DataSourceIbmSmSecretsByLabels() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretsByLabelsRead,
+
+		Schema: map[string]*schema.Schema{
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The labels that a secret must all have to be selected, such as `env:prod`.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Select only the secrets of this secret group. Use `default` for the default secret group.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Select only the secrets of this secret type.",
+			},
+			"include_payload": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to read the payload of the current version of the selected secrets.",
+			},
+			"secret_ids": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the selected secrets, by secret name.",
+			},
+			"metadata_json": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The metadata of the selected secrets as JSON objects, by secret name.",
+			},
+			"payloads_json": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The payload of the current version of the selected secrets as JSON objects, by secret name. It is only set when include_payload is true.",
+			},
+			"total_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of selected secrets.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretsByLabelsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	labels := flex.ExpandStringList(d.Get("labels").([]interface{}))
+
+	// The search narrows the list down by the first label, it also matches other fields
+	listSecretsOptions := &secretsmanagerv2.ListSecretsOptions{}
+
+	listSecretsOptions.SetSearch(labels[0])
+	if secretGroupId, ok := d.GetOk("secret_group_id"); ok {
+		listSecretsOptions.SetGroups([]string{secretGroupId.(string)})
+	}
+
+	pager, err := secretsManagerClient.NewSecretsPager(listSecretsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allItems, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] SecretsPager.GetAll() failed %s", err)
+		return diag.FromErr(fmt.Errorf("SecretsPager.GetAll() failed %s", err))
+	}
+
+	secretType := d.Get("secret_type").(string)
+	secretIds := make(map[string]string)
+	metadataJson := make(map[string]string)
+	for _, item := range allItems {
+		secretMetadata, err := dataSourceIbmSmSecretsSecretMetadataToMap(item)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if secretType != "" && secretMetadata["secret_type"] != secretType {
+			continue
+		}
+		if !dataSourceIbmSmSecretsByLabelsHasLabels(secretMetadata["labels"], labels) {
+			continue
+		}
+
+		name := secretMetadata["name"].(string)
+		if _, ok := secretIds[name]; ok {
+			return diag.FromErr(fmt.Errorf("[ERROR] More than one secret named %s has the labels %s, set secret_group_id or secret_type to select only one of them", name, strings.Join(labels, ", ")))
+		}
+		secretIds[name] = secretMetadata["id"].(string)
+
+		metadata, err := json.Marshal(secretMetadata)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error marshalling the metadata of secret %s: %s", name, err))
+		}
+		metadataJson[name] = string(metadata)
+	}
+
+	payloadsJson := make(map[string]string)
+	if d.Get("include_payload").(bool) {
+		for name, secretId := range secretIds {
+			payload, err := dataSourceIbmSmSecretsByLabelsGetPayload(context, secretsManagerClient, secretId)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			payloadsJson[name] = payload
+		}
+	}
+
+	sortedLabels := append([]string{}, labels...)
+	sort.Strings(sortedLabels)
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, strings.Join(sortedLabels, ",")))
+
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("secret_ids", secretIds); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_ids: %s", err))
+	}
+	if err = d.Set("metadata_json", metadataJson); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting metadata_json: %s", err))
+	}
+	if err = d.Set("payloads_json", payloadsJson); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting payloads_json: %s", err))
+	}
+	if err = d.Set("total_count", len(secretIds)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting total_count: %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIbmSmSecretsByLabelsHasLabels(secretLabels interface{}, labels []string) bool {
+	secretLabelList, _ := secretLabels.([]string)
+	for _, label := range labels {
+		found := false
+		for _, secretLabel := range secretLabelList {
+			if secretLabel == label {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// dataSourceIbmSmSecretsByLabelsGetPayload returns the payload of the current version of a secret
+// as a JSON object with the payload fields of its secret type.
+func dataSourceIbmSmSecretsByLabelsGetPayload(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string) (string, error) {
+	getSecretVersionOptions := &secretsmanagerv2.GetSecretVersionOptions{}
+
+	getSecretVersionOptions.SetSecretID(secretId)
+	getSecretVersionOptions.SetID("current")
+
+	secretVersionIntf, response, err := secretsManagerClient.GetSecretVersionWithContext(context, getSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionWithContext failed %s\n%s", err, response)
+		return "", fmt.Errorf("GetSecretVersionWithContext failed %s\n%s", err, response)
+	}
+
+	secretVersion, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionIntf)
+	if err != nil {
+		return "", err
+	}
+	payloadMap, err := dataSourceIbmSmSecretVersionPayloadToMap(secretVersion)
+	if err != nil {
+		return "", err
+	}
+
+	payload := make(map[string]interface{})
+	for key, value := range payloadMap {
+		switch v := value.(type) {
+		case *string:
+			if v != nil {
+				payload[key] = *v
+			}
+		case []string:
+			if v != nil {
+				payload[key] = v
+			}
+		case string:
+			// data_json is already encoded, keep the data as a nested object
+			payload["data"] = json.RawMessage(v)
+		}
+	}
+
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("Error marshalling the payload of secret %s: %s", secretId, err)
+	}
+	return string(payloadJson), nil
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretsByLabelsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretsByLabelsDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_sm_secrets_by_labels.sm_secrets_by_labels", "total_count", "2"),
+					resource.TestCheckResourceAttrPair("data.ibm_sm_secrets_by_labels.sm_secrets_by_labels", "secret_ids.terraform-test-by-labels-1", "ibm_sm_arbitrary_secret.sm_arbitrary_secret_1", "secret_id"),
+					resource.TestCheckResourceAttrPair("data.ibm_sm_secrets_by_labels.sm_secrets_by_labels", "secret_ids.terraform-test-by-labels-2", "ibm_sm_arbitrary_secret.sm_arbitrary_secret_2", "secret_id"),
+					resource.TestCheckNoResourceAttr("data.ibm_sm_secrets_by_labels.sm_secrets_by_labels", "secret_ids.terraform-test-by-labels-3"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secrets_by_labels.sm_secrets_by_labels", "payloads_json.terraform-test-by-labels-1", `{"payload":"payload-1"}`),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secrets_by_labels.sm_secrets_by_labels", "metadata_json.terraform-test-by-labels-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretsByLabelsDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_1" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-by-labels-1"
+			labels = ["env:tftest", "team:a"]
+			payload = "payload-1"
+		}
+
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_2" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-by-labels-2"
+			labels = ["team:b", "env:tftest"]
+			payload = "payload-2"
+		}
+
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_3" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-by-labels-3"
+			labels = ["env:tftest-other"]
+			payload = "payload-3"
+		}
+
+		data "ibm_sm_secrets_by_labels" "sm_secrets_by_labels" {
+			instance_id   = "%s"
+			region        = "%s"
+			labels = ["env:tftest"]
+			include_payload = true
+			depends_on = [
+				ibm_sm_arbitrary_secret.sm_arbitrary_secret_1,
+				ibm_sm_arbitrary_secret.sm_arbitrary_secret_2,
+				ibm_sm_arbitrary_secret.sm_arbitrary_secret_3
+			]
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
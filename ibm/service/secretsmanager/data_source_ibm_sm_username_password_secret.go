@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -146,6 +145,11 @@ func DataSourceIbmSmUsernamePasswordSecret() *schema.Resource {
 				Computed:    true,
 				Description: "The date that the secret is scheduled for automatic rotation.The service automatically creates a new version of the secret on its next rotation date. This field exists only for secrets that have an existing rotation policy.",
 			},
+			"days_until_expiry": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of whole days until the secret expires, as of the last refresh. This field exists only for secrets that have an expiration date.",
+			},
 			"username": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -178,8 +182,7 @@ func dataSourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schem
 
 	usernamePasswordSecretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 	usernamePasswordSecret := usernamePasswordSecretIntf.(*secretsmanagerv2.UsernamePasswordSecret)
 
@@ -275,6 +278,10 @@ func dataSourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schem
 		return diag.FromErr(fmt.Errorf("Error setting next_rotation_date: %s", err))
 	}
 
+	if err = d.Set("days_until_expiry", daysUntilExpiry(usernamePasswordSecret.ExpirationDate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting days_until_expiry: %s", err))
+	}
+
 	if err = d.Set("username", usernamePasswordSecret.Username); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting username: %s", err))
 	}
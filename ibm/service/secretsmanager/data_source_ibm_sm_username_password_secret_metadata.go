@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -146,6 +145,11 @@ func DataSourceIbmSmUsernamePasswordSecretMetadata() *schema.Resource {
 				Computed:    true,
 				Description: "The date that the secret is scheduled for automatic rotation.The service automatically creates a new version of the secret on its next rotation date. This field exists only for secrets that have an existing rotation policy.",
 			},
+			"days_until_expiry": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of whole days until the secret expires, as of the last refresh. This field exists only for secrets that have an expiration date.",
+			},
 		},
 	}
 }
@@ -167,8 +171,7 @@ func dataSourceIbmSmUsernamePasswordSecretMetadataRead(context context.Context,
 
 	usernamePasswordSecretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretMetadataWithContext", err, response)
 	}
 	usernamePasswordSecretMetadata := usernamePasswordSecretMetadataIntf.(*secretsmanagerv2.UsernamePasswordSecretMetadata)
 
@@ -264,6 +267,10 @@ func dataSourceIbmSmUsernamePasswordSecretMetadataRead(context context.Context,
 		return diag.FromErr(fmt.Errorf("Error setting next_rotation_date: %s", err))
 	}
 
+	if err = d.Set("days_until_expiry", daysUntilExpiry(usernamePasswordSecretMetadata.ExpirationDate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting days_until_expiry: %s", err))
+	}
+
 	return nil
 }
 
@@ -6,16 +6,14 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/go-openapi/strfmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/pkg/errors"
-	"log"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -24,12 +22,14 @@ import (
 )
 
 func ResourceIbmSmArbitrarySecret() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmArbitrarySecretCreate,
 		ReadContext:   resourceIbmSmArbitrarySecretRead,
 		UpdateContext: resourceIbmSmArbitrarySecretUpdate,
 		DeleteContext: resourceIbmSmArbitrarySecretDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importSecretByCrn,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -45,9 +45,8 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 			"payload": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Sensitive:   true,
-				Description: "The arbitrary secret data payload.",
+				Description: "The arbitrary secret data payload. Changing the payload creates a new version of the secret.",
 			},
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
@@ -55,21 +54,33 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Description: "The secret metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret metadata that a user can customize, as a JSON object. Use it instead of custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"description": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "An extended description of your secret.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
 			},
 			"expiration_date": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The date a secret is expired. The date format follows RFC 3339.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentDateTime,
+				Description:      "The date a secret is expired. The date format follows RFC 3339.",
 			},
 			"labels": &schema.Schema{
 				Type:        schema.TypeList,
 				Optional:    true,
+				MaxItems:    30,
 				Description: "Labels that you can use to search for secrets in your instance.Up to 30 labels can be created.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringLenBetween(2, 30)},
 			},
 
 			"secret_group_id": &schema.Schema{
@@ -86,6 +97,14 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Description: "The secret version metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"version_custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"version_custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret version metadata that a user can customize, as a JSON object. Use it instead of version_custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"created_by": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -136,8 +155,20 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Computed:    true,
 				Description: "The number of versions of the secret.",
 			},
+			"force_delete": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the locks from all the versions of the secret before the secret is deleted. A secret with locks can not be deleted.",
+			},
 		},
-	}
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}, "secret_id")
 }
 
 func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -160,15 +191,14 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.ArbitrarySecret)
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmArbitrarySecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmArbitrarySecret (%s) to be created: %s", d.Id(), err))
@@ -177,39 +207,6 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 	return resourceIbmSmArbitrarySecretRead(context, d, meta)
 }
 
-func waitForIbmSmArbitrarySecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
-	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
-	id := strings.Split(d.Id(), "/")
-	secretId := id[2]
-
-	getSecretOptions.SetID(secretId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pre_activation"},
-		Target:  []string{"active"},
-		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
-			stateObj := stateObjIntf.(*secretsmanagerv2.ArbitrarySecret)
-			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
-				}
-				return nil, "", err
-			}
-			failStates := map[string]bool{"destroyed": true}
-			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
-			}
-			return stateObj, *stateObj.StateDescription, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      0 * time.Second,
-		MinTimeout: 5 * time.Second,
-	}
-
-	return stateConf.WaitForState()
-}
-
 func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
@@ -228,12 +225,11 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 
 	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.ArbitrarySecret)
@@ -247,6 +243,9 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 	if err = d.Set("region", region); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
 	}
+	if err = d.Set("force_delete", d.Get("force_delete").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting force_delete: %s", err))
+	}
 	if err = d.Set("created_by", secret.CreatedBy); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
 	}
@@ -283,8 +282,8 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 	if err = d.Set("versions_total", flex.IntValue(secret.VersionsTotal)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting versions_total: %s", err))
 	}
-	if secret.CustomMetadata != nil {
-		d.Set("custom_metadata", secret.CustomMetadata)
+	if err = setSecretCustomMetadata(d, "custom_metadata", secret.CustomMetadata); err != nil {
+		return diag.FromErr(err)
 	}
 	if err = d.Set("description", secret.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
@@ -341,8 +340,11 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 		patchVals.Labels = labelsParsed
 		hasChange = true
 	}
-	if d.HasChange("custom_metadata") {
-		patchVals.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	if d.HasChanges("custom_metadata", "custom_metadata_json") {
+		patchVals.CustomMetadata, err = getSecretCustomMetadataPatch(d, "custom_metadata")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		hasChange = true
 	}
 
@@ -350,8 +352,33 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 		updateSecretMetadataOptions.SecretMetadataPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSecretMetadataWithContext", err, response)
+		}
+	}
+
+	// A new payload is stored as a new version of the secret
+	if d.HasChange("payload") {
+		versionModel := &secretsmanagerv2.ArbitrarySecretVersionPrototype{
+			Payload: core.StringPtr(d.Get("payload").(string)),
+		}
+		versionModel.VersionCustomMetadata, err = getSecretCustomMetadata(d, "version_custom_metadata")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+
+		createSecretVersionOptions.SetSecretID(secretId)
+		createSecretVersionOptions.SetSecretVersionPrototype(versionModel)
+
+		_, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+		if err != nil {
+			return flex.ServiceErrorDiags("CreateSecretVersionWithContext", err, response)
+		}
+	} else if d.HasChanges("version_custom_metadata", "version_custom_metadata_json") {
+		err = updateSecretVersionCustomMetadata(context, secretsManagerClient, secretId, d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -370,14 +397,19 @@ func resourceIbmSmArbitrarySecretDelete(context context.Context, d *schema.Resou
 	secretId := id[2]
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
+	if d.Get("force_delete").(bool) {
+		if err = deleteSecretLocks(context, secretsManagerClient, secretId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
 	deleteSecretOptions.SetID(secretId)
 
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSecretWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -395,9 +427,11 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	if _, ok := d.GetOk("payload"); ok {
 		model.Payload = core.StringPtr(d.Get("payload").(string))
 	}
-	if _, ok := d.GetOk("custom_metadata"); ok {
-		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	customMetadata, err := getSecretCustomMetadata(d, "custom_metadata")
+	if err != nil {
+		return nil, err
 	}
+	model.CustomMetadata = customMetadata
 	if _, ok := d.GetOk("description"); ok {
 		model.Description = core.StringPtr(d.Get("description").(string))
 	}
@@ -421,8 +455,10 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	if _, ok := d.GetOk("secret_group_id"); ok {
 		model.SecretGroupID = core.StringPtr(d.Get("secret_group_id").(string))
 	}
-	if _, ok := d.GetOk("version_custom_metadata"); ok {
-		model.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	versionCustomMetadata, err := getSecretCustomMetadata(d, "version_custom_metadata")
+	if err != nil {
+		return nil, err
 	}
+	model.VersionCustomMetadata = versionCustomMetadata
 	return model, nil
 }
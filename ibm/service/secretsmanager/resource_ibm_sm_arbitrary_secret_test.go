@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
@@ -26,21 +27,55 @@ func TestAccIbmSmArbitrarySecretBasic(t *testing.T) {
 		CheckDestroy: testAccCheckIbmSmArbitrarySecretDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccCheckIbmSmArbitrarySecretConfigBasic(),
+				Config: testAccCheckIbmSmArbitrarySecretConfigBasic("secret-credentials"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIbmSmArbitrarySecretExists("ibm_sm_arbitrary_secret.sm_arbitrary_secret", conf),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "versions_total", "1"),
 				),
 			},
+			// Changing the payload creates a new version of the same secret
+			resource.TestStep{
+				Config: testAccCheckIbmSmArbitrarySecretConfigBasic("secret-credentials-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmArbitrarySecretExists("ibm_sm_arbitrary_secret.sm_arbitrary_secret", conf),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "payload", "secret-credentials-updated"),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "versions_total", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_sm_arbitrary_secret.sm_arbitrary_secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			resource.TestStep{
 				ResourceName:      "ibm_sm_arbitrary_secret.sm_arbitrary_secret",
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIbmSmArbitrarySecretCrn("ibm_sm_arbitrary_secret.sm_arbitrary_secret"),
 			},
 		},
 	})
 }
 
-func testAccCheckIbmSmArbitrarySecretConfigBasic() string {
+func TestAccIbmSmArbitrarySecretForceDelete(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmArbitrarySecretConfigForceDelete(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "force_delete", "true"),
+					// The lock is created outside of Terraform, the destroy has to remove it
+					testAccCheckIbmSmArbitrarySecretLock("ibm_sm_arbitrary_secret.sm_arbitrary_secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmArbitrarySecretConfigBasic(payload string) string {
 	return fmt.Sprintf(`
 		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
 			name = "terraform-test-arbitrary-secret-resource"
@@ -49,12 +84,62 @@ func testAccCheckIbmSmArbitrarySecretConfigBasic() string {
   			custom_metadata = {"key":"value"}
   			description = "Extended description for this secret."
   			labels = ["my-label"]
-  			payload = "secret-credentials"
+  			payload = "%s"
+  			expiration_date = "2030-04-12T23:20:50.000Z"
   			secret_group_id = "default"
 		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, payload)
+}
+
+func testAccCheckIbmSmArbitrarySecretConfigForceDelete() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			name = "terraform-test-arbitrary-secret-force-delete"
+			instance_id   = "%s"
+			region        = "%s"
+			payload = "secret-credentials"
+			force_delete = true
+		}
 	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
 }
 
+func testAccCheckIbmSmArbitrarySecretLock(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		secretsManagerClient = getClientWithInstanceEndpointTest(secretsManagerClient)
+
+		createSecretVersionLocksBulkOptions := &secretsmanagerv2.CreateSecretVersionLocksBulkOptions{}
+
+		createSecretVersionLocksBulkOptions.SetSecretID(rs.Primary.Attributes["secret_id"])
+		createSecretVersionLocksBulkOptions.SetID("current")
+		createSecretVersionLocksBulkOptions.SetLocks([]secretsmanagerv2.SecretLockPrototype{
+			{Name: core.StringPtr("terraform-test-force-delete")},
+		})
+
+		_, _, err = secretsManagerClient.CreateSecretVersionLocksBulk(createSecretVersionLocksBulkOptions)
+		return err
+	}
+}
+
+func testAccIbmSmArbitrarySecretCrn(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return rs.Primary.Attributes["crn"], nil
+	}
+}
+
 func testAccCheckIbmSmArbitrarySecretExists(n string, obj secretsmanagerv2.ArbitrarySecret) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
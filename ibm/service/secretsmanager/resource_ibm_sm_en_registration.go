@@ -6,19 +6,19 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
 func ResourceIbmSmEnRegistration() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmEnRegistrationCreate,
 		ReadContext:   resourceIbmSmEnRegistrationRead,
 		UpdateContext: resourceIbmSmEnRegistrationUpdate,
@@ -45,7 +45,7 @@ func ResourceIbmSmEnRegistration() *schema.Resource {
 				Description:  "An optional description for the source  that is in your Event Notifications instance.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIbmSmEnRegistrationValidator() *validate.ResourceValidator {
@@ -104,8 +104,7 @@ func resourceIbmSmEnRegistrationCreate(context context.Context, d *schema.Resour
 
 	_, response, err := secretsManagerClient.CreateNotificationsRegistrationWithContext(context, createNotificationsRegistrationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateNotificationsRegistrationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateNotificationsRegistrationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateNotificationsRegistrationWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", region, instanceId))
@@ -128,12 +127,11 @@ func resourceIbmSmEnRegistrationRead(context context.Context, d *schema.Resource
 
 	notificationsRegistration, response, err := secretsManagerClient.GetNotificationsRegistrationWithContext(context, getNotificationsRegistrationOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetNotificationsRegistrationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetNotificationsRegistrationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetNotificationsRegistrationWithContext", err, response)
 	}
 
 	if err = d.Set("instance_id", instanceId); err != nil {
@@ -162,23 +160,17 @@ func resourceIbmSmEnRegistrationUpdate(context context.Context, d *schema.Resour
 
 	createNotificationsRegistrationOptions := &secretsmanagerv2.CreateNotificationsRegistrationOptions{}
 
-	hasChange := false
-
-	if d.HasChange("event_notifications_instance_crn") || d.HasChange("event_notifications_source_name") {
+	// The registration is replaced as a whole, so the CRN and the source name are always sent
+	if d.HasChange("event_notifications_instance_crn") || d.HasChange("event_notifications_source_name") || d.HasChange("event_notifications_source_description") {
 		createNotificationsRegistrationOptions.SetEventNotificationsInstanceCrn(d.Get("event_notifications_instance_crn").(string))
 		createNotificationsRegistrationOptions.SetEventNotificationsSourceName(d.Get("event_notifications_source_name").(string))
-		hasChange = true
-	}
-	if d.HasChange("event_notifications_source_description") {
-		createNotificationsRegistrationOptions.SetEventNotificationsSourceDescription(d.Get("event_notifications_source_description").(string))
-		hasChange = true
-	}
+		if _, ok := d.GetOk("event_notifications_source_description"); ok {
+			createNotificationsRegistrationOptions.SetEventNotificationsSourceDescription(d.Get("event_notifications_source_description").(string))
+		}
 
-	if hasChange {
 		_, response, err := secretsManagerClient.CreateNotificationsRegistrationWithContext(context, createNotificationsRegistrationOptions)
 		if err != nil {
-			log.Printf("[DEBUG] CreateNotificationsRegistrationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("CreateNotificationsRegistrationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("CreateNotificationsRegistrationWithContext", err, response)
 		}
 	}
 
@@ -199,9 +191,8 @@ func resourceIbmSmEnRegistrationDelete(context context.Context, d *schema.Resour
 	deleteNotificationsRegistrationOptions := &secretsmanagerv2.DeleteNotificationsRegistrationOptions{}
 
 	response, err := secretsManagerClient.DeleteNotificationsRegistrationWithContext(context, deleteNotificationsRegistrationOptions)
-	if err != nil {
-		log.Printf("[DEBUG] DeleteNotificationsRegistrationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteNotificationsRegistrationWithContext failed %s\n%s", err, response))
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return flex.ServiceErrorDiags("DeleteNotificationsRegistrationWithContext", err, response)
 	}
 
 	d.SetId("")
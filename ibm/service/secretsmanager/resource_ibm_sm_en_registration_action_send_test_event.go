@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// ResourceIbmSmEnRegistrationActionSendTestEvent sends a test event to the Event Notifications
+// instance that the Secrets Manager instance is registered with.
+func ResourceIbmSmEnRegistrationActionSendTestEvent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmEnRegistrationActionSendTestEventCreate,
+		ReadContext:   resourceIbmSmEnRegistrationActionSendTestEventRead,
+		DeleteContext: resourceIbmSmEnRegistrationActionSendTestEventDelete,
+
+		Schema: map[string]*schema.Schema{
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, sends the test event again.",
+			},
+			"status_code": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The HTTP status code that Secrets Manager returned for the test event.",
+			},
+			"sent_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the test event was sent. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmEnRegistrationActionSendTestEventCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	getNotificationsRegistrationTestOptions := &secretsmanagerv2.GetNotificationsRegistrationTestOptions{}
+
+	response, err := secretsManagerClient.GetNotificationsRegistrationTestWithContext(context, getNotificationsRegistrationTestOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("GetNotificationsRegistrationTestWithContext", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", region, instanceId))
+
+	if err = d.Set("status_code", response.StatusCode); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status_code: %s", err))
+	}
+	if err = d.Set("sent_at", time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting sent_at: %s", err))
+	}
+
+	return resourceIbmSmEnRegistrationActionSendTestEventRead(context, d, meta)
+}
+
+func resourceIbmSmEnRegistrationActionSendTestEventRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+
+	// The test event can not be read back, the result is kept from the create
+	if err := d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err := d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmEnRegistrationActionSendTestEventDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A sent event can not be removed.
+	d.SetId("")
+	return nil
+}
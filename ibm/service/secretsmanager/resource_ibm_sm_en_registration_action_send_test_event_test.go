@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmEnRegistrationActionSendTestEventBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmEnRegistrationActionSendTestEventConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_en_registration_action_send_test_event.sm_en_test_event", "status_code", "204"),
+					resource.TestCheckResourceAttrSet("ibm_sm_en_registration_action_send_test_event.sm_en_test_event", "sent_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmEnRegistrationActionSendTestEventConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_en_registration" "sm_en_registration" {
+			instance_id   = "%s"
+			region        = "%s"
+			event_notifications_instance_crn = "%s"
+			event_notifications_source_name = "My Secrets Manager Terraform Test"
+		}
+
+		resource "ibm_sm_en_registration_action_send_test_event" "sm_en_test_event" {
+			instance_id   = "%s"
+			region        = "%s"
+			triggers = {
+				registration = ibm_sm_en_registration.sm_en_registration.event_notifications_instance_crn
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerENInstanceCrn,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
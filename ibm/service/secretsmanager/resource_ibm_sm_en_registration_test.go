@@ -29,6 +29,13 @@ func TestAccIbmSmEnRegistrationBasic(t *testing.T) {
 					testAccCheckIbmSmEnRegistrationExists("ibm_sm_en_registration.sm_en_registration", conf),
 				),
 			},
+			resource.TestStep{
+				Config: testAccCheckIbmSmEnRegistrationConfigUpdated(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmEnRegistrationExists("ibm_sm_en_registration.sm_en_registration", conf),
+					resource.TestCheckResourceAttr("ibm_sm_en_registration.sm_en_registration", "event_notifications_source_description", "Terraform data source test updated."),
+				),
+			},
 		},
 	})
 }
@@ -47,6 +54,20 @@ func testAccCheckIbmSmEnRegistrationConfigBasic() string {
 	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerENInstanceCrn)
 }
 
+func testAccCheckIbmSmEnRegistrationConfigUpdated() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_en_registration" "sm_en_registration"{
+  			instance_id   = "%s"
+  			region        = "%s"
+  			event_notifications_instance_crn = "%s"
+  			event_notifications_source_description = "Terraform data source test updated."
+  			event_notifications_source_name = "My Secrets Manager Terraform Test"
+}
+
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerENInstanceCrn)
+}
+
 func testAccCheckIbmSmEnRegistrationExists(n string, obj secretsmanagerv2.NotificationsRegistration) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
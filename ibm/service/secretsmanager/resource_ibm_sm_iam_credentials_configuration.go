@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,7 +18,7 @@ import (
 )
 
 func ResourceIbmSmIamCredentialsConfiguration() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmIamCredentialsConfigurationCreate,
 		ReadContext:   resourceIbmSmIamCredentialsConfigurationRead,
 		UpdateContext: resourceIbmSmIamCredentialsConfigurationUpdate,
@@ -42,7 +41,7 @@ func ResourceIbmSmIamCredentialsConfiguration() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
-				Description: "The API key that is generated for this secret.After the secret reaches the end of its lease (see the `ttl` field), the API key is deleted automatically. If you want to continue to use the same API key for future read operations, see the `reuse_api_key` field.",
+				Description: "An IBM Cloud API key that can create and manage service IDs. The API key is write-only, it is not read back from the configuration.",
 			},
 			"secret_type": &schema.Schema{
 				Type:        schema.TypeString,
@@ -65,7 +64,7 @@ func ResourceIbmSmIamCredentialsConfiguration() *schema.Resource {
 				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
 			},
 		},
-	}
+	}, "name")
 }
 
 func resourceIbmSmIamCredentialsConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -88,8 +87,7 @@ func resourceIbmSmIamCredentialsConfigurationCreate(context context.Context, d *
 
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateConfigurationWithContext", err, response)
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.IAMCredentialsConfiguration)
 
@@ -116,12 +114,11 @@ func resourceIbmSmIamCredentialsConfigurationRead(context context.Context, d *sc
 
 	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetConfigurationWithContext", err, response)
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.IAMCredentialsConfiguration)
 
@@ -134,6 +131,9 @@ func resourceIbmSmIamCredentialsConfigurationRead(context context.Context, d *sc
 	if err = d.Set("name", configuration.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
 	}
+	if err = d.Set("config_type", configuration.ConfigType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting config_type: %s", err))
+	}
 	if err = d.Set("secret_type", configuration.SecretType); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
 	}
@@ -146,9 +146,7 @@ func resourceIbmSmIamCredentialsConfigurationRead(context context.Context, d *sc
 	if err = d.Set("updated_at", flex.DateTimeToString(configuration.UpdatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
 	}
-	if err = d.Set("api_key", configuration.ApiKey); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting api_key: %s", err))
-	}
+	// The api_key is write-only, the configured value is kept in the state
 
 	return nil
 }
@@ -183,8 +181,7 @@ func resourceIbmSmIamCredentialsConfigurationUpdate(context context.Context, d *
 		updateConfigurationOptions.ConfigurationPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateConfigurationWithContext", err, response)
 		}
 	}
 
@@ -208,9 +205,8 @@ func resourceIbmSmIamCredentialsConfigurationDelete(context context.Context, d *
 	deleteConfigurationOptions.SetName(configName)
 
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
-	if err != nil {
-		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return flex.ServiceErrorDiags("DeleteConfigurationWithContext", err, response)
 	}
 
 	d.SetId("")
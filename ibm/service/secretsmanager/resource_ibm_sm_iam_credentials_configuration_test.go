@@ -31,9 +31,10 @@ func TestAccIbmSmIamCredentialsConfigurationBasic(t *testing.T) {
 				),
 			},
 			resource.TestStep{
-				ResourceName:      "ibm_sm_iam_credentials_configuration.sm_iam_credentials_configuration",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "ibm_sm_iam_credentials_configuration.sm_iam_credentials_configuration",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
 			},
 		},
 	})
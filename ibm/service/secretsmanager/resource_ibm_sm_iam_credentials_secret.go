@@ -6,14 +6,13 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -22,12 +21,14 @@ import (
 )
 
 func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmIamCredentialsSecretCreate,
 		ReadContext:   resourceIbmSmIamCredentialsSecretRead,
 		UpdateContext: resourceIbmSmIamCredentialsSecretUpdate,
 		DeleteContext: resourceIbmSmIamCredentialsSecretDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importSecretByCrn,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"secret_type": &schema.Schema{
@@ -55,14 +56,16 @@ func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
 			"labels": &schema.Schema{
 				Type:        schema.TypeList,
 				Optional:    true,
+				MaxItems:    30,
 				Description: "Labels that you can use to search for secrets in your instance.Up to 30 labels can be created.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringLenBetween(2, 30)},
 			},
 			"ttl": &schema.Schema{
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: StringIsIntBetween(60, 7776000),
-				Description:  "The time-to-live (TTL) or lease duration to assign to generated credentials.For `iam_credentials` secrets, the TTL defines for how long each generated API key remains valid. The value is an integer that specifies the number of seconds .Minimum duration is 1 minute. Maximum is 90 days.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     StringIsDurationBetween(60, 7776000),
+				DiffSuppressFunc: suppressEquivalentSecretTTL,
+				Description:      "The time-to-live (TTL) or lease duration to assign to generated credentials.For `iam_credentials` secrets, the TTL defines for how long each generated API key remains valid. The value is an integer that specifies the number of seconds, or a duration such as `90m`, `24h` or `90d`.Minimum duration is 1 minute. Maximum is 90 days.",
 			},
 			"access_groups": &schema.Schema{
 				Type:        schema.TypeList,
@@ -99,16 +102,18 @@ func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
 							Description: "Determines whether Secrets Manager rotates your secret automatically.Default is `false`. If `auto_rotate` is set to `true` the service rotates your secret based on the defined interval.",
 						},
 						"interval": &schema.Schema{
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Computed:    true,
-							Description: "The length of the secret rotation time interval.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The length of the secret rotation time interval.",
 						},
 						"unit": &schema.Schema{
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "The units for the secret rotation time interval.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"day", "month"}, false),
+							Description:  "The units for the secret rotation time interval.",
 						},
 						"rotate_keys": &schema.Schema{
 							Type:        schema.TypeBool,
@@ -125,13 +130,28 @@ func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
 				Description: "The secret metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret metadata that a user can customize, as a JSON object. Use it instead of custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"version_custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "The secret version metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"version_custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"version_custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret version metadata that a user can customize, as a JSON object. Use it instead of version_custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"created_by": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -203,8 +223,20 @@ func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
 				Sensitive:   true,
 				Description: "The API key that is generated for this secret.After the secret reaches the end of its lease (see the `ttl` field), the API key is deleted automatically. If you want to continue to use the same API key for future read operations, see the `reuse_api_key` field.",
 			},
+			"force_delete": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the locks from all the versions of the secret before the secret is deleted. A secret with locks can not be deleted.",
+			},
 		},
-	}
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}, "secret_id")
 }
 
 func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -227,15 +259,14 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.IAMCredentialsSecret)
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmIamCredentialsSecret (%s) to be created: %s", d.Id(), err))
@@ -244,40 +275,6 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 	return resourceIbmSmIamCredentialsSecretRead(context, d, meta)
 }
 
-func waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
-	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
-
-	id := strings.Split(d.Id(), "/")
-	secretId := id[2]
-
-	getSecretOptions.SetID(secretId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pre_activation"},
-		Target:  []string{"active"},
-		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
-			stateObj := stateObjIntf.(*secretsmanagerv2.IAMCredentialsSecret)
-			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
-				}
-				return nil, "", err
-			}
-			failStates := map[string]bool{"destroyed": true}
-			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
-			}
-			return stateObj, *stateObj.StateDescription, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      0 * time.Second,
-		MinTimeout: 5 * time.Second,
-	}
-
-	return stateConf.WaitForState()
-}
-
 func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
@@ -296,12 +293,11 @@ func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.Re
 
 	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.IAMCredentialsSecret)
@@ -315,6 +311,9 @@ func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.Re
 	if err = d.Set("region", region); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
 	}
+	if err = d.Set("force_delete", d.Get("force_delete").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting force_delete: %s", err))
+	}
 	if err = d.Set("created_by", secret.CreatedBy); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
 	}
@@ -324,8 +323,8 @@ func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.Re
 	if err = d.Set("crn", secret.Crn); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
 	}
-	if secret.CustomMetadata != nil {
-		d.Set("custom_metadata", secret.CustomMetadata)
+	if err = setSecretCustomMetadata(d, "custom_metadata", secret.CustomMetadata); err != nil {
+		return diag.FromErr(err)
 	}
 	if err = d.Set("description", secret.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
@@ -438,12 +437,15 @@ func resourceIbmSmIamCredentialsSecretUpdate(context context.Context, d *schema.
 		patchVals.Labels = labelsParsed
 		hasChange = true
 	}
-	if d.HasChange("custom_metadata") {
-		patchVals.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	if d.HasChanges("custom_metadata", "custom_metadata_json") {
+		patchVals.CustomMetadata, err = getSecretCustomMetadataPatch(d, "custom_metadata")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		hasChange = true
 	}
 	if d.HasChange("ttl") {
-		patchVals.TTL = core.StringPtr(d.Get("ttl").(string))
+		patchVals.TTL = core.StringPtr(normalizeSecretTTL(d.Get("ttl").(string)))
 		hasChange = true
 	}
 	if d.HasChange("rotation") {
@@ -460,8 +462,14 @@ func resourceIbmSmIamCredentialsSecretUpdate(context context.Context, d *schema.
 		updateSecretMetadataOptions.SecretMetadataPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSecretMetadataWithContext", err, response)
+		}
+	}
+
+	if d.HasChanges("version_custom_metadata", "version_custom_metadata_json") {
+		err = updateSecretVersionCustomMetadata(context, secretsManagerClient, secretId, d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -480,14 +488,19 @@ func resourceIbmSmIamCredentialsSecretDelete(context context.Context, d *schema.
 	secretId := id[2]
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
+	if d.Get("force_delete").(bool) {
+		if err = deleteSecretLocks(context, secretsManagerClient, secretId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
 	deleteSecretOptions.SetID(secretId)
 
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSecretWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -517,7 +530,7 @@ func resourceIbmSmIamCredentialsSecretMapToSecretPrototype(d *schema.ResourceDat
 		model.Labels = labelsParsed
 	}
 	if _, ok := d.GetOk("ttl"); ok {
-		model.TTL = core.StringPtr(d.Get("ttl").(string))
+		model.TTL = core.StringPtr(normalizeSecretTTL(d.Get("ttl").(string)))
 	}
 	if _, ok := d.GetOk("access_groups"); ok {
 		accessGroups := d.Get("access_groups").([]interface{})
@@ -538,12 +551,16 @@ func resourceIbmSmIamCredentialsSecretMapToSecretPrototype(d *schema.ResourceDat
 		}
 		model.Rotation = RotationModel
 	}
-	if _, ok := d.GetOk("custom_metadata"); ok {
-		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	customMetadata, err := getSecretCustomMetadata(d, "custom_metadata")
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := d.GetOk("version_custom_metadata"); ok {
-		model.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	model.CustomMetadata = customMetadata
+	versionCustomMetadata, err := getSecretCustomMetadata(d, "version_custom_metadata")
+	if err != nil {
+		return nil, err
 	}
+	model.VersionCustomMetadata = versionCustomMetadata
 	return model, nil
 }
 
@@ -102,6 +102,35 @@ func TestAccIbmSmIamCredentialsSecretBasic(t *testing.T) {
 	}
 }
 
+func TestAccIbmSmIamCredentialsSecretDurationTtl(t *testing.T) {
+	var conf secretsmanagerv2.IAMCredentialsSecret
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmIamCredentialsSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmIamCredentialsSecretConfigDurationTtl("30m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmIamCredentialsSecretExists("ibm_sm_iam_credentials_secret.sm_iam_credentials_secret", conf),
+					resource.TestCheckResourceAttr("ibm_sm_iam_credentials_secret.sm_iam_credentials_secret", "ttl", "1800"),
+				),
+			},
+			resource.TestStep{
+				Config:   testAccCheckIbmSmIamCredentialsSecretConfigDurationTtl("1800"),
+				PlanOnly: true,
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSmIamCredentialsSecretConfigDurationTtl("1d"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_iam_credentials_secret.sm_iam_credentials_secret", "ttl", "86400"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSmIamCredentialsSecretConfigBasic_withApikey_serviceid() string {
 	return fmt.Sprintf(`
 		resource "ibm_sm_iam_credentials_configuration" "sm_iam_credentials_configuration_instance" {
@@ -189,6 +218,20 @@ func testAccCheckIbmSmIamCredentialsSecretConfigBasic_noApikey_accessGroup() str
 	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerIamCredentialsSecretServiceAccessGroup)
 }
 
+func testAccCheckIbmSmIamCredentialsSecretConfigDurationTtl(ttl string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_iam_credentials_secret" "sm_iam_credentials_secret" {
+			instance_id   = "%s"
+			region        = "%s"
+  			service_id = "%s"
+  			ttl = "%s"
+			name = "iam-credentials-test-terraform"
+			reuse_api_key = true
+		}
+
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerIamCredentialsSecretServiceId, ttl)
+}
+
 func testAccCheckIbmSmIamCredentialsSecretExists(n string, obj secretsmanagerv2.IAMCredentialsSecret) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
@@ -6,11 +6,9 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 	"time"
 
@@ -21,12 +19,14 @@ import (
 )
 
 func ResourceIbmSmImportedCertificate() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmImportedCertificateCreate,
 		ReadContext:   resourceIbmSmImportedCertificateRead,
 		UpdateContext: resourceIbmSmImportedCertificateUpdate,
 		DeleteContext: resourceIbmSmImportedCertificateDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importSecretByCrn,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"custom_metadata": &schema.Schema{
@@ -35,6 +35,14 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 				Description: "The secret metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret metadata that a user can customize, as a JSON object. Use it instead of custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"description": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -48,8 +56,9 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 			"labels": &schema.Schema{
 				Type:        schema.TypeList,
 				Optional:    true,
+				MaxItems:    30,
 				Description: "Labels that you can use to search for secrets in your instance.Up to 30 labels can be created.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringLenBetween(2, 30)},
 			},
 			"name": &schema.Schema{
 				Type:        schema.TypeString,
@@ -79,6 +88,14 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 				Description: "The secret version metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"version_custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"version_custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret version metadata that a user can customize, as a JSON object. Use it instead of version_custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"certificate": &schema.Schema{
 				Type:      schema.TypeString,
 				Required:  true,
@@ -91,6 +108,32 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 				},
 				Description: "The PEM-encoded contents of your certificate.",
 			},
+			"sha1_fingerprint": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-1 fingerprint of the certificate, as colon-separated hexadecimal pairs.",
+			},
+			"sha256_fingerprint": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate, as colon-separated hexadecimal pairs.",
+			},
+			"subject": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the subject of the certificate.",
+			},
+			"subject_alt_names": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Subject Alternative Names that are read from the certificate: DNS names, IP addresses, email addresses and URIs.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"days_until_expiry": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of whole days until the certificate expires, as of the last refresh.",
+			},
 			"intermediate": &schema.Schema{
 				Type:      schema.TypeString,
 				Optional:  true,
@@ -222,8 +265,20 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 					},
 				},
 			},
+			"force_delete": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the locks from all the versions of the secret before the secret is deleted. A secret with locks can not be deleted.",
+			},
 		},
-	}
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}, "secret_id")
 }
 
 func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -246,15 +301,14 @@ func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.R
 
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSecretWithContext", err, response)
 	}
 	secret := secretIntf.(*secretsmanagerv2.ImportedCertificate)
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmImportedCertificateCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmImportedCertificate (%s) to be created: %s", d.Id(), err))
@@ -263,40 +317,6 @@ func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.R
 	return resourceIbmSmImportedCertificateRead(context, d, meta)
 }
 
-func waitForIbmSmImportedCertificateCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
-	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
-
-	id := strings.Split(d.Id(), "/")
-	secretId := id[2]
-
-	getSecretOptions.SetID(secretId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pre_activation"},
-		Target:  []string{"active"},
-		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
-			stateObj := stateObjIntf.(*secretsmanagerv2.ImportedCertificate)
-			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
-				}
-				return nil, "", err
-			}
-			failStates := map[string]bool{"destroyed": true}
-			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
-			}
-			return stateObj, *stateObj.StateDescription, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      0 * time.Second,
-		MinTimeout: 5 * time.Second,
-	}
-
-	return stateConf.WaitForState()
-}
-
 func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
@@ -315,12 +335,11 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 
 	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 	secret := secretIntf.(*secretsmanagerv2.ImportedCertificate)
 
@@ -333,6 +352,9 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 	if err = d.Set("region", region); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
 	}
+	if err = d.Set("force_delete", d.Get("force_delete").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting force_delete: %s", err))
+	}
 	if err = d.Set("created_by", secret.CreatedBy); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
 	}
@@ -342,8 +364,8 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 	if err = d.Set("crn", secret.Crn); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
 	}
-	if secret.CustomMetadata != nil {
-		d.Set("custom_metadata", secret.CustomMetadata)
+	if err = setSecretCustomMetadata(d, "custom_metadata", secret.CustomMetadata); err != nil {
+		return diag.FromErr(err)
 	}
 	if err = d.Set("description", secret.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
@@ -419,6 +441,9 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 	if err = d.Set("certificate", secret.Certificate); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting certificate: %s", err))
 	}
+	if err = setCertificateAttributes(d, secret.Certificate); err != nil {
+		return diag.FromErr(err)
+	}
 	if err = d.Set("intermediate", secret.Intermediate); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting intermediate: %s", err))
 	}
@@ -466,8 +491,11 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 		patchVals.Labels = labelsParsed
 		hasChange = true
 	}
-	if d.HasChange("custom_metadata") {
-		patchVals.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	if d.HasChanges("custom_metadata", "custom_metadata_json") {
+		patchVals.CustomMetadata, err = getSecretCustomMetadataPatch(d, "custom_metadata")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		hasChange = true
 	}
 
@@ -475,8 +503,14 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 		updateSecretMetadataOptions.SecretMetadataPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSecretMetadataWithContext", err, response)
+		}
+	}
+
+	if d.HasChanges("version_custom_metadata", "version_custom_metadata_json") {
+		err = updateSecretVersionCustomMetadata(context, secretsManagerClient, secretId, d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -495,14 +529,19 @@ func resourceIbmSmImportedCertificateDelete(context context.Context, d *schema.R
 	secretId := id[2]
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
+	if d.Get("force_delete").(bool) {
+		if err = deleteSecretLocks(context, secretsManagerClient, secretId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
 	deleteSecretOptions.SetID(secretId)
 
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSecretWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -517,9 +556,11 @@ func resourceIbmSmImportedCertificateMapToSecretPrototype(d *schema.ResourceData
 	if _, ok := d.GetOk("name"); ok {
 		model.Name = core.StringPtr(d.Get("name").(string))
 	}
-	if _, ok := d.GetOk("custom_metadata"); ok {
-		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	customMetadata, err := getSecretCustomMetadata(d, "custom_metadata")
+	if err != nil {
+		return nil, err
 	}
+	model.CustomMetadata = customMetadata
 	if _, ok := d.GetOk("description"); ok {
 		model.Description = core.StringPtr(d.Get("description").(string))
 	}
@@ -534,9 +575,11 @@ func resourceIbmSmImportedCertificateMapToSecretPrototype(d *schema.ResourceData
 	if _, ok := d.GetOk("secret_group_id"); ok {
 		model.SecretGroupID = core.StringPtr(d.Get("secret_group_id").(string))
 	}
-	if _, ok := d.GetOk("version_custom_metadata"); ok {
-		model.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	versionCustomMetadata, err := getSecretCustomMetadata(d, "version_custom_metadata")
+	if err != nil {
+		return nil, err
 	}
+	model.VersionCustomMetadata = versionCustomMetadata
 	if _, ok := d.GetOk("certificate"); ok {
 		model.Certificate = core.StringPtr(formatCertificate(d.Get("certificate").(string)))
 	}
@@ -28,6 +28,10 @@ func TestAccIbmSmImportedCertificateBasic(t *testing.T) {
 				Config: testAccCheckIbmSmImportedCertificateConfigBasic(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIbmSmImportedCertificateExists("ibm_sm_imported_certificate.sm_imported_certificate", conf),
+					resource.TestCheckResourceAttrSet("ibm_sm_imported_certificate.sm_imported_certificate", "sha1_fingerprint"),
+					resource.TestCheckResourceAttrSet("ibm_sm_imported_certificate.sm_imported_certificate", "sha256_fingerprint"),
+					resource.TestCheckResourceAttrSet("ibm_sm_imported_certificate.sm_imported_certificate", "subject"),
+					resource.TestCheckResourceAttrSet("ibm_sm_imported_certificate.sm_imported_certificate", "days_until_expiry"),
 				),
 			},
 			resource.TestStep{
@@ -5,12 +5,11 @@ package secretsmanager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 	"time"
 
@@ -21,12 +20,14 @@ import (
 )
 
 func ResourceIbmSmKvSecret() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmKvSecretCreate,
 		ReadContext:   resourceIbmSmKvSecretRead,
 		UpdateContext: resourceIbmSmKvSecretUpdate,
 		DeleteContext: resourceIbmSmKvSecretDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importSecretByCrn,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"secret_type": &schema.Schema{
@@ -54,16 +55,28 @@ func ResourceIbmSmKvSecret() *schema.Resource {
 			"labels": &schema.Schema{
 				Type:        schema.TypeList,
 				Optional:    true,
+				MaxItems:    30,
 				Description: "Labels that you can use to search for secrets in your instance.Up to 30 labels can be created.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringLenBetween(2, 30)},
 			},
 			"data": &schema.Schema{
-				Type:        schema.TypeMap,
-				Required:    true,
-				ForceNew:    true,
-				Sensitive:   true,
-				Description: "The payload data of a key-value secret.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"data", "data_json"},
+				Description:  "The payload data of a key-value secret.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"data_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				ExactlyOneOf:     []string{"data", "data_json"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The payload data of a key-value secret as a JSON object. Use it instead of data when the values are nested.",
 			},
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
@@ -71,13 +84,28 @@ func ResourceIbmSmKvSecret() *schema.Resource {
 				Description: "The secret metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret metadata that a user can customize, as a JSON object. Use it instead of custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"version_custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "The secret version metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"version_custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"version_custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret version metadata that a user can customize, as a JSON object. Use it instead of version_custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"created_by": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -128,8 +156,20 @@ func ResourceIbmSmKvSecret() *schema.Resource {
 				Computed:    true,
 				Description: "A v4 UUID identifier.",
 			},
+			"force_delete": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the locks from all the versions of the secret before the secret is deleted. A secret with locks can not be deleted.",
+			},
 		},
-	}
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}, "secret_id")
 }
 
 func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -152,15 +192,14 @@ func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData
 
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.KVSecret)
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmKvSecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmKvSecret (%s) to be created: %s", d.Id(), err))
@@ -169,40 +208,6 @@ func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData
 	return resourceIbmSmKvSecretRead(context, d, meta)
 }
 
-func waitForIbmSmKvSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
-	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
-
-	id := strings.Split(d.Id(), "/")
-	secretId := id[2]
-
-	getSecretOptions.SetID(secretId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pre_activation"},
-		Target:  []string{"active"},
-		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
-			stateObj := stateObjIntf.(*secretsmanagerv2.KVSecret)
-			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
-				}
-				return nil, "", err
-			}
-			failStates := map[string]bool{"destroyed": true}
-			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
-			}
-			return stateObj, *stateObj.StateDescription, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      0 * time.Second,
-		MinTimeout: 5 * time.Second,
-	}
-
-	return stateConf.WaitForState()
-}
-
 func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
@@ -221,12 +226,11 @@ func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData,
 
 	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.KVSecret)
@@ -240,6 +244,9 @@ func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData,
 	if err = d.Set("region", region); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
 	}
+	if err = d.Set("force_delete", d.Get("force_delete").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting force_delete: %s", err))
+	}
 	if err = d.Set("created_by", secret.CreatedBy); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
 	}
@@ -276,8 +283,8 @@ func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData,
 	if err = d.Set("versions_total", flex.IntValue(secret.VersionsTotal)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting versions_total: %s", err))
 	}
-	if secret.CustomMetadata != nil {
-		d.Set("custom_metadata", secret.CustomMetadata)
+	if err = setSecretCustomMetadata(d, "custom_metadata", secret.CustomMetadata); err != nil {
+		return diag.FromErr(err)
 	}
 	if err = d.Set("description", secret.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
@@ -288,7 +295,18 @@ func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData,
 		}
 	}
 	if secret.Data != nil {
-		d.Set("data", secret.Data)
+		// Nested data can only be represented as JSON
+		if _, ok := d.GetOk("data_json"); ok || !isStringMap(secret.Data) {
+			dataJson, err := json.Marshal(secret.Data)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("Error marshalling data_json: %s", err))
+			}
+			if err = d.Set("data_json", string(dataJson)); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting data_json: %s", err))
+			}
+		} else {
+			d.Set("data", secret.Data)
+		}
 	}
 
 	return nil
@@ -331,8 +349,11 @@ func resourceIbmSmKvSecretUpdate(context context.Context, d *schema.ResourceData
 		patchVals.Labels = labelsParsed
 		hasChange = true
 	}
-	if d.HasChange("custom_metadata") {
-		patchVals.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	if d.HasChanges("custom_metadata", "custom_metadata_json") {
+		patchVals.CustomMetadata, err = getSecretCustomMetadataPatch(d, "custom_metadata")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		hasChange = true
 	}
 
@@ -340,8 +361,14 @@ func resourceIbmSmKvSecretUpdate(context context.Context, d *schema.ResourceData
 		updateSecretMetadataOptions.SecretMetadataPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSecretMetadataWithContext", err, response)
+		}
+	}
+
+	if d.HasChanges("version_custom_metadata", "version_custom_metadata_json") {
+		err = updateSecretVersionCustomMetadata(context, secretsManagerClient, secretId, d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -360,14 +387,19 @@ func resourceIbmSmKvSecretDelete(context context.Context, d *schema.ResourceData
 	secretId := id[2]
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
+	if d.Get("force_delete").(bool) {
+		if err = deleteSecretLocks(context, secretsManagerClient, secretId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
 	deleteSecretOptions.SetID(secretId)
 
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSecretWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -399,11 +431,22 @@ func resourceIbmSmKvSecretMapToSecretPrototype(d *schema.ResourceData) (*secrets
 	if _, ok := d.GetOk("data"); ok {
 		model.Data = d.Get("data").(map[string]interface{})
 	}
-	if _, ok := d.GetOk("custom_metadata"); ok {
-		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	if _, ok := d.GetOk("data_json"); ok {
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("data_json").(string)), &data); err != nil {
+			return model, fmt.Errorf("Error parsing data_json: %s", err)
+		}
+		model.Data = data
+	}
+	customMetadata, err := getSecretCustomMetadata(d, "custom_metadata")
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := d.GetOk("version_custom_metadata"); ok {
-		model.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	model.CustomMetadata = customMetadata
+	versionCustomMetadata, err := getSecretCustomMetadata(d, "version_custom_metadata")
+	if err != nil {
+		return nil, err
 	}
+	model.VersionCustomMetadata = versionCustomMetadata
 	return model, nil
 }
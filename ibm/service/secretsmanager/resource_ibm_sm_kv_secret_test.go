@@ -39,6 +39,134 @@ func TestAccIbmSmKvSecretBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmKvSecretDataJson(t *testing.T) {
+	var conf secretsmanagerv2.KVSecret
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmKvSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmKvSecretConfigDataJson(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmKvSecretExists("ibm_sm_kv_secret.sm_kv_secret_json", conf),
+					resource.TestCheckResourceAttrSet("ibm_sm_kv_secret.sm_kv_secret_json", "data_json"),
+				),
+			},
+			// Reformatting the JSON does not cause a change
+			resource.TestStep{
+				Config:   testAccCheckIbmSmKvSecretConfigDataJsonReformatted(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccIbmSmKvSecretVersionCustomMetadata(t *testing.T) {
+	var secretId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmKvSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmKvSecretConfigVersionCustomMetadata("value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmKvSecretVersionCustomMetadata("ibm_sm_kv_secret.sm_kv_secret_version_metadata", "value", &secretId),
+				),
+			},
+			// The metadata of the current version is updated without recreating the secret
+			resource.TestStep{
+				Config: testAccCheckIbmSmKvSecretConfigVersionCustomMetadata("updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmKvSecretVersionCustomMetadata("ibm_sm_kv_secret.sm_kv_secret_version_metadata", "updated", &secretId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIbmSmKvSecretCustomMetadataJson(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmKvSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmKvSecretConfigCustomMetadataJson(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_kv_secret.sm_kv_secret_metadata_json", "custom_metadata_json", `{"count":1,"nested":{"enabled":true}}`),
+				),
+			},
+			// The nested metadata is updated in place
+			resource.TestStep{
+				Config: testAccCheckIbmSmKvSecretConfigCustomMetadataJson(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_kv_secret.sm_kv_secret_metadata_json", "custom_metadata_json", `{"count":2,"nested":{"enabled":true}}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmKvSecretConfigCustomMetadataJson(count int) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_kv_secret" "sm_kv_secret_metadata_json" {
+			instance_id   = "%s"
+			region        = "%s"
+			data = {"key":"value"}
+			name = "kv-secret-metadata-json-terraform-test"
+			custom_metadata_json = jsonencode({"count":%d,"nested":{"enabled":true}})
+			version_custom_metadata_json = jsonencode({"count":%d})
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, count, count)
+}
+
+func testAccCheckIbmSmKvSecretConfigVersionCustomMetadata(value string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_kv_secret" "sm_kv_secret_version_metadata" {
+			instance_id   = "%s"
+			region        = "%s"
+			data = {"key":"value"}
+			name = "kv-secret-version-metadata-terraform-test"
+			version_custom_metadata = {"key":"%s"}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, value)
+}
+
+func testAccCheckIbmSmKvSecretConfigDataJson() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_kv_secret" "sm_kv_secret_json" {
+			instance_id   = "%s"
+			region        = "%s"
+			data_json = jsonencode({"key":"value","nested":{"list":[1,2],"enabled":true}})
+			name = "kv-secret-json-terraform-test"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
+
+func testAccCheckIbmSmKvSecretConfigDataJsonReformatted() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_kv_secret" "sm_kv_secret_json" {
+			instance_id   = "%s"
+			region        = "%s"
+			data_json = <<EOF
+{
+  "nested": {"enabled": true, "list": [1, 2]},
+  "key": "value"
+}
+EOF
+			name = "kv-secret-json-terraform-test"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
+
 func testAccCheckIbmSmKvSecretConfigBasic() string {
 	return fmt.Sprintf(`
 
@@ -87,6 +215,44 @@ func testAccCheckIbmSmKvSecretExists(n string, obj secretsmanagerv2.KVSecret) re
 	}
 }
 
+func testAccCheckIbmSmKvSecretVersionCustomMetadata(n string, value string, secretId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		secretsManagerClient = getClientWithInstanceEndpointTest(secretsManagerClient)
+
+		id := strings.Split(rs.Primary.ID, "/")
+		if *secretId == "" {
+			*secretId = id[2]
+		} else if *secretId != id[2] {
+			return fmt.Errorf("The secret was recreated: %s", rs.Primary.ID)
+		}
+
+		getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+		getSecretVersionMetadataOptions.SetSecretID(id[2])
+		getSecretVersionMetadataOptions.SetID("current")
+
+		versionMetadataIntf, _, err := secretsManagerClient.GetSecretVersionMetadata(getSecretVersionMetadataOptions)
+		if err != nil {
+			return err
+		}
+
+		versionMetadata := versionMetadataIntf.(*secretsmanagerv2.KVSecretVersionMetadata)
+		if versionMetadata.VersionCustomMetadata["key"] != value {
+			return fmt.Errorf("Unexpected version_custom_metadata of %s: %v", rs.Primary.ID, versionMetadata.VersionCustomMetadata)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIbmSmKvSecretDestroy(s *terraform.State) error {
 	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
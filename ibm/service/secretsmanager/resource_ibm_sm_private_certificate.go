@@ -6,10 +6,9 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strings"
 	"time"
@@ -21,12 +20,14 @@ import (
 )
 
 func ResourceIbmSmPrivateCertificate() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmPrivateCertificateCreate,
 		ReadContext:   resourceIbmSmPrivateCertificateRead,
 		UpdateContext: resourceIbmSmPrivateCertificateUpdate,
 		DeleteContext: resourceIbmSmPrivateCertificateDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importSecretByCrn,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"secret_type": &schema.Schema{
@@ -54,8 +55,9 @@ func ResourceIbmSmPrivateCertificate() *schema.Resource {
 			"labels": &schema.Schema{
 				Type:        schema.TypeList,
 				Optional:    true,
+				MaxItems:    30,
 				Description: "Labels that you can use to search for secrets in your instance.Up to 30 labels can be created.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringLenBetween(2, 30)},
 			},
 			"certificate_template": &schema.Schema{
 				Type:        schema.TypeString,
@@ -70,12 +72,14 @@ func ResourceIbmSmPrivateCertificate() *schema.Resource {
 				Description: "The Common Name (AKA CN) represents the server name that is protected by the SSL certificate.",
 			},
 			"alt_names": &schema.Schema{
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				ForceNew:    true,
 				Optional:    true,
 				Computed:    true,
+				MaxItems:    99,
 				Description: "With the Subject Alternative Name field, you can specify additional host names to be protected by a single SSL certificate.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCertificateAltName},
+				Set:         schema.HashString,
 			},
 			"ip_sans": &schema.Schema{
 				Type:        schema.TypeString,
@@ -142,16 +146,18 @@ func ResourceIbmSmPrivateCertificate() *schema.Resource {
 							Description: "Determines whether Secrets Manager rotates your secret automatically.Default is `false`. If `auto_rotate` is set to `true` the service rotates your secret based on the defined interval.",
 						},
 						"interval": &schema.Schema{
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Computed:    true,
-							Description: "The length of the secret rotation time interval.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The length of the secret rotation time interval.",
 						},
 						"unit": &schema.Schema{
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "The units for the secret rotation time interval.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"day", "month"}, false),
+							Description:  "The units for the secret rotation time interval.",
 						},
 					},
 				},
@@ -162,13 +168,28 @@ func ResourceIbmSmPrivateCertificate() *schema.Resource {
 				Description: "The secret metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret metadata that a user can customize, as a JSON object. Use it instead of custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"version_custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
-				ForceNew:    true,
 				Optional:    true,
 				Description: "The secret version metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"version_custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"version_custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret version metadata that a user can customize, as a JSON object. Use it instead of version_custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"created_by": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -240,11 +261,12 @@ func ResourceIbmSmPrivateCertificate() *schema.Resource {
 				Description: "The distinguished name that identifies the entity that signed and issued the certificate.",
 			},
 			"key_algorithm": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     "RSA2048",
-				Description: "The identifier for the cryptographic algorithm to be used to generate the public key that is associated with the certificate.The algorithm that you select determines the encryption algorithm (`RSA` or `ECDSA`) and key size to be used to generate keys and sign certificates. For longer living certificates, it is recommended to use longer keys to provide more encryption protection. Allowed values:  RSA2048, RSA4096, EC256, EC384.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "RSA2048",
+				ValidateFunc: validation.StringInSlice([]string{"RSA2048", "RSA4096", "EC256", "EC384"}, false),
+				Description:  "The identifier for the cryptographic algorithm to be used to generate the public key that is associated with the certificate.The algorithm that you select determines the encryption algorithm (`RSA` or `ECDSA`) and key size to be used to generate keys and sign certificates. For longer living certificates, it is recommended to use longer keys to provide more encryption protection. Allowed values:  RSA2048, RSA4096, EC256, EC384.",
 			},
 			"next_rotation_date": &schema.Schema{
 				Type:        schema.TypeString,
@@ -291,6 +313,32 @@ func ResourceIbmSmPrivateCertificate() *schema.Resource {
 				Sensitive:   true,
 				Description: "The PEM-encoded contents of your certificate.",
 			},
+			"sha1_fingerprint": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-1 fingerprint of the certificate, as colon-separated hexadecimal pairs.",
+			},
+			"sha256_fingerprint": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate, as colon-separated hexadecimal pairs.",
+			},
+			"subject": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the subject of the certificate.",
+			},
+			"subject_alt_names": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Subject Alternative Names that are read from the certificate: DNS names, IP addresses, email addresses and URIs.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"days_until_expiry": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of whole days until the certificate expires, as of the last refresh.",
+			},
 			"private_key": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -310,8 +358,20 @@ func ResourceIbmSmPrivateCertificate() *schema.Resource {
 				Description: "The chain of certificate authorities that are associated with the certificate.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"force_delete": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the locks from all the versions of the secret before the secret is deleted. A secret with locks can not be deleted.",
+			},
 		},
-	}
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}, "secret_id")
 }
 
 func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -334,15 +394,14 @@ func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.Re
 
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.PrivateCertificate)
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmPrivateCertificateCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmPrivateCertificate (%s) to be created: %s", d.Id(), err))
@@ -351,40 +410,6 @@ func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.Re
 	return resourceIbmSmPrivateCertificateRead(context, d, meta)
 }
 
-func waitForIbmSmPrivateCertificateCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
-	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
-
-	id := strings.Split(d.Id(), "/")
-	secretId := id[2]
-
-	getSecretOptions.SetID(secretId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pre_activation"},
-		Target:  []string{"active"},
-		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
-			stateObj := stateObjIntf.(*secretsmanagerv2.PrivateCertificate)
-			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
-				}
-				return nil, "", err
-			}
-			failStates := map[string]bool{"destroyed": true}
-			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
-			}
-			return stateObj, *stateObj.StateDescription, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      0 * time.Second,
-		MinTimeout: 5 * time.Second,
-	}
-
-	return stateConf.WaitForState()
-}
-
 func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
@@ -403,12 +428,11 @@ func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.Reso
 
 	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.PrivateCertificate)
@@ -422,6 +446,9 @@ func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.Reso
 	if err = d.Set("region", region); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
 	}
+	if err = d.Set("force_delete", d.Get("force_delete").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting force_delete: %s", err))
+	}
 	if err = d.Set("created_by", secret.CreatedBy); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
 	}
@@ -431,8 +458,8 @@ func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.Reso
 	if err = d.Set("crn", secret.Crn); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
 	}
-	if secret.CustomMetadata != nil {
-		d.Set("custom_metadata", secret.CustomMetadata)
+	if err = setSecretCustomMetadata(d, "custom_metadata", secret.CustomMetadata); err != nil {
+		return diag.FromErr(err)
 	}
 	if err = d.Set("description", secret.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
@@ -528,6 +555,9 @@ func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.Reso
 	if err = d.Set("certificate", secret.Certificate); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting certificate: %s", err))
 	}
+	if err = setCertificateAttributes(d, secret.Certificate); err != nil {
+		return diag.FromErr(err)
+	}
 	if err = d.Set("private_key", secret.PrivateKey); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting private_key: %s", err))
 	}
@@ -579,8 +609,11 @@ func resourceIbmSmPrivateCertificateUpdate(context context.Context, d *schema.Re
 		patchVals.Labels = labelsParsed
 		hasChange = true
 	}
-	if d.HasChange("custom_metadata") {
-		patchVals.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	if d.HasChanges("custom_metadata", "custom_metadata_json") {
+		patchVals.CustomMetadata, err = getSecretCustomMetadataPatch(d, "custom_metadata")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		hasChange = true
 	}
 	if d.HasChange("rotation") {
@@ -597,8 +630,14 @@ func resourceIbmSmPrivateCertificateUpdate(context context.Context, d *schema.Re
 		updateSecretMetadataOptions.SecretMetadataPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSecretMetadataWithContext", err, response)
+		}
+	}
+
+	if d.HasChanges("version_custom_metadata", "version_custom_metadata_json") {
+		err = updateSecretVersionCustomMetadata(context, secretsManagerClient, secretId, d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -617,14 +656,19 @@ func resourceIbmSmPrivateCertificateDelete(context context.Context, d *schema.Re
 	secretId := id[2]
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
+	if d.Get("force_delete").(bool) {
+		if err = deleteSecretLocks(context, secretsManagerClient, secretId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
 	deleteSecretOptions.SetID(secretId)
 
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSecretWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -660,7 +704,7 @@ func resourceIbmSmPrivateCertificateMapToSecretPrototype(d *schema.ResourceData)
 		model.CommonName = core.StringPtr(d.Get("common_name").(string))
 	}
 	if _, ok := d.GetOk("alt_names"); ok {
-		altNames := d.Get("alt_names").([]interface{})
+		altNames := d.Get("alt_names").(*schema.Set).List()
 		altNamesParsed := make([]string, len(altNames))
 		for i, v := range altNames {
 			altNamesParsed[i] = fmt.Sprint(v)
@@ -703,12 +747,16 @@ func resourceIbmSmPrivateCertificateMapToSecretPrototype(d *schema.ResourceData)
 		}
 		model.Rotation = RotationModel
 	}
-	if _, ok := d.GetOk("custom_metadata"); ok {
-		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	customMetadata, err := getSecretCustomMetadata(d, "custom_metadata")
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := d.GetOk("version_custom_metadata"); ok {
-		model.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	model.CustomMetadata = customMetadata
+	versionCustomMetadata, err := getSecretCustomMetadata(d, "version_custom_metadata")
+	if err != nil {
+		return nil, err
 	}
+	model.VersionCustomMetadata = versionCustomMetadata
 
 	return model, nil
 }
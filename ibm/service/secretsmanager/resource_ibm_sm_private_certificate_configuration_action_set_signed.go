@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// ResourceIbmSmPrivateCertificateConfigurationActionSetSigned uploads the certificate of an
+// intermediate CA that is signed by an external root CA, and waits for the CA to be configured.
+func ResourceIbmSmPrivateCertificateConfigurationActionSetSigned() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmPrivateCertificateConfigurationActionSetSignedCreate,
+		ReadContext:   resourceIbmSmPrivateCertificateConfigurationActionSetSignedRead,
+		DeleteContext: resourceIbmSmPrivateCertificateConfigurationActionSetSignedDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the intermediate CA configuration.",
+			},
+			"certificate": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PEM-encoded certificate that is signed by the external root CA.",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the intermediate CA.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceIbmSmPrivateCertificateConfigurationActionSetSignedCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	configName := d.Get("name").(string)
+
+	createConfigurationActionOptions := &secretsmanagerv2.CreateConfigurationActionOptions{}
+
+	createConfigurationActionOptions.SetName(configName)
+	createConfigurationActionOptions.SetConfigActionPrototype(&secretsmanagerv2.PrivateCertificateConfigurationActionSetSignedPrototype{
+		ActionType:  core.StringPtr(secretsmanagerv2.PrivateCertificateConfigurationActionSetSignedPrototype_ActionType_PrivateCertConfigurationActionSetSigned),
+		Certificate: core.StringPtr(d.Get("certificate").(string)),
+	})
+
+	_, response, err := secretsManagerClient.CreateConfigurationActionWithContext(context, createConfigurationActionOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("CreateConfigurationActionWithContext", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, configName))
+
+	_, err = waitForIbmSmPrivateCertificateConfigurationIntermediateCASigned(context, secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for the intermediate CA (%s) to be configured: %s", configName, err))
+	}
+
+	return resourceIbmSmPrivateCertificateConfigurationActionSetSignedRead(context, d, meta)
+}
+
+func waitForIbmSmPrivateCertificateConfigurationIntermediateCASigned(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+	getConfigurationOptions.SetName(d.Get("name").(string))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA_Status_SigningRequired,
+			secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA_Status_SignedCertificateRequired,
+		},
+		// A signed CA can issue certificates once a certificate template is added
+		Target: []string{
+			secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA_Status_Configured,
+			secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA_Status_CertificateTemplateRequired,
+		},
+		Refresh: func() (interface{}, string, error) {
+			configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetConfiguration failed %s\n%s", err, response)
+			}
+			configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA)
+			return configuration, *configuration.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func resourceIbmSmPrivateCertificateConfigurationActionSetSignedRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	configName := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+	getConfigurationOptions.SetName(configName)
+
+	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return flex.ServiceErrorDiags("GetConfigurationWithContext", err, response)
+	}
+
+	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA)
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("name", configName); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("status", configuration.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmPrivateCertificateConfigurationActionSetSignedDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The signed certificate can not be removed from the CA.
+	d.SetId("")
+	return nil
+}
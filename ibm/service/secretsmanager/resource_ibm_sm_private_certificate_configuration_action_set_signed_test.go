@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmPrivateCertificateConfigurationActionSetSignedBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPrivateCertificateConfigurationActionSetSignedConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_private_certificate_configuration_action_set_signed.sm_set_signed", "status", "certificate_template_required"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmPrivateCertificateConfigurationActionSetSignedConfigBasic() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_private_certificate_configuration_root_ca" "ibm_sm_private_certificate_configuration_root_ca_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			max_ttl = "180000"
+			common_name = "ibm.com"
+			crl_expiry = "10000h"
+			name = "root-ca-terraform-private-cert-set-signed-test"
+		}
+		resource "ibm_sm_private_certificate_configuration_intermediate_ca" "sm_private_certificate_configuration_intermediate_ca" {
+			instance_id   = "%s"
+			region        = "%s"
+			max_ttl = "180000"
+			common_name = "ibm.com"
+			signing_method = "external"
+			name = "intermediate-ca-terraform-private-cert-set-signed-test"
+		}
+		resource "ibm_sm_private_certificate_configuration_action_sign_csr" "sm_sign_csr" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = ibm_sm_private_certificate_configuration_root_ca.ibm_sm_private_certificate_configuration_root_ca_instance.name
+			csr = ibm_sm_private_certificate_configuration_intermediate_ca.sm_private_certificate_configuration_intermediate_ca.data[0].csr
+			common_name = "ibm.com"
+		}
+		resource "ibm_sm_private_certificate_configuration_action_set_signed" "sm_set_signed" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = ibm_sm_private_certificate_configuration_intermediate_ca.sm_private_certificate_configuration_intermediate_ca.name
+			certificate = ibm_sm_private_certificate_configuration_action_sign_csr.sm_sign_csr.data[0].certificate
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
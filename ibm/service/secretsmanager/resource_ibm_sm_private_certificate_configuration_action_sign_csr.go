@@ -0,0 +1,366 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// ResourceIbmSmPrivateCertificateConfigurationActionSignCsr signs a certificate signing request
+// with a root or intermediate CA, for example to sign an intermediate CA that is hosted
+// outside of Secrets Manager. Every apply that creates or replaces the resource signs the
+// CSR again.
+func ResourceIbmSmPrivateCertificateConfigurationActionSignCsr() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmPrivateCertificateConfigurationActionSignCsrCreate,
+		ReadContext:   resourceIbmSmPrivateCertificateConfigurationActionSignCsrRead,
+		DeleteContext: resourceIbmSmPrivateCertificateConfigurationActionSignCsrDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the root or intermediate CA configuration that signs the CSR.",
+			},
+			"csr": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PEM-encoded certificate signing request.",
+			},
+			"common_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Common Name (AKA CN) represents the server name that is protected by the SSL certificate.",
+			},
+			"alt_names": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    99,
+				Description: "With the Subject Alternative Name field, you can specify additional host names to be protected by a single SSL certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCertificateAltName},
+			},
+			"ip_sans": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The IP Subject Alternative Names (SANs) to define for the CA certificate, in a comma-delimited list.",
+			},
+			"uri_sans": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The URI Subject Alternative Names (SANs) to define for the CA certificate, in a comma-delimited list.",
+			},
+			"other_sans": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The custom Object Identifier (OID) or UTF8-string Subject Alternative Names (SANs) to define for the CA certificate.The alternative names must match the values that are specified in the `allowed_other_sans` field in the associated certificate template. The format is the same as OpenSSL: `<oid>:<type>:<value>` where the current valid type is `UTF8`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The time-to-live (TTL) to assign to the signed certificate.",
+			},
+			"format": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The format of the returned data.",
+			},
+			"max_path_length": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The maximum path length to encode in the generated certificate. `-1` means no limit.",
+			},
+			"exclude_cn_from_sans": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Controls whether the common name is excluded from Subject Alternative Names (SANs).",
+			},
+			"permitted_dns_domains": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The allowed DNS domains or subdomains for the certificates that are to be signed and issued by this CA certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"use_csr_values": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Determines whether to use values from a certificate signing request (CSR) to complete a `private_cert_configuration_action_sign_csr` action.",
+			},
+			"ou": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Organizational Unit (OU) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"organization": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Organization (O) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"country": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Country (C) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"locality": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Locality (L) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"province": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Province (ST) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"street_address": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The street address values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"postal_code": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The postal code values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"serial_number": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The serial number to assign to the generated certificate. To assign a random serial number, you can omit this field.",
+			},
+			"data": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The data that is associated with the signed certificate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The PEM-encoded contents of your certificate.",
+						},
+						"issuing_ca": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The PEM-encoded certificate of the certificate authority that signed and issued this certificate.",
+						},
+						"ca_chain": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The chain of certificate authorities that are associated with the certificate.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"expiration": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The certificate expiration time.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIbmSmPrivateCertificateConfigurationActionSignCsrCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	configName := d.Get("name").(string)
+
+	createConfigurationActionOptions := &secretsmanagerv2.CreateConfigurationActionOptions{}
+
+	createConfigurationActionOptions.SetName(configName)
+	createConfigurationActionOptions.SetConfigActionPrototype(resourceIbmSmPrivateCertificateConfigurationActionSignCsrMapToConfigurationActionPrototype(d))
+
+	configurationActionIntf, response, err := secretsManagerClient.CreateConfigurationActionWithContext(context, createConfigurationActionOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("CreateConfigurationActionWithContext", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%d", region, instanceId, configName, time.Now().Unix()))
+
+	configurationAction := configurationActionIntf.(*secretsmanagerv2.PrivateCertificateConfigurationActionSignCSR)
+	if configurationAction.Data != nil {
+		dataMap, err := resourceIbmSmPrivateCertificateConfigurationActionSignCsrCACertificateToMap(configurationAction.Data)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("data", []map[string]interface{}{dataMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting data: %s", err))
+		}
+	}
+
+	return resourceIbmSmPrivateCertificateConfigurationActionSignCsrRead(context, d, meta)
+}
+
+func resourceIbmSmPrivateCertificateConfigurationActionSignCsrRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	configName := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	// Signing is a one-off action. Only drop it from state when the signing CA no longer exists.
+	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+	getConfigurationOptions.SetName(configName)
+
+	_, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return flex.ServiceErrorDiags("GetConfigurationWithContext", err, response)
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("name", configName); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmPrivateCertificateConfigurationActionSignCsrDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Signed certificates are not revoked.
+	d.SetId("")
+	return nil
+}
+
+func resourceIbmSmPrivateCertificateConfigurationActionSignCsrMapToConfigurationActionPrototype(d *schema.ResourceData) *secretsmanagerv2.PrivateCertificateConfigurationActionSignCSRPrototype {
+	model := &secretsmanagerv2.PrivateCertificateConfigurationActionSignCSRPrototype{
+		ActionType: core.StringPtr(secretsmanagerv2.PrivateCertificateConfigurationActionSignCSRPrototype_ActionType_PrivateCertConfigurationActionSignCsr),
+		Csr:        core.StringPtr(d.Get("csr").(string)),
+	}
+	if _, ok := d.GetOk("common_name"); ok {
+		model.CommonName = core.StringPtr(d.Get("common_name").(string))
+	}
+	if _, ok := d.GetOk("alt_names"); ok {
+		model.AltNames = flex.ExpandStringList(d.Get("alt_names").([]interface{}))
+	}
+	if _, ok := d.GetOk("ip_sans"); ok {
+		model.IpSans = core.StringPtr(d.Get("ip_sans").(string))
+	}
+	if _, ok := d.GetOk("uri_sans"); ok {
+		model.UriSans = core.StringPtr(d.Get("uri_sans").(string))
+	}
+	if _, ok := d.GetOk("other_sans"); ok {
+		model.OtherSans = flex.ExpandStringList(d.Get("other_sans").([]interface{}))
+	}
+	if _, ok := d.GetOk("ttl"); ok {
+		model.TTL = core.StringPtr(d.Get("ttl").(string))
+	}
+	if _, ok := d.GetOk("format"); ok {
+		model.Format = core.StringPtr(d.Get("format").(string))
+	}
+	if _, ok := d.GetOk("max_path_length"); ok {
+		model.MaxPathLength = core.Int64Ptr(int64(d.Get("max_path_length").(int)))
+	}
+	if _, ok := d.GetOk("exclude_cn_from_sans"); ok {
+		model.ExcludeCnFromSans = core.BoolPtr(d.Get("exclude_cn_from_sans").(bool))
+	}
+	if _, ok := d.GetOk("permitted_dns_domains"); ok {
+		model.PermittedDnsDomains = flex.ExpandStringList(d.Get("permitted_dns_domains").([]interface{}))
+	}
+	if _, ok := d.GetOk("use_csr_values"); ok {
+		model.UseCsrValues = core.BoolPtr(d.Get("use_csr_values").(bool))
+	}
+	if _, ok := d.GetOk("ou"); ok {
+		model.Ou = flex.ExpandStringList(d.Get("ou").([]interface{}))
+	}
+	if _, ok := d.GetOk("organization"); ok {
+		model.Organization = flex.ExpandStringList(d.Get("organization").([]interface{}))
+	}
+	if _, ok := d.GetOk("country"); ok {
+		model.Country = flex.ExpandStringList(d.Get("country").([]interface{}))
+	}
+	if _, ok := d.GetOk("locality"); ok {
+		model.Locality = flex.ExpandStringList(d.Get("locality").([]interface{}))
+	}
+	if _, ok := d.GetOk("province"); ok {
+		model.Province = flex.ExpandStringList(d.Get("province").([]interface{}))
+	}
+	if _, ok := d.GetOk("street_address"); ok {
+		model.StreetAddress = flex.ExpandStringList(d.Get("street_address").([]interface{}))
+	}
+	if _, ok := d.GetOk("postal_code"); ok {
+		model.PostalCode = flex.ExpandStringList(d.Get("postal_code").([]interface{}))
+	}
+	if _, ok := d.GetOk("serial_number"); ok {
+		model.SerialNumber = core.StringPtr(d.Get("serial_number").(string))
+	}
+	return model
+}
+
+func resourceIbmSmPrivateCertificateConfigurationActionSignCsrCACertificateToMap(model *secretsmanagerv2.PrivateCertificateConfigurationCACertificate) (map[string]interface{}, error) {
+	modelMap := make(map[string]interface{})
+	if model.Certificate != nil {
+		modelMap["certificate"] = model.Certificate
+	}
+	if model.IssuingCa != nil {
+		modelMap["issuing_ca"] = model.IssuingCa
+	}
+	if model.CaChain != nil {
+		modelMap["ca_chain"] = model.CaChain
+	}
+	if model.Expiration != nil {
+		modelMap["expiration"] = flex.IntValue(model.Expiration)
+	}
+	return modelMap, nil
+}
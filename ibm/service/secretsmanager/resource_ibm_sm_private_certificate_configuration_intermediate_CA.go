@@ -19,7 +19,7 @@ import (
 )
 
 func ResourceIbmSmPrivateCertificateConfigurationIntermediateCA() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmPrivateCertificateConfigurationIntermediateCACreate,
 		ReadContext:   resourceIbmSmPrivateCertificateConfigurationIntermediateCARead,
 		UpdateContext: resourceIbmSmPrivateCertificateConfigurationIntermediateCAUpdate,
@@ -88,8 +88,9 @@ func ResourceIbmSmPrivateCertificateConfigurationIntermediateCA() *schema.Resour
 				Type:        schema.TypeList,
 				Optional:    true,
 				ForceNew:    true,
+				MaxItems:    99,
 				Description: "With the Subject Alternative Name field, you can specify additional host names to be protected by a single SSL certificate.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCertificateAltName},
 			},
 			"ip_sans": &schema.Schema{
 				Type:        schema.TypeString,
@@ -306,7 +307,7 @@ func ResourceIbmSmPrivateCertificateConfigurationIntermediateCA() *schema.Resour
 				Description: "Determines whether to use values from a certificate signing request (CSR) to complete a `private_cert_configuration_action_sign_csr` action.",
 			},
 		},
-	}
+	}, "name")
 }
 
 func resourceIbmSmPrivateCertificateConfigurationIntermediateCACreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -329,8 +330,7 @@ func resourceIbmSmPrivateCertificateConfigurationIntermediateCACreate(context co
 
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateConfigurationWithContext", err, response)
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA)
 
@@ -379,12 +379,11 @@ func resourceIbmSmPrivateCertificateConfigurationIntermediateCARead(context cont
 
 	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetConfigurationWithContext", err, response)
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA)
 
@@ -565,8 +564,7 @@ func resourceIbmSmPrivateCertificateConfigurationIntermediateCAUpdate(context co
 		updateConfigurationOptions.ConfigurationPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateConfigurationWithContext", err, response)
 		}
 	}
 
@@ -591,8 +589,7 @@ func resourceIbmSmPrivateCertificateConfigurationIntermediateCADelete(context co
 
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteConfigurationWithContext", err, response)
 	}
 
 	d.SetId("")
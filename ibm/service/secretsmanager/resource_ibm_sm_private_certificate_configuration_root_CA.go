@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,7 +18,7 @@ import (
 )
 
 func ResourceIbmSmPrivateCertificateConfigurationRootCA() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmPrivateCertificateConfigurationRootCACreate,
 		ReadContext:   resourceIbmSmPrivateCertificateConfigurationRootCARead,
 		UpdateContext: resourceIbmSmPrivateCertificateConfigurationRootCAUpdate,
@@ -73,8 +72,9 @@ func ResourceIbmSmPrivateCertificateConfigurationRootCA() *schema.Resource {
 				Type:        schema.TypeList,
 				Optional:    true,
 				ForceNew:    true,
+				MaxItems:    99,
 				Description: "With the Subject Alternative Name field, you can specify additional host names to be protected by a single SSL certificate.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCertificateAltName},
 			},
 			"ip_sans": &schema.Schema{
 				Type:        schema.TypeString,
@@ -305,7 +305,7 @@ func ResourceIbmSmPrivateCertificateConfigurationRootCA() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "name")
 }
 
 func resourceIbmSmPrivateCertificateConfigurationRootCACreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -328,8 +328,7 @@ func resourceIbmSmPrivateCertificateConfigurationRootCACreate(context context.Co
 
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateConfigurationWithContext", err, response)
 	}
 
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationRootCA)
@@ -356,12 +355,11 @@ func resourceIbmSmPrivateCertificateConfigurationRootCARead(context context.Cont
 
 	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetConfigurationWithContext", err, response)
 	}
 
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationRootCA)
@@ -549,8 +547,7 @@ func resourceIbmSmPrivateCertificateConfigurationRootCAUpdate(context context.Co
 		updateConfigurationOptions.ConfigurationPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateConfigurationWithContext", err, response)
 		}
 	}
 
@@ -575,8 +572,7 @@ func resourceIbmSmPrivateCertificateConfigurationRootCADelete(context context.Co
 
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteConfigurationWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,7 +18,7 @@ import (
 )
 
 func ResourceIbmSmPrivateCertificateConfigurationTemplate() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmPrivateCertificateConfigurationTemplateCreate,
 		ReadContext:   resourceIbmSmPrivateCertificateConfigurationTemplateRead,
 		UpdateContext: resourceIbmSmPrivateCertificateConfigurationTemplateUpdate,
@@ -318,7 +317,7 @@ func ResourceIbmSmPrivateCertificateConfigurationTemplate() *schema.Resource {
 				Description: "The duration in seconds by which to backdate the `not_before` property of an issued private certificate.",
 			},
 		},
-	}
+	}, "name")
 }
 
 func resourceIbmSmPrivateCertificateConfigurationTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -341,8 +340,7 @@ func resourceIbmSmPrivateCertificateConfigurationTemplateCreate(context context.
 
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateConfigurationWithContext", err, response)
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationTemplate)
 
@@ -369,12 +367,11 @@ func resourceIbmSmPrivateCertificateConfigurationTemplateRead(context context.Co
 
 	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetConfigurationWithContext", err, response)
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationTemplate)
 
@@ -805,8 +802,7 @@ func resourceIbmSmPrivateCertificateConfigurationTemplateUpdate(context context.
 		updateConfigurationOptions.ConfigurationPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateConfigurationWithContext", err, response)
 		}
 	}
 
@@ -831,8 +827,7 @@ func resourceIbmSmPrivateCertificateConfigurationTemplateDelete(context context.
 
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteConfigurationWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -85,6 +85,72 @@ func testAccCheckIbmSmPrivateCertificateConfigBasic() string {
 		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
 }
 
+func TestAccIbmSmPrivateCertificateAltNamesOrder(t *testing.T) {
+	var conf secretsmanagerv2.PrivateCertificate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmPrivateCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPrivateCertificateConfigAltNames(`["a.ibm.com", "b.ibm.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmPrivateCertificateExists("ibm_sm_private_certificate.sm_private_certificate", conf),
+					resource.TestCheckResourceAttr("ibm_sm_private_certificate.sm_private_certificate", "alt_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr("ibm_sm_private_certificate.sm_private_certificate", "alt_names.*", "a.ibm.com"),
+				),
+			},
+			resource.TestStep{
+				Config:   testAccCheckIbmSmPrivateCertificateConfigAltNames(`["b.ibm.com", "a.ibm.com"]`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmPrivateCertificateConfigAltNames(altNames string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_private_certificate_configuration_root_ca" "ibm_sm_private_certificate_configuration_root_ca_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			max_ttl = "180000"
+			common_name = "ibm.com"
+			crl_expiry = "10000h"
+			name = "root-ca-terraform-private-cert-alt-names-test"
+		}
+		resource "ibm_sm_private_certificate_configuration_intermediate_ca" "ibm_sm_private_certificate_configuration_intermediate_ca_instance" {
+  			instance_id   = "%s"
+			region        = "%s"
+			max_ttl = "180000"
+			common_name = "ibm.com"
+			issuer = ibm_sm_private_certificate_configuration_root_ca.ibm_sm_private_certificate_configuration_root_ca_instance.name
+			signing_method = "internal"
+			name = "intermediate-ca-terraform-private-cert-alt-names-test"
+		}
+		resource "ibm_sm_private_certificate_configuration_template" "sm_private_certificate_configuration_template_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			certificate_authority = ibm_sm_private_certificate_configuration_intermediate_ca.ibm_sm_private_certificate_configuration_intermediate_ca_instance.name
+			allow_any_name = true
+			name = "template-terraform-private-cert-alt-names-test"
+		}
+
+		resource "ibm_sm_private_certificate" "sm_private_certificate" {
+			instance_id = "%s"
+			region = "%s"
+		  	name = "private_cert_terraform-alt-names-test"
+  			certificate_template = ibm_sm_private_certificate_configuration_template.sm_private_certificate_configuration_template_instance.name
+  			common_name = "ibm.com"
+  			alt_names = %s
+  			ttl = "1800"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID,
+		acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, altNames)
+}
+
 func testAccCheckIbmSmPrivateCertificateExists(n string, obj secretsmanagerv2.PrivateCertificate) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
@@ -6,28 +6,34 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"log"
+	"net"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	cisdnsrecordsv1 "github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	ciszonesv1 "github.com/IBM/networking-go-sdk/zonesv1"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
 func ResourceIbmSmPublicCertificate() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmPublicCertificateCreate,
 		ReadContext:   resourceIbmSmPublicCertificateRead,
 		UpdateContext: resourceIbmSmPublicCertificateUpdate,
 		DeleteContext: resourceIbmSmPublicCertificateDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importSecretByCrn,
+		},
+		CustomizeDiff: resourceIbmSmPublicCertificateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"secret_type": &schema.Schema{
@@ -55,29 +61,34 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 			"labels": &schema.Schema{
 				Type:        schema.TypeList,
 				Optional:    true,
+				MaxItems:    30,
 				Description: "Labels that you can use to search for secrets in your instance.Up to 30 labels can be created.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringLenBetween(2, 30)},
 			},
 			"common_name": &schema.Schema{
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
-				Description: "The Common Name (AKA CN) represents the server name that is protected by the SSL certificate.",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.All(validation.StringLenBetween(4, 64), validatePublicCertificateDomain),
+				Description:  "The Common Name (AKA CN) represents the server name that is protected by the SSL certificate.",
 			},
 			"alt_names": &schema.Schema{
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				ForceNew:    true,
 				Optional:    true,
 				Computed:    true,
+				MaxItems:    99,
 				Description: "With the Subject Alternative Name field, you can specify additional host names to be protected by a single SSL certificate.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validatePublicCertificateDomain},
+				Set:         schema.HashString,
 			},
 			"key_algorithm": &schema.Schema{
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Optional:    true,
-				Default:     "RSA2048",
-				Description: "The identifier for the cryptographic algorithm to be used to generate the public key that is associated with the certificate.The algorithm that you select determines the encryption algorithm (`RSA` or `ECDSA`) and key size to be used to generate keys and sign certificates. For longer living certificates, it is recommended to use longer keys to provide more encryption protection. Allowed values:  RSA2048, RSA4096, EC256, EC384.",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Default:      "RSA2048",
+				ValidateFunc: validation.StringInSlice([]string{"RSA2048", "RSA4096", "EC256", "EC384"}, false),
+				Description:  "The identifier for the cryptographic algorithm to be used to generate the public key that is associated with the certificate.The algorithm that you select determines the encryption algorithm (`RSA` or `ECDSA`) and key size to be used to generate keys and sign certificates. For longer living certificates, it is recommended to use longer keys to provide more encryption protection. Allowed values:  RSA2048, RSA4096, EC256, EC384.",
 			},
 			"ca": &schema.Schema{
 				Type:        schema.TypeString,
@@ -91,6 +102,26 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 				Required:    true,
 				Description: "A human-readable unique name to assign to your configuration.To protect your privacy, do not use personal data, such as your name or location, as an name for your secret.",
 			},
+			"manual_dns": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Creates the TXT records of the DNS challenges in Cloud Internet Services and validates them when `dns` is `manual`. The records are deleted after the certificate is issued.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cis_crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the Cloud Internet Services instance that hosts the DNS zone.",
+						},
+						"zone_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the DNS zone. By default, the zone is looked up from the TXT record names.",
+						},
+					},
+				},
+			},
 			"bundle_certs": &schema.Schema{
 				Type:        schema.TypeBool,
 				ForceNew:    true,
@@ -113,16 +144,18 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 							Description: "Determines whether Secrets Manager rotates your secret automatically.Default is `false`. If `auto_rotate` is set to `true` the service rotates your secret based on the defined interval.",
 						},
 						"interval": &schema.Schema{
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Computed:    true,
-							Description: "The length of the secret rotation time interval.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The length of the secret rotation time interval.",
 						},
 						"unit": &schema.Schema{
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "The units for the secret rotation time interval.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"day", "month"}, false),
+							Description:  "The units for the secret rotation time interval.",
 						},
 						"rotate_keys": &schema.Schema{
 							Type:        schema.TypeBool,
@@ -139,13 +172,28 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 				Description: "The secret metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret metadata that a user can customize, as a JSON object. Use it instead of custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"version_custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
-				ForceNew:    true,
 				Optional:    true,
 				Description: "The secret version metadata that a user can customize.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"version_custom_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"version_custom_metadata"},
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: flex.SuppressEquivalentJSONValue,
+				Description:      "The secret version metadata that a user can customize, as a JSON object. Use it instead of version_custom_metadata when the values are numbers, booleans or nested objects.",
+			},
 			"created_by": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -319,6 +367,32 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 				Sensitive:   true,
 				Description: "The PEM-encoded contents of your certificate.",
 			},
+			"sha1_fingerprint": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-1 fingerprint of the certificate, as colon-separated hexadecimal pairs.",
+			},
+			"sha256_fingerprint": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate, as colon-separated hexadecimal pairs.",
+			},
+			"subject": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the subject of the certificate.",
+			},
+			"subject_alt_names": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Subject Alternative Names that are read from the certificate: DNS names, IP addresses, email addresses and URIs.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"days_until_expiry": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of whole days until the certificate expires, as of the last refresh.",
+			},
 			"intermediate": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -331,11 +405,34 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 				Sensitive:   true,
 				Description: "(Optional) The PEM-encoded private key to associate with the certificate.",
 			},
+			"force_delete": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the locks from all the versions of the secret before the secret is deleted. A secret with locks can not be deleted.",
+			},
+			"poll_interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 179),
+				Description:  "The number of seconds between the checks of the certificate while it is ordered. A random jitter of up to a fifth of the interval is added to every check.",
+			},
+			"initial_delay": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of seconds to wait before the certificate is checked for the first time after it is ordered.",
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(35 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(35 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
-	}
+	}, "secret_id")
 }
 
 func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -348,6 +445,11 @@ func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.Res
 	instanceId := d.Get("instance_id").(string)
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
+	_, manualDns := d.GetOk("manual_dns")
+	if manualDns && d.Get("dns").(string) != "manual" {
+		return diag.FromErr(fmt.Errorf("[ERROR] manual_dns can only be set when dns is manual"))
+	}
+
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
 
 	secretPrototypeModel, err := resourceIbmSmPublicCertificateMapToSecretPrototype(d)
@@ -358,16 +460,36 @@ func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.Res
 
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.PublicCertificate)
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmPublicCertificateCreate(secretsManagerClient, d)
+	// A certificate ordered with manual DNS is only issued after its challenges are validated
+	if d.Get("dns").(string) == "manual" {
+		challengesObj, err := waitForIbmSmPublicCertificateChallenges(context, secretsManagerClient, d)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for the DNS challenges of resource IbmSmPublicCertificate (%s): %s", d.Id(), err))
+		}
+		if !manualDns {
+			return resourceIbmSmPublicCertificateRead(context, d, meta)
+		}
+		challenges := challengesObj.(*secretsmanagerv2.PublicCertificate).IssuanceInfo.Challenges
+		err = resourceIbmSmPublicCertificateValidateManualDns(context, secretsManagerClient, d, meta, challenges)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceIbmSmPublicCertificateRead(context, d, meta)
+	}
+
+	_, err = waitForIbmSmPublicCertificateCreate(context, secretsManagerClient, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
+		if isWaitTimeout(context, err) {
+			return resourceIbmSmPublicCertificatePreActivation(d)
+		}
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmPublicCertificate (%s) to be created: %s", d.Id(), err))
 	}
@@ -375,7 +497,7 @@ func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.Res
 	return resourceIbmSmPublicCertificateRead(context, d, meta)
 }
 
-func waitForIbmSmPublicCertificateCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmPublicCertificateChallenges(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -383,30 +505,199 @@ func waitForIbmSmPublicCertificateCreate(secretsManagerClient *secretsmanagerv2.
 
 	getSecretOptions.SetID(secretId)
 
+	pollInterval := time.Duration(d.Get("poll_interval").(int)) * time.Second
+
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pre_activation"},
-		Target:  []string{"active"},
-		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+		Pending: []string{"pending"},
+		Target:  []string{"challenges_ready"},
+		Refresh: withPollingJitter(context, pollInterval, func() (interface{}, string, error) {
+			stateObjIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetSecret failed %s\n%s", err, response)
+			}
 			stateObj := stateObjIntf.(*secretsmanagerv2.PublicCertificate)
+			if *stateObj.StateDescription == "destroyed" {
+				return stateObj, *stateObj.StateDescription, fmt.Errorf("The certificate %s could not be ordered", secretId)
+			}
+			if stateObj.IssuanceInfo != nil && stateObj.IssuanceInfo.ErrorMessage != nil {
+				return stateObj, "failed", fmt.Errorf("The certificate %s could not be ordered: %s", secretId, *stateObj.IssuanceInfo.ErrorMessage)
+			}
+			if stateObj.IssuanceInfo != nil && len(stateObj.IssuanceInfo.Challenges) > 0 {
+				return stateObj, "challenges_ready", nil
+			}
+			return stateObj, "pending", nil
+		}),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        0 * time.Second,
+		PollInterval: pollInterval,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+// resourceIbmSmPublicCertificateValidateManualDns creates the TXT records of the challenges in
+// Cloud Internet Services, validates the challenges and waits for the certificate to be issued.
+func resourceIbmSmPublicCertificateValidateManualDns(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData, meta interface{}, challenges []secretsmanagerv2.ChallengeResource) error {
+	manualDnsConfig := d.Get("manual_dns").([]interface{})[0].(map[string]interface{})
+	cisCrn := manualDnsConfig["cis_crn"].(string)
+	zoneId := manualDnsConfig["zone_id"].(string)
+
+	dnsRecordsClient, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	dnsRecordsClient.Crn = core.StringPtr(cisCrn)
+
+	var zones []ciszonesv1.ZoneDetails
+	if zoneId == "" {
+		zonesClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+		if err != nil {
+			return err
+		}
+		zonesClient.Crn = core.StringPtr(cisCrn)
+		result, response, err := zonesClient.ListZones(zonesClient.NewListZonesOptions())
+		if err != nil {
+			log.Printf("[DEBUG] ListZones failed %s\n%s", err, response)
+			return fmt.Errorf("ListZones failed %s\n%s", err, response)
+		}
+		zones = result.Result
+	}
+
+	// The records are only needed to validate the challenges
+	records := map[string]string{}
+	defer func() {
+		for recordId, recordZoneId := range records {
+			dnsRecordsClient.ZoneIdentifier = core.StringPtr(recordZoneId)
+			_, response, err := dnsRecordsClient.DeleteDnsRecord(dnsRecordsClient.NewDeleteDnsRecordOptions(recordId))
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
-				}
-				return nil, "", err
+				log.Printf("[WARN] Error deleting DNS challenge record %s: %s\n%s", recordId, err, response)
 			}
-			failStates := map[string]bool{"destroyed": true}
-			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
+		}
+	}()
+
+	for _, challenge := range challenges {
+		recordZoneId := zoneId
+		if recordZoneId == "" {
+			recordZoneId = findIbmSmPublicCertificateChallengeZone(zones, *challenge.TxtRecordName)
+			if recordZoneId == "" {
+				return fmt.Errorf("[ERROR] No DNS zone of %s matches the challenge record %s", cisCrn, *challenge.TxtRecordName)
 			}
-			return stateObj, *stateObj.StateDescription, nil
+		}
+		dnsRecordsClient.ZoneIdentifier = core.StringPtr(recordZoneId)
+
+		createDnsRecordOptions := dnsRecordsClient.NewCreateDnsRecordOptions()
+		createDnsRecordOptions.SetType(cisdnsrecordsv1.CreateDnsRecordOptions_Type_Txt)
+		createDnsRecordOptions.SetName(*challenge.TxtRecordName)
+		createDnsRecordOptions.SetContent(*challenge.TxtRecordValue)
+		createDnsRecordOptions.SetTTL(120)
+		record, response, err := dnsRecordsClient.CreateDnsRecord(createDnsRecordOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateDnsRecord failed %s\n%s", err, response)
+			return fmt.Errorf("CreateDnsRecord failed %s\n%s", err, response)
+		}
+		records[*record.Result.ID] = recordZoneId
+	}
+
+	id := strings.Split(d.Id(), "/")
+	secretId := id[2]
+	err = createIbmSmPublicCertificateValidateManualDnsAction(context, secretsManagerClient, secretId)
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForIbmSmPublicCertificateCreate(context, secretsManagerClient, d, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error waiting for resource IbmSmPublicCertificate (%s) to be created: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func createIbmSmPublicCertificateValidateManualDnsAction(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string) error {
+	createSecretActionOptions := &secretsmanagerv2.CreateSecretActionOptions{}
+	createSecretActionOptions.SetID(secretId)
+	createSecretActionOptions.SetSecretActionPrototype(&secretsmanagerv2.PublicCertificateActionValidateManualDNSPrototype{
+		ActionType: core.StringPtr(secretsmanagerv2.PublicCertificateActionValidateManualDNSPrototype_ActionType_PublicCertActionValidateDnsChallenge),
+	})
+
+	_, response, err := secretsManagerClient.CreateSecretActionWithContext(context, createSecretActionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretActionWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("CreateSecretActionWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// findIbmSmPublicCertificateChallengeZone returns the ID of the most specific zone that contains the record.
+func findIbmSmPublicCertificateChallengeZone(zones []ciszonesv1.ZoneDetails, recordName string) string {
+	zoneId, zoneName := "", ""
+	recordName = strings.TrimSuffix(recordName, ".")
+	for _, zone := range zones {
+		if zone.ID == nil || zone.Name == nil {
+			continue
+		}
+		if (recordName == *zone.Name || strings.HasSuffix(recordName, "."+*zone.Name)) && len(*zone.Name) > len(zoneName) {
+			zoneId, zoneName = *zone.ID, *zone.Name
+		}
+	}
+	return zoneId
+}
+
+func waitForIbmSmPublicCertificateCreate(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+	pollInterval := time.Duration(d.Get("poll_interval").(int)) * time.Second
+
+	stateConf := newIbmSmSecretCreateStateConf(context, secretsManagerClient, d, timeout)
+	stateConf.Refresh = withPollingJitter(context, pollInterval, stateConf.Refresh)
+	stateConf.Delay = time.Duration(d.Get("initial_delay").(int)) * time.Second
+	stateConf.MinTimeout = 0
+	stateConf.PollInterval = pollInterval
+
+	return stateConf.WaitForStateContext(context)
+}
+
+// resourceIbmSmPublicCertificatePreActivation keeps a certificate that is still ordered when the
+// timeout expires, instead of failing the resource and ordering a new certificate on the next apply.
+func resourceIbmSmPublicCertificatePreActivation(d *schema.ResourceData) diag.Diagnostics {
+	d.Set("state_description", "pre_activation")
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The public certificate %s is still being ordered", d.Id()),
+			Detail:   "The timeout expired before the certificate was issued. The next apply waits for the certificate again.",
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      0 * time.Second,
-		MinTimeout: 5 * time.Second,
 	}
+}
 
-	return stateConf.WaitForState()
+// resourceIbmSmPublicCertificateCustomizeDiff plans an update of a certificate that is still ordered,
+// so that the update resumes waiting for it. Certificates with manual DNS wait for their challenges to be validated.
+func resourceIbmSmPublicCertificateCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.Get("state_description").(string) == "pre_activation" && diff.Get("dns").(string) != "manual" {
+		return diff.SetNewComputed("state_description")
+	}
+	return nil
+}
+
+// validatePublicCertificateDomain validates a domain of a public certificate against the rules of
+// Let's Encrypt, so that an order that would be rejected fails at plan time. The domain must be a
+// host name with at least two labels, not an IP address or an email address, and a wildcard can
+// only be the whole leftmost label.
+func validatePublicCertificateDomain(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	domain := strings.TrimPrefix(v, "*.")
+	switch {
+	case strings.Contains(domain, "*"):
+		errors = append(errors, fmt.Errorf("expected %s to have a wildcard only as the whole leftmost label, such as *.example.com, got %q", k, v))
+	case net.ParseIP(v) != nil:
+		errors = append(errors, fmt.Errorf("expected %s to be a host name, public certificates can't be issued for IP addresses, got %q", k, v))
+	case len(v) > 253 || !strings.Contains(domain, ".") || !certificateHostNameRegexp.MatchString(domain):
+		errors = append(errors, fmt.Errorf("expected %s to be a fully qualified host name, such as example.com or *.example.com, got %q", k, v))
+	}
+
+	return warnings, errors
 }
 
 func resourceIbmSmPublicCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -427,12 +718,11 @@ func resourceIbmSmPublicCertificateRead(context context.Context, d *schema.Resou
 
 	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretWithContext", err, response)
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.PublicCertificate)
@@ -446,6 +736,9 @@ func resourceIbmSmPublicCertificateRead(context context.Context, d *schema.Resou
 	if err = d.Set("region", region); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
 	}
+	if err = d.Set("force_delete", d.Get("force_delete").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting force_delete: %s", err))
+	}
 	if err = d.Set("created_by", secret.CreatedBy); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
 	}
@@ -513,8 +806,8 @@ func resourceIbmSmPublicCertificateRead(context context.Context, d *schema.Resou
 	if err = d.Set("rotation", []map[string]interface{}{rotationMap}); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting rotation: %s", err))
 	}
-	if secret.CustomMetadata != nil {
-		d.Set("custom_metadata", secret.CustomMetadata)
+	if err = setSecretCustomMetadata(d, "custom_metadata", secret.CustomMetadata); err != nil {
+		return diag.FromErr(err)
 	}
 	if err = d.Set("description", secret.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
@@ -553,6 +846,9 @@ func resourceIbmSmPublicCertificateRead(context context.Context, d *schema.Resou
 	if err = d.Set("certificate", secret.Certificate); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting certificate: %s", err))
 	}
+	if err = setCertificateAttributes(d, secret.Certificate); err != nil {
+		return diag.FromErr(err)
+	}
 	if err = d.Set("intermediate", secret.Intermediate); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting intermediate: %s", err))
 	}
@@ -599,8 +895,11 @@ func resourceIbmSmPublicCertificateUpdate(context context.Context, d *schema.Res
 		patchVals.Labels = labelsParsed
 		hasChange = true
 	}
-	if d.HasChange("custom_metadata") {
-		patchVals.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	if d.HasChanges("custom_metadata", "custom_metadata_json") {
+		patchVals.CustomMetadata, err = getSecretCustomMetadataPatch(d, "custom_metadata")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		hasChange = true
 	}
 	if d.HasChange("rotation") {
@@ -617,8 +916,26 @@ func resourceIbmSmPublicCertificateUpdate(context context.Context, d *schema.Res
 		updateSecretMetadataOptions.SecretMetadataPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSecretMetadataWithContext", err, response)
+		}
+	}
+
+	if d.HasChanges("version_custom_metadata", "version_custom_metadata_json") {
+		err = updateSecretVersionCustomMetadata(context, secretsManagerClient, secretId, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Resume waiting for a certificate that was still ordered when the create timeout expired
+	if stateDescription, _ := d.GetChange("state_description"); stateDescription.(string) == "pre_activation" && d.Get("dns").(string) != "manual" {
+		_, err = waitForIbmSmPublicCertificateCreate(context, secretsManagerClient, d, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			if isWaitTimeout(context, err) {
+				return resourceIbmSmPublicCertificatePreActivation(d)
+			}
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for resource IbmSmPublicCertificate (%s) to be issued: %s", d.Id(), err))
 		}
 	}
 
@@ -637,14 +954,19 @@ func resourceIbmSmPublicCertificateDelete(context context.Context, d *schema.Res
 	secretId := id[2]
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
+	if d.Get("force_delete").(bool) {
+		if err = deleteSecretLocks(context, secretsManagerClient, secretId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
 	deleteSecretOptions.SetID(secretId)
 
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSecretWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -677,7 +999,7 @@ func resourceIbmSmPublicCertificateMapToSecretPrototype(d *schema.ResourceData)
 		model.CommonName = core.StringPtr(d.Get("common_name").(string))
 	}
 	if _, ok := d.GetOk("alt_names"); ok {
-		altNames := d.Get("alt_names").([]interface{})
+		altNames := d.Get("alt_names").(*schema.Set).List()
 		altNamesParsed := make([]string, len(altNames))
 		for i, v := range altNames {
 			altNamesParsed[i] = fmt.Sprint(v)
@@ -703,12 +1025,16 @@ func resourceIbmSmPublicCertificateMapToSecretPrototype(d *schema.ResourceData)
 		}
 		model.Rotation = RotationModel
 	}
-	if _, ok := d.GetOk("custom_metadata"); ok {
-		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	customMetadata, err := getSecretCustomMetadata(d, "custom_metadata")
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := d.GetOk("version_custom_metadata"); ok {
-		model.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	model.CustomMetadata = customMetadata
+	versionCustomMetadata, err := getSecretCustomMetadata(d, "version_custom_metadata")
+	if err != nil {
+		return nil, err
 	}
+	model.VersionCustomMetadata = versionCustomMetadata
 	return model, nil
 }
 
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// ResourceIbmSmPublicCertificateActionValidateManualDns validates the DNS challenges of a
+// public certificate that is ordered with manual DNS. Create the TXT records of the
+// challenges before the resource, for example with another DNS provider.
+func ResourceIbmSmPublicCertificateActionValidateManualDns() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmPublicCertificateActionValidateManualDnsCreate,
+		ReadContext:   resourceIbmSmPublicCertificateActionValidateManualDnsRead,
+		DeleteContext: resourceIbmSmPublicCertificateActionValidateManualDnsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the public certificate.",
+			},
+			"wait_for_active": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Wait for the certificate to be issued after the challenges are validated.",
+			},
+			"poll_interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 179),
+				Description:  "The number of seconds between the checks of the certificate while it is issued. A random jitter of up to a fifth of the interval is added to every check.",
+			},
+			"initial_delay": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of seconds to wait before the certificate is checked for the first time after the challenges are validated.",
+			},
+			"state_description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A text representation of the secret state.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(35 * time.Minute),
+		},
+	}
+}
+
+func resourceIbmSmPublicCertificateActionValidateManualDnsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	err = createIbmSmPublicCertificateValidateManualDnsAction(context, secretsManagerClient, secretId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	if d.Get("wait_for_active").(bool) {
+		_, err = waitForIbmSmPublicCertificateCreate(context, secretsManagerClient, d, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for the public certificate (%s) to be issued: %s", secretId, err))
+		}
+	}
+
+	return resourceIbmSmPublicCertificateActionValidateManualDnsRead(context, d, meta)
+}
+
+func resourceIbmSmPublicCertificateActionValidateManualDnsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+
+	getSecretMetadataOptions.SetID(secretId)
+
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return flex.ServiceErrorDiags("GetSecretMetadataWithContext", err, response)
+	}
+
+	secretMetadata := secretMetadataIntf.(*secretsmanagerv2.PublicCertificateMetadata)
+
+	if err = d.Set("secret_id", secretId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("state_description", secretMetadata.StateDescription); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting state_description: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmPublicCertificateActionValidateManualDnsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The validation can not be undone.
+	d.SetId("")
+	return nil
+}
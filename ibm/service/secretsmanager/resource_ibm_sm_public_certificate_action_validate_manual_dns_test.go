@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmPublicCertificateActionValidateManualDnsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmPublicCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPublicCertificateActionValidateManualDnsConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_public_certificate_action_validate_manual_dns.sm_validate_manual_dns", "state_description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmPublicCertificateActionValidateManualDnsConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_public_certificate_configuration_ca_lets_encrypt" "sm_public_certificate_configuration_ca_lets_encrypt_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "public_cert_ca_lets_encrypt-terraform-test-validate-dns"
+			lets_encrypt_environment = "%s"
+			lets_encrypt_private_key = "%s"
+		}
+
+		resource "ibm_sm_public_certificate" "sm_public_certificate" {
+			instance_id = "%s"
+			region = "%s"
+			name = "public-certificate-terraform-tests-validate-dns"
+			secret_group_id = "default"
+			common_name = "%s"
+			ca = ibm_sm_public_certificate_configuration_ca_lets_encrypt.sm_public_certificate_configuration_ca_lets_encrypt_instance.name
+			dns = "manual"
+		}
+
+		resource "ibm_sm_public_certificate_action_validate_manual_dns" "sm_validate_manual_dns" {
+			instance_id = "%s"
+			region = "%s"
+			secret_id = ibm_sm_public_certificate.sm_public_certificate.secret_id
+			wait_for_active = false
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerPublicCertificateLetsEncryptEnvironment, acc.SecretsManagerPublicCertificateLetsEncryptPrivateKey,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerPublicCertificateCommonName,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -8,16 +8,16 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"log"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
 func ResourceIbmSmPublicCertificateConfigurationCALetsEncrypt() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmPublicCertificateConfigurationCALetsEncryptCreate,
 		ReadContext:   resourceIbmSmPublicCertificateConfigurationCALetsEncryptRead,
 		UpdateContext: resourceIbmSmPublicCertificateConfigurationCALetsEncryptUpdate,
@@ -59,7 +59,7 @@ func ResourceIbmSmPublicCertificateConfigurationCALetsEncrypt() *schema.Resource
 				Description: "The secret type. Supported types are arbitrary, certificates (imported, public, and private), IAM credentials, key-value, and user credentials.",
 			},
 		},
-	}
+	}, "name")
 }
 
 func resourceIbmSmPublicCertificateConfigurationCALetsEncryptCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -82,8 +82,7 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptCreate(context cont
 
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateConfigurationWithContext", err, response)
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationCALetsEncrypt)
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *configuration.Name))
@@ -109,12 +108,11 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptRead(context contex
 
 	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetConfigurationWithContext", err, response)
 	}
 
 	configuration := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationCALetsEncrypt)
@@ -179,8 +177,7 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptUpdate(context cont
 		updateConfigurationOptions.ConfigurationPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateConfigurationWithContext", err, response)
 		}
 	}
 
@@ -205,8 +202,7 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptDelete(context cont
 
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteConfigurationWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,7 +19,7 @@ import (
 )
 
 func ResourceIbmSmConfigurationPublicCertificateDNSCis() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmConfigurationPublicCertificateDNSCisCreate,
 		ReadContext:   resourceIbmSmConfigurationPublicCertificateDNSCisRead,
 		UpdateContext: resourceIbmSmConfigurationPublicCertificateDNSCisUpdate,
@@ -72,7 +71,7 @@ func ResourceIbmSmConfigurationPublicCertificateDNSCis() *schema.Resource {
 				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
 			},
 		},
-	}
+	}, "name")
 }
 
 func ResourceIbmSmConfigurationPublicCertificateDNSCisValidator() *validate.ResourceValidator {
@@ -136,8 +135,7 @@ func resourceIbmSmConfigurationPublicCertificateDNSCisCreate(context context.Con
 
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateConfigurationWithContext", err, response)
 	}
 
 	configuration := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationDNSCloudInternetServices)
@@ -163,12 +161,11 @@ func resourceIbmSmConfigurationPublicCertificateDNSCisRead(context context.Conte
 
 	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetConfigurationWithContext", err, response)
 	}
 
 	configuration := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationDNSCloudInternetServices)
@@ -245,8 +242,7 @@ func resourceIbmSmConfigurationPublicCertificateDNSCisUpdate(context context.Con
 		updateConfigurationOptions.ConfigurationPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateConfigurationWithContext", err, response)
 		}
 	}
 
@@ -270,8 +266,7 @@ func resourceIbmSmConfigurationPublicCertificateDNSCisDelete(context context.Con
 
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteConfigurationWithContext", err, response)
 	}
 
 	d.SetId("")
@@ -39,6 +39,31 @@ func TestAccIbmSmPublicCertificateBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmPublicCertificateManualDns(t *testing.T) {
+	var conf secretsmanagerv2.PublicCertificate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmPublicCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPublicCertificateConfigManualDns(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmPublicCertificateExists("ibm_sm_public_certificate.sm_public_certificate", conf),
+					resource.TestCheckResourceAttr("ibm_sm_public_certificate.sm_public_certificate", "state_description", "active"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_sm_public_certificate.sm_public_certificate",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manual_dns"},
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSmPublicCertificateConfigBasic() string {
 	return fmt.Sprintf(`
 		resource "ibm_sm_public_certificate_configuration_ca_lets_encrypt" "sm_public_certificate_configuration_ca_lets_encrypt_instance" {
@@ -70,6 +95,32 @@ func testAccCheckIbmSmPublicCertificateConfigBasic() string {
 		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerPublicCertificateCommonName)
 }
 
+func testAccCheckIbmSmPublicCertificateConfigManualDns() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_public_certificate_configuration_ca_lets_encrypt" "sm_public_certificate_configuration_ca_lets_encrypt_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "public_cert_ca_lets_encrypt-terraform-test-manual-dns"
+			lets_encrypt_environment = "%s"
+			lets_encrypt_private_key = "%s"
+		}
+
+		resource "ibm_sm_public_certificate" "sm_public_certificate" {
+			instance_id = "%s"
+			region = "%s"
+			name = "public-certificate-terraform-tests-manual-dns"
+			secret_group_id = "default"
+			common_name = "%s"
+			ca = ibm_sm_public_certificate_configuration_ca_lets_encrypt.sm_public_certificate_configuration_ca_lets_encrypt_instance.name
+			dns = "manual"
+			manual_dns {
+				cis_crn = "%s"
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerPublicCertificateLetsEncryptEnvironment, acc.SecretsManagerPublicCertificateLetsEncryptPrivateKey,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerPublicCertificateCommonName, acc.SecretsManagerPublicCertificateCisCrn)
+}
+
 func testAccCheckIbmSmPublicCertificateExists(n string, obj secretsmanagerv2.PublicCertificate) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
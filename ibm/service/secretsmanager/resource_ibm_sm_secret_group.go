@@ -19,7 +19,7 @@ import (
 )
 
 func ResourceIbmSmSecretGroup() *schema.Resource {
-	return &schema.Resource{
+	return withIDStateUpgrader(&schema.Resource{
 		CreateContext: resourceIbmSmSecretGroupCreate,
 		ReadContext:   resourceIbmSmSecretGroupRead,
 		UpdateContext: resourceIbmSmSecretGroupUpdate,
@@ -54,8 +54,14 @@ func ResourceIbmSmSecretGroup() *schema.Resource {
 				Computed:    true,
 				Description: "The date that a resource was recently modified. The date format follows RFC 3339.",
 			},
+			"force_delete": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the secrets of the secret group, and remove their locks, before the secret group is deleted. A secret group that contains secrets can not be deleted.",
+			},
 		},
-	}
+	}, "secret_group_id")
 }
 
 func ResourceIbmSmSecretGroupValidator() *validate.ResourceValidator {
@@ -104,8 +110,7 @@ func resourceIbmSmSecretGroupCreate(context context.Context, d *schema.ResourceD
 
 	secretGroup, response, err := secretsManagerClient.CreateSecretGroupWithContext(context, createSecretGroupOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateSecretGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretGroupWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateSecretGroupWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secretGroup.ID))
@@ -132,12 +137,11 @@ func resourceIbmSmSecretGroupRead(context context.Context, d *schema.ResourceDat
 
 	secretGroup, response, err := secretsManagerClient.GetSecretGroupWithContext(context, getSecretGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetSecretGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretGroupWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecretGroupWithContext", err, response)
 	}
 
 	if err = d.Set("secret_group_id", secretGroupId); err != nil {
@@ -149,6 +153,9 @@ func resourceIbmSmSecretGroupRead(context context.Context, d *schema.ResourceDat
 	if err = d.Set("region", region); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
 	}
+	if err = d.Set("force_delete", d.Get("force_delete").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting force_delete: %s", err))
+	}
 	if err = d.Set("name", secretGroup.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
 	}
@@ -199,8 +206,7 @@ func resourceIbmSmSecretGroupUpdate(context context.Context, d *schema.ResourceD
 		updateSecretGroupOptions.SecretGroupPatch, _ = patchVals.AsPatch()
 		_, response, err := secretsManagerClient.UpdateSecretGroupWithContext(context, updateSecretGroupOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateSecretGroupWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretGroupWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateSecretGroupWithContext", err, response)
 		}
 	}
 
@@ -219,17 +225,79 @@ func resourceIbmSmSecretGroupDelete(context context.Context, d *schema.ResourceD
 	secretGroupId := id[2]
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
+	if d.Get("force_delete").(bool) {
+		if err = resourceIbmSmSecretGroupDeleteSecrets(context, secretsManagerClient, secretGroupId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Secret groups that still contain secrets can not be deleted
+	listSecretsOptions := &secretsmanagerv2.ListSecretsOptions{}
+
+	listSecretsOptions.SetGroups([]string{secretGroupId})
+	listSecretsOptions.SetLimit(1)
+
+	secrets, response, err := secretsManagerClient.ListSecretsWithContext(context, listSecretsOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("ListSecretsWithContext", err, response)
+	}
+	if secrets.TotalCount != nil && *secrets.TotalCount > 0 {
+		return diag.FromErr(fmt.Errorf("The secret group %s contains %d secrets, delete the secrets or move them to another secret group, or set force_delete, before you delete the secret group", secretGroupId, *secrets.TotalCount))
+	}
+
 	deleteSecretGroupOptions := &secretsmanagerv2.DeleteSecretGroupOptions{}
 
 	deleteSecretGroupOptions.SetID(secretGroupId)
 
-	response, err := secretsManagerClient.DeleteSecretGroupWithContext(context, deleteSecretGroupOptions)
+	response, err = secretsManagerClient.DeleteSecretGroupWithContext(context, deleteSecretGroupOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteSecretGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretGroupWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteSecretGroupWithContext", err, response)
 	}
 
 	d.SetId("")
 
 	return nil
 }
+
+// resourceIbmSmSecretGroupDeleteSecrets deletes all the secrets of a secret group, after the
+// locks of their versions are removed.
+func resourceIbmSmSecretGroupDeleteSecrets(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretGroupId string) error {
+	listSecretsOptions := &secretsmanagerv2.ListSecretsOptions{}
+
+	listSecretsOptions.SetGroups([]string{secretGroupId})
+
+	pager, err := secretsManagerClient.NewSecretsPager(listSecretsOptions)
+	if err != nil {
+		return err
+	}
+
+	allItems, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] SecretsPager.GetAll() failed %s", err)
+		return fmt.Errorf("SecretsPager.GetAll() failed %s", err)
+	}
+
+	for _, item := range allItems {
+		secretMetadata, err := dataSourceIbmSmSecretsSecretMetadataToMap(item)
+		if err != nil {
+			return err
+		}
+		secretId := secretMetadata["id"].(string)
+
+		if err = deleteSecretLocks(context, secretsManagerClient, secretId); err != nil {
+			return err
+		}
+
+		deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
+
+		deleteSecretOptions.SetID(secretId)
+
+		response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
+			return fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response)
+		}
+	}
+
+	return nil
+}
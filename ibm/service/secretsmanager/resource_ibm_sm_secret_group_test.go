@@ -14,6 +14,7 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
@@ -80,6 +81,27 @@ func TestAccIbmSmSecretGroupAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmSecretGroupForceDelete(t *testing.T) {
+	var conf secretsmanagerv2.SecretGroup
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmSecretGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretGroupConfigForceDelete(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmSecretGroupExists("ibm_sm_secret_group.sm_secret_group", conf),
+					resource.TestCheckResourceAttr("ibm_sm_secret_group.sm_secret_group", "force_delete", "true"),
+					testAccCheckIbmSmSecretGroupAddSecret("ibm_sm_secret_group.sm_secret_group"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSmSecretGroupConfigBasic(name string) string {
 	return fmt.Sprintf(`
 
@@ -103,6 +125,52 @@ func testAccCheckIbmSmSecretGroupConfig(name string, description string) string
 	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, name, description)
 }
 
+func testAccCheckIbmSmSecretGroupConfigForceDelete(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_secret_group" "sm_secret_group" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "%s"
+			force_delete = true
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, name)
+}
+
+// testAccCheckIbmSmSecretGroupAddSecret creates a secret in the secret group outside of
+// Terraform, so that the secret group can only be destroyed with force_delete.
+func testAccCheckIbmSmSecretGroupAddSecret(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		secretsManagerClient = getClientWithInstanceEndpointTest(secretsManagerClient)
+
+		id := strings.Split(rs.Primary.ID, "/")
+		secretPrototype := &secretsmanagerv2.ArbitrarySecretPrototype{
+			SecretType:    core.StringPtr("arbitrary"),
+			Name:          core.StringPtr(fmt.Sprintf("tf_force_delete_%d", acctest.RandIntRange(10, 100))),
+			Payload:       core.StringPtr("secret-data"),
+			SecretGroupID: core.StringPtr(id[2]),
+		}
+
+		createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
+
+		createSecretOptions.SetSecretPrototype(secretPrototype)
+
+		_, _, err = secretsManagerClient.CreateSecret(createSecretOptions)
+		return err
+	}
+}
+
 func testAccCheckIbmSmSecretGroupExists(n string, obj secretsmanagerv2.SecretGroup) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
@@ -0,0 +1,365 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// ResourceIbmSmSecretLock attaches a lock to a version of a secret. A secret version
+// with locks can not be deleted, and its payload is kept after a rotation. The lock can also
+// be attached to the previous version, to freeze both versions of the secret.
+func ResourceIbmSmSecretLock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretLockCreate,
+		ReadContext:   resourceIbmSmSecretLockRead,
+		DeleteContext: resourceIbmSmSecretLockDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "current",
+				Description: "The ID of the secret version, or the `current` or `previous` alias.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-readable name to assign to the lock. The lock name must be unique per secret version.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "An extended description of the lock.",
+			},
+			"attributes": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Optional information to associate with a lock, such as resources CRNs to be used by automation.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_sm_secret_lock", "mode"),
+				Description:  "An optional lock mode. `exclusive` removes the locks with the same name from the previous version of the secret, `exclusive_delete` also deletes the data of the previous version if it has no locks left.",
+			},
+			"lock_previous_version": &schema.Schema{
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"mode"},
+				Description:   "Attach the lock to the previous version of the secret as well, so that neither version can be deleted or replaced by a rotation. It can only be used with the `current` version.",
+			},
+			"previous_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the previous secret version that the lock is attached to, when `lock_previous_version` is `true`.",
+			},
+			"resolved_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret version that the lock is attached to.",
+			},
+			"secret_version_alias": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A human-readable alias that describes the secret version.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A v4 UUID identifier, or `default` secret group.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier that is associated with the entity that created the lock.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the lock was created. The date format follows RFC 3339.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the lock was recently modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func ResourceIbmSmSecretLockValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "mode",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s", secretsmanagerv2.CreateSecretVersionLocksBulkOptions_Mode_Exclusive, secretsmanagerv2.CreateSecretVersionLocksBulkOptions_Mode_ExclusiveDelete),
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_sm_secret_lock", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmSmSecretLockCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	lockName := d.Get("name").(string)
+	lockPreviousVersion := d.Get("lock_previous_version").(bool)
+
+	if lockPreviousVersion && d.Get("version_id").(string) != "current" {
+		return diag.FromErr(fmt.Errorf("[ERROR] lock_previous_version can only be used with the current version of the secret"))
+	}
+
+	// The lock stays on the version it was created on, so resolve the current and previous aliases
+	versionId, err := resourceIbmSmSecretLockResolveVersionID(context, secretsManagerClient, secretId, d.Get("version_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var previousVersionId string
+	if lockPreviousVersion {
+		previousVersionId, err = resourceIbmSmSecretLockResolveVersionID(context, secretsManagerClient, secretId, "previous")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	lock := secretsmanagerv2.SecretLockPrototype{
+		Name: core.StringPtr(lockName),
+	}
+	if _, ok := d.GetOk("description"); ok {
+		lock.Description = core.StringPtr(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("attributes"); ok {
+		lock.Attributes = d.Get("attributes").(map[string]interface{})
+	}
+
+	versionIds := []string{versionId}
+	if previousVersionId != "" {
+		versionIds = append(versionIds, previousVersionId)
+	}
+	for i, id := range versionIds {
+		createSecretVersionLocksBulkOptions := &secretsmanagerv2.CreateSecretVersionLocksBulkOptions{}
+
+		createSecretVersionLocksBulkOptions.SetSecretID(secretId)
+		createSecretVersionLocksBulkOptions.SetID(id)
+		createSecretVersionLocksBulkOptions.SetLocks([]secretsmanagerv2.SecretLockPrototype{lock})
+		if _, ok := d.GetOk("mode"); ok {
+			createSecretVersionLocksBulkOptions.SetMode(d.Get("mode").(string))
+		}
+
+		_, response, err := secretsManagerClient.CreateSecretVersionLocksBulkWithContext(context, createSecretVersionLocksBulkOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+			// Don't leave the lock of the current version behind when the previous version can't be locked
+			if i > 0 {
+				resourceIbmSmSecretLockDeleteLock(context, secretsManagerClient, secretId, versionId, lockName)
+			}
+			return flex.ServiceErrorDiags("CreateSecretVersionLocksBulkWithContext", err, response)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s", region, instanceId, secretId, versionId, lockName))
+
+	if err = d.Set("previous_version_id", previousVersionId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting previous_version_id: %s", err))
+	}
+
+	return resourceIbmSmSecretLockRead(context, d, meta)
+}
+
+func resourceIbmSmSecretLockRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 5 {
+		return diag.Errorf("Wrong format of resource ID. To import a secret lock use the format `<region>/<instance_id>/<secret_id>/<version_id>/<lock_name>`")
+	}
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	versionId := id[3]
+	lockName := id[4]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	listSecretVersionLocksOptions := &secretsmanagerv2.ListSecretVersionLocksOptions{}
+
+	listSecretVersionLocksOptions.SetSecretID(secretId)
+	listSecretVersionLocksOptions.SetID(versionId)
+	listSecretVersionLocksOptions.SetSearch(lockName)
+
+	secretVersionLocks, response, err := secretsManagerClient.ListSecretVersionLocksWithContext(context, listSecretVersionLocksOptions)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return flex.ServiceErrorDiags("ListSecretVersionLocksWithContext", err, response)
+	}
+
+	// The search matches lock names that contain the name, so look for the exact match
+	var lock *secretsmanagerv2.SecretLock
+	for i := range secretVersionLocks.Locks {
+		if secretVersionLocks.Locks[i].Name != nil && *secretVersionLocks.Locks[i].Name == lockName {
+			lock = &secretVersionLocks.Locks[i]
+			break
+		}
+	}
+	if lock == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("secret_id", secretId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	// Keep the configured alias, it resolves to resolved_version_id
+	if _, ok := d.GetOk("version_id"); !ok {
+		if err = d.Set("version_id", versionId); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
+		}
+	}
+	if err = d.Set("resolved_version_id", versionId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resolved_version_id: %s", err))
+	}
+	if err = d.Set("name", lock.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("description", lock.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+	if lock.Attributes != nil {
+		if err = d.Set("attributes", flex.Flatten(lock.Attributes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting attributes: %s", err))
+		}
+	}
+	if err = d.Set("secret_version_alias", lock.SecretVersionAlias); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_version_alias: %s", err))
+	}
+	if err = d.Set("secret_group_id", lock.SecretGroupID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_group_id: %s", err))
+	}
+	if err = d.Set("created_by", lock.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(lock.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("updated_at", flex.DateTimeToString(lock.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmSecretLockDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	versionId := id[3]
+	lockName := id[4]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	if err = resourceIbmSmSecretLockDeleteLock(context, secretsManagerClient, secretId, versionId, lockName); err != nil {
+		return diag.FromErr(err)
+	}
+	if previousVersionId := d.Get("previous_version_id").(string); previousVersionId != "" {
+		if err = resourceIbmSmSecretLockDeleteLock(context, secretsManagerClient, secretId, previousVersionId, lockName); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIbmSmSecretLockResolveVersionID returns the ID of a secret version, or of the version
+// that the `current` or `previous` alias refers to.
+func resourceIbmSmSecretLockResolveVersionID(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string, versionId string) (string, error) {
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID(versionId)
+
+	secretVersionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		return "", fmt.Errorf("GetSecretVersionMetadataWithContext failed for the %s version %s\n%s", versionId, err, response)
+	}
+	secretVersionMetadata, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionMetadataIntf)
+	if err != nil {
+		return "", err
+	}
+	return *secretVersionMetadata.ID, nil
+}
+
+// resourceIbmSmSecretLockDeleteLock deletes a lock from a secret version. A lock or version that
+// no longer exists is not an error.
+func resourceIbmSmSecretLockDeleteLock(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string, versionId string, lockName string) error {
+	deleteSecretVersionLocksBulkOptions := &secretsmanagerv2.DeleteSecretVersionLocksBulkOptions{}
+
+	deleteSecretVersionLocksBulkOptions.SetSecretID(secretId)
+	deleteSecretVersionLocksBulkOptions.SetID(versionId)
+	deleteSecretVersionLocksBulkOptions.SetName([]string{lockName})
+
+	_, response, err := secretsManagerClient.DeleteSecretVersionLocksBulkWithContext(context, deleteSecretVersionLocksBulkOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
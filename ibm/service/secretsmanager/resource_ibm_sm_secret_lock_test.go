@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretLockBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretLockConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_secret_lock.sm_secret_lock", "name", "terraform-test-lock"),
+					resource.TestCheckResourceAttr("ibm_sm_secret_lock.sm_secret_lock", "secret_version_alias", "current"),
+					resource.TestCheckResourceAttr("ibm_sm_secret_lock.sm_secret_lock", "attributes.app", "terraform"),
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_lock.sm_secret_lock", "resolved_version_id"),
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_lock.sm_secret_lock", "created_at"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_sm_secret_lock.sm_secret_lock",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretLockConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-secret-lock"
+			payload = "secret-credentials"
+		}
+
+		resource "ibm_sm_secret_lock" "sm_secret_lock" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+			name = "terraform-test-lock"
+			description = "Lock used by the terraform tests"
+			attributes = {
+				app = "terraform"
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
+
+func TestAccIbmSmSecretLockPreviousVersion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretLockConfigPreviousVersion(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ibm_sm_secret_lock.sm_secret_lock", "resolved_version_id", "ibm_sm_secret_rotation.sm_secret_rotation", "version_id"),
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_lock.sm_secret_lock", "previous_version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretLockConfigPreviousVersion() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-secret-lock-previous"
+			payload = "secret-credentials"
+			lifecycle {
+				ignore_changes = [payload]
+			}
+		}
+
+		resource "ibm_sm_secret_rotation" "sm_secret_rotation" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+			payload = "new-secret-credentials"
+		}
+
+		resource "ibm_sm_secret_lock" "sm_secret_lock" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_secret_rotation.sm_secret_rotation.secret_id
+			name = "terraform-test-lock-previous"
+			lock_previous_version = true
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
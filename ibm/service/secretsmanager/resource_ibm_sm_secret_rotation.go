@@ -0,0 +1,407 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// ResourceIbmSmSecretRotation rotates a secret of any type by creating a new version of it.
+// The secret is rotated again when the triggers change.
+func ResourceIbmSmSecretRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretRotationCreate,
+		ReadContext:   resourceIbmSmSecretRotationRead,
+		DeleteContext: resourceIbmSmSecretRotationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(35 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret.",
+			},
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, rotates the secret again.",
+			},
+			"payload": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The new payload of an `arbitrary` secret.",
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The new password of a `username_password` secret.",
+			},
+			"data_json": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validateJSONObject,
+				Description:  "The new data of a `kv` secret as a JSON object.",
+			},
+			"certificate": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The new PEM-encoded certificate of an `imported_cert` secret.",
+			},
+			"intermediate": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The new PEM-encoded intermediate certificate of an `imported_cert` secret.",
+			},
+			"private_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The new PEM-encoded private key of an `imported_cert` secret.",
+			},
+			"csr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The certificate signing request to use for the new version of a `private_cert` secret.",
+			},
+			"rotate_keys": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Generate a new private key for the new version of a `public_cert` secret.",
+			},
+			"version_custom_metadata": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The secret version metadata that a user can customize.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret version that the rotation created.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the secret version was created. The date format follows RFC 3339.",
+			},
+			"serial_number": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique serial number of the certificate of the new version, for `imported_cert`, `private_cert` and `public_cert` secrets.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmSecretRotationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+
+	getSecretMetadataOptions.SetID(secretId)
+
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("GetSecretMetadataWithContext", err, response)
+	}
+	secretMetadata, err := dataSourceIbmSmSecretsSecretMetadataToMap(secretMetadataIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretType := secretMetadata["secret_type"].(string)
+
+	secretVersionPrototype, err := resourceIbmSmSecretRotationMapToSecretVersionPrototype(d, secretType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A public certificate is ordered again, so the new version is recognized by its serial number once it is issued
+	var previousSerialNumber string
+	if secretType == secretsmanagerv2.Secret_SecretType_PublicCert {
+		previousSerialNumber, err = getIbmSmSecretCurrentSerialNumber(context, secretsManagerClient, secretId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+
+	createSecretVersionOptions.SetSecretID(secretId)
+	createSecretVersionOptions.SetSecretVersionPrototype(secretVersionPrototype)
+
+	secretVersionIntf, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("CreateSecretVersionWithContext", err, response)
+	}
+	secretVersion, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versionId := *secretVersion.ID
+	if secretType == secretsmanagerv2.Secret_SecretType_PublicCert {
+		currentVersionIntf, err := waitForIbmSmPublicCertificateRotation(context, secretsManagerClient, secretId, previousSerialNumber, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error waiting for the public certificate (%s) to be rotated: %s", secretId, err))
+		}
+		versionId = *currentVersionIntf.(*secretsmanagerv2.SecretVersion).ID
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instanceId, secretId, versionId))
+
+	if err = d.Set("secret_type", secretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+
+	return resourceIbmSmSecretRotationRead(context, d, meta)
+}
+
+func resourceIbmSmSecretRotationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	versionId := id[3]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID(versionId)
+
+	secretVersionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+	if err != nil {
+		// Old versions are removed after later rotations, which must not rotate the secret again
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return flex.ServiceErrorDiags("GetSecretVersionMetadataWithContext", err, response)
+	}
+	secretVersionMetadata, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionMetadataIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("secret_type", secretVersionMetadata.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	if err = d.Set("version_id", versionId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(secretVersionMetadata.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("serial_number", secretVersionMetadata.SerialNumber); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting serial_number: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmSecretRotationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The secret is not rolled back, the new version stays the current version.
+	d.SetId("")
+	return nil
+}
+
+// getIbmSmSecretCurrentSerialNumber returns the serial number of the certificate of the current version of a secret.
+func getIbmSmSecretCurrentSerialNumber(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string) (string, error) {
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID("current")
+
+	secretVersionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		return "", fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+	}
+	secretVersionMetadata, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionMetadataIntf)
+	if err != nil {
+		return "", err
+	}
+	if secretVersionMetadata.SerialNumber == nil {
+		return "", nil
+	}
+	return *secretVersionMetadata.SerialNumber, nil
+}
+
+// waitForIbmSmPublicCertificateRotation waits until the current version of a public certificate has a
+// certificate with another serial number, and returns that version. The wait fails if the order of the
+// certificate fails.
+func waitForIbmSmPublicCertificateRotation(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string, previousSerialNumber string, timeout time.Duration) (interface{}, error) {
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID("current")
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pre_activation"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response)
+			}
+			stateDescription, issuanceInfo := secretMetadataState(secretMetadataIntf)
+			if secretFailStates[stateDescription] {
+				return nil, "", fmt.Errorf("The secret %s is %s", secretId, stateDescription)
+			}
+			if issuanceInfo != nil && issuanceInfo.ErrorCode != nil {
+				return nil, "", fmt.Errorf("The order of the certificate failed: %s: %s", *issuanceInfo.ErrorCode, stringValue(issuanceInfo.ErrorMessage))
+			}
+
+			secretVersionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+			}
+			secretVersionMetadata, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionMetadataIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			if secretVersionMetadata.SerialNumber != nil && *secretVersionMetadata.SerialNumber != previousSerialNumber {
+				return secretVersionMetadata, "active", nil
+			}
+			return secretVersionMetadata, "pre_activation", nil
+		},
+		Timeout:    timeout,
+		Delay:      0 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func resourceIbmSmSecretRotationMapToSecretVersionPrototype(d *schema.ResourceData, secretType string) (secretsmanagerv2.SecretVersionPrototypeIntf, error) {
+	var versionCustomMetadata map[string]interface{}
+	if _, ok := d.GetOk("version_custom_metadata"); ok {
+		versionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	}
+
+	switch secretType {
+	case secretsmanagerv2.Secret_SecretType_Arbitrary:
+		if _, ok := d.GetOk("payload"); !ok {
+			return nil, fmt.Errorf("payload is required to rotate an %s secret", secretType)
+		}
+		return &secretsmanagerv2.ArbitrarySecretVersionPrototype{
+			Payload:               core.StringPtr(d.Get("payload").(string)),
+			VersionCustomMetadata: versionCustomMetadata,
+		}, nil
+	case secretsmanagerv2.Secret_SecretType_UsernamePassword:
+		if _, ok := d.GetOk("password"); !ok {
+			return nil, fmt.Errorf("password is required to rotate a %s secret", secretType)
+		}
+		return &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{
+			Password:              core.StringPtr(d.Get("password").(string)),
+			VersionCustomMetadata: versionCustomMetadata,
+		}, nil
+	case secretsmanagerv2.Secret_SecretType_Kv:
+		if _, ok := d.GetOk("data_json"); !ok {
+			return nil, fmt.Errorf("data_json is required to rotate a %s secret", secretType)
+		}
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("data_json").(string)), &data); err != nil {
+			return nil, fmt.Errorf("Error parsing data_json: %s", err)
+		}
+		return &secretsmanagerv2.KVSecretVersionPrototype{
+			Data:                  data,
+			VersionCustomMetadata: versionCustomMetadata,
+		}, nil
+	case secretsmanagerv2.Secret_SecretType_ImportedCert:
+		if _, ok := d.GetOk("certificate"); !ok {
+			return nil, fmt.Errorf("certificate is required to rotate an %s secret", secretType)
+		}
+		model := &secretsmanagerv2.ImportedCertificateVersionPrototype{
+			Certificate:           core.StringPtr(d.Get("certificate").(string)),
+			VersionCustomMetadata: versionCustomMetadata,
+		}
+		if _, ok := d.GetOk("intermediate"); ok {
+			model.Intermediate = core.StringPtr(d.Get("intermediate").(string))
+		}
+		if _, ok := d.GetOk("private_key"); ok {
+			model.PrivateKey = core.StringPtr(d.Get("private_key").(string))
+		}
+		return model, nil
+	case secretsmanagerv2.Secret_SecretType_IamCredentials:
+		return &secretsmanagerv2.IAMCredentialsSecretVersionPrototype{
+			VersionCustomMetadata: versionCustomMetadata,
+		}, nil
+	case secretsmanagerv2.Secret_SecretType_PrivateCert:
+		model := &secretsmanagerv2.PrivateCertificateVersionPrototype{
+			VersionCustomMetadata: versionCustomMetadata,
+		}
+		if _, ok := d.GetOk("csr"); ok {
+			model.Csr = core.StringPtr(d.Get("csr").(string))
+		}
+		return model, nil
+	case secretsmanagerv2.Secret_SecretType_PublicCert:
+		return &secretsmanagerv2.PublicCertificateVersionPrototype{
+			Rotation: &secretsmanagerv2.PublicCertificateRotationObject{
+				RotateKeys: core.BoolPtr(d.Get("rotate_keys").(bool)),
+			},
+			VersionCustomMetadata: versionCustomMetadata,
+		}, nil
+	}
+	return nil, fmt.Errorf("Secrets of type %s can not be rotated", secretType)
+}
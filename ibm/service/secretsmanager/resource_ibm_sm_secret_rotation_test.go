@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretRotationBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretRotationConfigBasic("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_secret_rotation.sm_secret_rotation", "secret_type", "username_password"),
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_rotation.sm_secret_rotation", "version_id"),
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_rotation.sm_secret_rotation", "created_at"),
+				),
+			},
+			// Changing the triggers rotates the secret again
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretRotationConfigBasic("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_secret_rotation.sm_secret_rotation", "triggers.build", "2"),
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_rotation.sm_secret_rotation", "version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretRotationConfigBasic(build string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-secret-rotation"
+			username = "username"
+			password = "password"
+			lifecycle {
+				ignore_changes = [password]
+			}
+		}
+
+		resource "ibm_sm_secret_rotation" "sm_secret_rotation" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_username_password_secret.sm_username_password_secret.secret_id
+			password = "rotated-password-%s"
+			triggers = {
+				build = "%s"
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, build, build)
+}
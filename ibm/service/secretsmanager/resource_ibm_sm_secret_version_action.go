@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+const (
+	smSecretVersionActionDeleteCredentials = "delete_credentials"
+	smSecretVersionActionRevokeCertificate = "revoke_certificate"
+	smSecretVersionActionRevert            = "revert"
+)
+
+// ResourceIbmSmSecretVersionAction runs an action on a version of a secret: deleting the
+// credentials of an IAM credentials secret version, reverting an IAM credentials secret to
+// the version, or revoking a private certificate version.
+func ResourceIbmSmSecretVersionAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretVersionActionCreate,
+		ReadContext:   resourceIbmSmSecretVersionActionRead,
+		DeleteContext: resourceIbmSmSecretVersionActionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret version, or the `current` or `previous` alias.",
+			},
+			"action": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_sm_secret_version_action", "action"),
+				Description:  "The action to run on the secret version.",
+			},
+			"resolved_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret version that version_id referred to when the action ran.",
+			},
+			"payload_available": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the payload of the secret version is available.",
+			},
+			"created_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret version that the revert action created.",
+			},
+			"revocation_time_seconds": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The timestamp of the certificate revocation.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func ResourceIbmSmSecretVersionActionValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "action",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s, %s", smSecretVersionActionDeleteCredentials, smSecretVersionActionRevert, smSecretVersionActionRevokeCertificate),
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_sm_secret_version_action", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmSmSecretVersionActionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	action := d.Get("action").(string)
+
+	// Resolve the current and previous aliases, they move to other versions after a rotation
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID(d.Get("version_id").(string))
+
+	secretVersionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("GetSecretVersionMetadataWithContext", err, response)
+	}
+	secretVersionMetadata, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionMetadataIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	versionId := *secretVersionMetadata.ID
+
+	switch action {
+	case smSecretVersionActionDeleteCredentials:
+		deleteSecretVersionDataOptions := &secretsmanagerv2.DeleteSecretVersionDataOptions{}
+
+		deleteSecretVersionDataOptions.SetSecretID(secretId)
+		deleteSecretVersionDataOptions.SetID(versionId)
+
+		response, err := secretsManagerClient.DeleteSecretVersionDataWithContext(context, deleteSecretVersionDataOptions)
+		if err != nil {
+			return flex.ServiceErrorDiags("DeleteSecretVersionDataWithContext", err, response)
+		}
+	case smSecretVersionActionRevert:
+		// The revert creates a new current version with the credentials of the version
+		createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+
+		createSecretVersionOptions.SetSecretID(secretId)
+		createSecretVersionOptions.SetSecretVersionPrototype(&secretsmanagerv2.IAMCredentialsSecretRestoreFromVersionPrototype{
+			RestoreFromVersion: core.StringPtr(versionId),
+		})
+
+		secretVersionIntf, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+		if err != nil {
+			return flex.ServiceErrorDiags("CreateSecretVersionWithContext", err, response)
+		}
+		secretVersion, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionIntf)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("created_version_id", secretVersion.ID)
+	case smSecretVersionActionRevokeCertificate:
+		createSecretVersionActionOptions := &secretsmanagerv2.CreateSecretVersionActionOptions{}
+
+		createSecretVersionActionOptions.SetSecretID(secretId)
+		createSecretVersionActionOptions.SetID(versionId)
+		createSecretVersionActionOptions.SetSecretVersionActionPrototype(&secretsmanagerv2.PrivateCertificateVersionActionRevokePrototype{
+			ActionType: core.StringPtr(secretsmanagerv2.SecretVersionActionPrototype_ActionType_PrivateCertActionRevokeCertificate),
+		})
+
+		versionActionIntf, response, err := secretsManagerClient.CreateSecretVersionActionWithContext(context, createSecretVersionActionOptions)
+		if err != nil {
+			return flex.ServiceErrorDiags("CreateSecretVersionActionWithContext", err, response)
+		}
+		if versionAction, ok := versionActionIntf.(*secretsmanagerv2.PrivateCertificateVersionActionRevoke); ok {
+			d.Set("revocation_time_seconds", flex.IntValue(versionAction.RevocationTimeSeconds))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s", region, instanceId, secretId, versionId, action))
+
+	if action == smSecretVersionActionDeleteCredentials {
+		_, err = waitForIbmSmSecretVersionDataDelete(context, secretsManagerClient, d)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for the credentials of the secret version (%s) to be deleted: %s", versionId, err))
+		}
+	}
+
+	return resourceIbmSmSecretVersionActionRead(context, d, meta)
+}
+
+func waitForIbmSmSecretVersionDataDelete(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+	id := strings.Split(d.Id(), "/")
+
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+
+	getSecretVersionMetadataOptions.SetSecretID(id[2])
+	getSecretVersionMetadataOptions.SetID(id[3])
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"available"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			secretVersionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetSecretVersionMetadata failed %s\n%s", err, response)
+			}
+			secretVersionMetadata, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionMetadataIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			if secretVersionMetadata.PayloadAvailable != nil && *secretVersionMetadata.PayloadAvailable {
+				return secretVersionMetadata, "available", nil
+			}
+			return secretVersionMetadata, "deleted", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func resourceIbmSmSecretVersionActionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	versionId := id[3]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID(versionId)
+
+	secretVersionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return flex.ServiceErrorDiags("GetSecretVersionMetadataWithContext", err, response)
+	}
+	secretVersionMetadata, err := dataSourceIbmSmSecretVersionToSecretVersion(secretVersionMetadataIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("resolved_version_id", versionId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resolved_version_id: %s", err))
+	}
+	if err = d.Set("payload_available", secretVersionMetadata.PayloadAvailable); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting payload_available: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmSecretVersionActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The action can not be undone.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretVersionActionDeleteCredentials(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionActionConfigDeleteCredentials(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_version_action.sm_delete_credentials", "resolved_version_id"),
+					resource.TestCheckResourceAttr("ibm_sm_secret_version_action.sm_delete_credentials", "payload_available", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretVersionActionConfigDeleteCredentials() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_iam_credentials_secret" "sm_iam_credentials_secret" {
+			instance_id   = "%s"
+			region        = "%s"
+			service_id = "%s"
+			ttl = "1800"
+			name = "terraform-test-version-action"
+			reuse_api_key = true
+		}
+
+		resource "ibm_sm_secret_version_action" "sm_delete_credentials" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_iam_credentials_secret.sm_iam_credentials_secret.secret_id
+			version_id = "current"
+			action = "delete_credentials"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerIamCredentialsSecretServiceId,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -28,6 +28,17 @@ func TestAccIbmSmUsernamePasswordSecretBasic(t *testing.T) {
 				Config: testAccCheckIbmSmUsernamePasswordSecretConfigBasic(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIbmSmUsernamePasswordSecretExists("ibm_sm_username_password_secret.sm_username_password_secret", conf),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.sm_username_password_secret", "rotation.0.interval", "1"),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.sm_username_password_secret", "rotation.0.unit", "day"),
+					resource.TestCheckResourceAttrSet("ibm_sm_username_password_secret.sm_username_password_secret", "next_rotation_date"),
+				),
+			},
+			// The rotation policy is updated with the secret metadata
+			resource.TestStep{
+				Config: testAccCheckIbmSmUsernamePasswordSecretConfigRotation(2, "month"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.sm_username_password_secret", "rotation.0.interval", "2"),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.sm_username_password_secret", "rotation.0.unit", "month"),
 				),
 			},
 			resource.TestStep{
@@ -39,6 +50,26 @@ func TestAccIbmSmUsernamePasswordSecretBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmUsernamePasswordSecretExpiration(t *testing.T) {
+	var conf secretsmanagerv2.UsernamePasswordSecret
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmUsernamePasswordSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmUsernamePasswordSecretConfigExpiration("2030-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmUsernamePasswordSecretExists("ibm_sm_username_password_secret.sm_username_password_secret", conf),
+					resource.TestCheckResourceAttrSet("ibm_sm_username_password_secret.sm_username_password_secret", "expiration_date"),
+					resource.TestCheckResourceAttrSet("ibm_sm_username_password_secret.sm_username_password_secret", "days_until_expiry"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSmUsernamePasswordSecretConfigBasic() string {
 	return fmt.Sprintf(`
 
@@ -61,6 +92,43 @@ func testAccCheckIbmSmUsernamePasswordSecretConfigBasic() string {
 	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
 }
 
+func testAccCheckIbmSmUsernamePasswordSecretConfigRotation(interval int, unit string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			instance_id   = "%s"
+			region        = "%s"
+			custom_metadata = {"key":"value"}
+			description = "Extended description for this secret."
+			labels = ["my-label"]
+			rotation {
+				auto_rotate = true
+				interval = %d
+				unit = "%s"
+			}
+			secret_group_id = "default"
+			username = "username"
+			password = "password"
+			name = "username_password-datasource-terraform-test"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, interval, unit)
+}
+
+func testAccCheckIbmSmUsernamePasswordSecretConfigExpiration(expirationDate string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			instance_id     = "%s"
+			region          = "%s"
+			secret_group_id = "default"
+			username        = "username"
+			password        = "password"
+			expiration_date = "%s"
+			name            = "username_password-expiration-terraform-test"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, expirationDate)
+}
+
 func testAccCheckIbmSmUsernamePasswordSecretExists(n string, obj secretsmanagerv2.UsernamePasswordSecret) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
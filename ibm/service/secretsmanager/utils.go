@@ -1,12 +1,27 @@
 package secretsmanager
 
 import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"math/rand"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
@@ -51,39 +66,85 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	} else {
 		endpoint = fmt.Sprintf("https://%s.%s.secrets-manager.%s/api", instanceId, region, domain)
 	}
+	// The secrets_manager endpoint of the provider is a template of the instance endpoints
+	if template := originalClient.Service.GetServiceURL(); strings.Contains(template, "{instance_id}") {
+		endpoint = strings.NewReplacer("{instance_id}", instanceId, "{region}", region).Replace(template)
+	}
+
+	key := instanceClientKey{originalClient: originalClient, endpoint: endpoint}
+	instanceClients.lock.Lock()
+	defer instanceClients.lock.Unlock()
+	if client, ok := instanceClients.store[key]; ok {
+		return client
+	}
 
 	// clone the client and set endpoint
 	newClient := &secretsmanagerv2.SecretsManagerV2{
 		Service: originalClient.Service.Clone(),
 	}
 	newClient.Service.SetServiceURL(endpoint)
+	instanceClients.store[key] = newClient
 	return newClient
 }
 
+// instanceClientKey identifies a client of an instance endpoint. The endpoint is built from the
+// instance, the region and the endpoint type, and the provider client keeps the clients of
+// providers that are configured with different credentials apart.
+type instanceClientKey struct {
+	originalClient *secretsmanagerv2.SecretsManagerV2
+	endpoint       string
+}
+
+// instanceClients caches the clients of instance endpoints, so that the resources of an
+// instance share one client instead of cloning the provider client on every call.
+var instanceClients = struct {
+	lock  sync.Mutex
+	store map[instanceClientKey]*secretsmanagerv2.SecretsManagerV2
+}{
+	store: make(map[instanceClientKey]*secretsmanagerv2.SecretsManagerV2),
+}
+
 // Add the fields needed for building the instance endpoint to the given schema
 func AddInstanceFields(resource *schema.Resource) *schema.Resource {
-	resource.Schema["instance_id"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
-		Description: "The ID of the Secrets Manager instance.",
+	// Data sources that can find the instance by themselves define their own instance_id
+	if _, ok := resource.Schema["instance_id"]; !ok {
+		resource.Schema["instance_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The ID of the Secrets Manager instance.",
+		}
 	}
 	resource.Schema["region"] = &schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		Computed:    true,
 		ForceNew:    true,
-		Description: "The region of the Secrets Manager instance.",
+		Description: "The region of the Secrets Manager instance. By default, the region of the provider configuration is used.",
 	}
+	// Resources that can't be updated are recreated when the endpoint type changes
 	resource.Schema["endpoint_type"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "public or private.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     resource.CreateContext != nil && resource.UpdateContext == nil,
+		ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+		Description:  "public or private. By default, the endpoint type of the provider configuration is used.",
 	}
 
 	return resource
 }
 
+// withIDStateUpgrader adds the instance fields to a resource, and upgrades the ID of its states to
+// <region>/<instance_id>/<attributes...>. The next changes of the format of the ID are added as
+// state migrations, so that the states of the previous formats keep working.
+func withIDStateUpgrader(resource *schema.Resource, attributes ...string) *schema.Resource {
+	AddInstanceFields(resource)
+	idAttributes := append([]string{"region", "instance_id"}, attributes...)
+	return flex.WithStateMigrations(resource,
+		flex.StateMigration{Resource: resource, Upgrade: flex.UpgradeCompositeID(idAttributes...)},
+	)
+}
+
 func StringIsIntBetween(min, max int) schema.SchemaValidateFunc {
 	return func(i interface{}, k string) (warnings []string, errors []error) {
 		vs, ok := i.(string)
@@ -107,3 +168,445 @@ func StringIsIntBetween(min, max int) schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+// parseSecretTTL returns the number of seconds of a TTL that is either an integer number of
+// seconds or a duration such as "90m", "24h" or "90d".
+func parseSecretTTL(ttl string) (int, error) {
+	if seconds, err := strconv.Atoi(ttl); err == nil {
+		return seconds, nil
+	}
+	if strings.HasSuffix(ttl, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(ttl, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", ttl)
+		}
+		return days * 24 * 60 * 60, nil
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", ttl)
+	}
+	if duration%time.Second != 0 {
+		return 0, fmt.Errorf("duration %q is not a whole number of seconds", ttl)
+	}
+	return int(duration / time.Second), nil
+}
+
+// StringIsDurationBetween validates a TTL that parseSecretTTL accepts and that is between min
+// and max seconds.
+func StringIsDurationBetween(min, max int) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		vs, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return warnings, errors
+		}
+
+		v, err := parseSecretTTL(vs)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("expected %s to be a number of seconds or a duration such as 90m, 24h or 90d: %s", k, err))
+			return warnings, errors
+		}
+
+		if v < min || v > max {
+			errors = append(errors, fmt.Errorf("expected %s to be in the range (%d - %d) seconds, got %d", k, min, max, v))
+			return warnings, errors
+		}
+
+		return warnings, errors
+	}
+}
+
+func suppressEquivalentSecretTTL(k, old, new string, d *schema.ResourceData) bool {
+	oldSeconds, err := parseSecretTTL(old)
+	if err != nil {
+		return false
+	}
+	newSeconds, err := parseSecretTTL(new)
+	if err != nil {
+		return false
+	}
+	return oldSeconds == newSeconds
+}
+
+// normalizeSecretTTL returns a TTL as the number of seconds that the API expects.
+func normalizeSecretTTL(ttl string) string {
+	seconds, err := parseSecretTTL(ttl)
+	if err != nil {
+		return ttl
+	}
+	return strconv.Itoa(seconds)
+}
+
+var (
+	certificateHostNameRegexp     = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9_]([a-zA-Z0-9-_]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	certificateEmailAddressRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+$`)
+)
+
+// validateCertificateAltName validates that a subject alternative name is a host name, which can
+// start with a wildcard, or an email address.
+func validateCertificateAltName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	if len(v) > 253 || !(certificateHostNameRegexp.MatchString(v) || certificateEmailAddressRegexp.MatchString(v)) {
+		errors = append(errors, fmt.Errorf("expected %s to be a host name, such as example.com or *.example.com, or an email address, got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+// suppressEquivalentDateTime ignores the difference between an RFC 3339 date in the
+// configuration and the same date as it is returned by the API, for example with milliseconds.
+func suppressEquivalentDateTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// importSecretByCrn lets secrets be imported by their CRN, in addition to the
+// `<region>/<instance_id>/<secret_id>` ID. The CRN is converted to that ID.
+func importSecretByCrn(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "crn:") {
+		id, err := secretIdFromCrn(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// secretIdFromCrn converts a secret CRN, like
+// `crn:v1:bluemix:public:secrets-manager:<region>:a/<account_id>:<instance_id>:secret:<secret_id>`,
+// to the `<region>/<instance_id>/<secret_id>` ID of the secret resources.
+func secretIdFromCrn(crn string) (string, error) {
+	parts := strings.Split(crn, ":")
+	if len(parts) != 10 || parts[4] != "secrets-manager" || parts[8] != "secret" || parts[5] == "" || parts[7] == "" || parts[9] == "" {
+		return "", fmt.Errorf("Invalid secret CRN %q, the expected format is crn:v1:bluemix:public:secrets-manager:<region>:a/<account_id>:<instance_id>:secret:<secret_id>", crn)
+	}
+	return fmt.Sprintf("%s/%s/%s", parts[5], parts[7], parts[9]), nil
+}
+
+// deleteSecretLocks removes the locks from all the versions of a secret, a secret with
+// locks can not be deleted.
+func deleteSecretLocks(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string) error {
+	listSecretLocksOptions := &secretsmanagerv2.ListSecretLocksOptions{}
+
+	listSecretLocksOptions.SetID(secretId)
+
+	pager, err := secretsManagerClient.NewSecretLocksPager(listSecretLocksOptions)
+	if err != nil {
+		return err
+	}
+
+	allItems, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] SecretLocksPager.GetAll() failed %s", err)
+		return fmt.Errorf("SecretLocksPager.GetAll() failed %s", err)
+	}
+
+	lockNamesByVersion := make(map[string][]string)
+	for _, lock := range allItems {
+		lockNamesByVersion[*lock.SecretVersionID] = append(lockNamesByVersion[*lock.SecretVersionID], *lock.Name)
+	}
+
+	for versionId, lockNames := range lockNamesByVersion {
+		deleteSecretVersionLocksBulkOptions := &secretsmanagerv2.DeleteSecretVersionLocksBulkOptions{}
+
+		deleteSecretVersionLocksBulkOptions.SetSecretID(secretId)
+		deleteSecretVersionLocksBulkOptions.SetID(versionId)
+		deleteSecretVersionLocksBulkOptions.SetName(lockNames)
+
+		_, response, err := secretsManagerClient.DeleteSecretVersionLocksBulkWithContext(context, deleteSecretVersionLocksBulkOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+			return fmt.Errorf("DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+		}
+	}
+
+	return nil
+}
+
+// secretFailStates are the states in which a secret that is being created can no longer become active.
+var secretFailStates = map[string]bool{"deactivated": true, "destroyed": true}
+
+// waitForIbmSmSecretCreate waits until the secret of the resource becomes active.
+func waitForIbmSmSecretCreate(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+	return newIbmSmSecretCreateStateConf(context, secretsManagerClient, d, d.Timeout(schema.TimeoutCreate)).WaitForStateContext(context)
+}
+
+// newIbmSmSecretCreateStateConf returns a waiter that polls the metadata of the secret of the resource
+// until it is active. The wait fails if the secret is deactivated or destroyed, with the issuance error
+// of the certificate if there is one.
+func newIbmSmSecretCreateStateConf(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData, timeout time.Duration) *resource.StateChangeConf {
+	id := strings.Split(d.Id(), "/")
+	secretId := id[2]
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+
+	return &resource.StateChangeConf{
+		Pending: []string{"pre_activation"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nil, "", fmt.Errorf("The secret %s does not exist anymore: %s\n%s", secretId, err, response)
+				}
+				return nil, "", fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response)
+			}
+			stateDescription, issuanceInfo := secretMetadataState(secretMetadataIntf)
+			if secretFailStates[stateDescription] {
+				if issuanceInfo != nil && issuanceInfo.ErrorCode != nil {
+					return secretMetadataIntf, stateDescription, fmt.Errorf("The secret %s is %s: %s: %s", secretId, stateDescription, *issuanceInfo.ErrorCode, stringValue(issuanceInfo.ErrorMessage))
+				}
+				return secretMetadataIntf, stateDescription, fmt.Errorf("The secret %s is %s", secretId, stateDescription)
+			}
+			return secretMetadataIntf, stateDescription, nil
+		},
+		Timeout:    timeout,
+		Delay:      0 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+}
+
+// secretMetadataState returns the state of a secret and, for public certificates, their issuance info.
+func secretMetadataState(secretMetadataIntf secretsmanagerv2.SecretMetadataIntf) (string, *secretsmanagerv2.CertificateIssuanceInfo) {
+	var stateDescription *string
+	var issuanceInfo *secretsmanagerv2.CertificateIssuanceInfo
+	switch secretMetadata := secretMetadataIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecretMetadata:
+		stateDescription = secretMetadata.StateDescription
+	case *secretsmanagerv2.IAMCredentialsSecretMetadata:
+		stateDescription = secretMetadata.StateDescription
+	case *secretsmanagerv2.ImportedCertificateMetadata:
+		stateDescription = secretMetadata.StateDescription
+	case *secretsmanagerv2.KVSecretMetadata:
+		stateDescription = secretMetadata.StateDescription
+	case *secretsmanagerv2.PrivateCertificateMetadata:
+		stateDescription = secretMetadata.StateDescription
+	case *secretsmanagerv2.PublicCertificateMetadata:
+		stateDescription = secretMetadata.StateDescription
+		issuanceInfo = secretMetadata.IssuanceInfo
+	case *secretsmanagerv2.UsernamePasswordSecretMetadata:
+		stateDescription = secretMetadata.StateDescription
+	case *secretsmanagerv2.SecretMetadata:
+		stateDescription = secretMetadata.StateDescription
+		issuanceInfo = secretMetadata.IssuanceInfo
+	}
+	return stringValue(stateDescription), issuanceInfo
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// withPollingJitter delays every refresh of a waiter by a random duration of up to a fifth of the
+// polling interval, so that the waiters of resources that are created together don't poll at the same time.
+func withPollingJitter(context context.Context, pollInterval time.Duration, refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		select {
+		case <-context.Done():
+		case <-time.After(time.Duration(rand.Int63n(int64(pollInterval)/5 + 1))):
+		}
+		return refresh()
+	}
+}
+
+// isWaitTimeout reports whether a waiter stopped because its timeout or the deadline of the operation expired.
+func isWaitTimeout(ctx context.Context, err error) bool {
+	if _, ok := err.(*resource.TimeoutError); ok {
+		return true
+	}
+	return ctx.Err() == context.DeadlineExceeded
+}
+
+// getSecretCustomMetadata returns the custom metadata that is configured either with a map argument,
+// such as custom_metadata, or as a JSON object with the matching _json argument.
+func getSecretCustomMetadata(d *schema.ResourceData, key string) (map[string]interface{}, error) {
+	if metadataJson, ok := d.GetOk(key + "_json"); ok {
+		return parseSecretCustomMetadataJSON(key, metadataJson.(string))
+	}
+	if metadata, ok := d.GetOk(key); ok {
+		return metadata.(map[string]interface{}), nil
+	}
+	return nil, nil
+}
+
+// getSecretCustomMetadataPatch returns the changed custom metadata. The keys that are removed from
+// the metadata are set to null, as the API merges the patch.
+func getSecretCustomMetadataPatch(d *schema.ResourceData, key string) (map[string]interface{}, error) {
+	patch := map[string]interface{}{}
+	oldMetadata, _ := d.GetChange(key)
+	for k := range oldMetadata.(map[string]interface{}) {
+		patch[k] = nil
+	}
+	oldMetadataJson, _ := d.GetChange(key + "_json")
+	if oldMetadataJson.(string) != "" {
+		oldMetadata, err := parseSecretCustomMetadataJSON(key, oldMetadataJson.(string))
+		if err != nil {
+			return nil, err
+		}
+		for k := range oldMetadata {
+			patch[k] = nil
+		}
+	}
+
+	newMetadata, err := getSecretCustomMetadata(d, key)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range newMetadata {
+		patch[k] = v
+	}
+	return patch, nil
+}
+
+// setSecretCustomMetadata sets the custom metadata that is read from the API. Metadata with values that
+// are not strings can only be represented as JSON, so it is set to the _json argument.
+func setSecretCustomMetadata(d *schema.ResourceData, key string, metadata map[string]interface{}) error {
+	if metadata == nil {
+		return nil
+	}
+	if _, ok := d.GetOk(key + "_json"); ok || !isStringMap(metadata) {
+		metadataJson, err := json.Marshal(metadata)
+		if err != nil {
+			return fmt.Errorf("Error marshalling %s_json: %s", key, err)
+		}
+		if err = d.Set(key+"_json", string(metadataJson)); err != nil {
+			return fmt.Errorf("Error setting %s_json: %s", key, err)
+		}
+		return nil
+	}
+	if err := d.Set(key, metadata); err != nil {
+		return fmt.Errorf("Error setting %s: %s", key, err)
+	}
+	return nil
+}
+
+func parseSecretCustomMetadataJSON(key string, metadataJson string) (map[string]interface{}, error) {
+	var metadata map[string]interface{}
+	if err := json.Unmarshal([]byte(metadataJson), &metadata); err != nil {
+		return nil, fmt.Errorf("Error parsing %s_json: %s", key, err)
+	}
+	return metadata, nil
+}
+
+func validateJSONObject(v interface{}, k string) (ws []string, errors []error) {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &data); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
+
+func isStringMap(data map[string]interface{}) bool {
+	for _, v := range data {
+		if _, ok := v.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// updateSecretVersionCustomMetadata updates the custom metadata of the current version of a secret.
+func updateSecretVersionCustomMetadata(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string, d *schema.ResourceData) error {
+	versionCustomMetadata, err := getSecretCustomMetadataPatch(d, "version_custom_metadata")
+	if err != nil {
+		return err
+	}
+
+	updateSecretVersionMetadataOptions := &secretsmanagerv2.UpdateSecretVersionMetadataOptions{}
+
+	updateSecretVersionMetadataOptions.SetSecretID(secretId)
+	updateSecretVersionMetadataOptions.SetID("current")
+	updateSecretVersionMetadataOptions.SetSecretVersionMetadataPatch(map[string]interface{}{
+		"version_custom_metadata": versionCustomMetadata,
+	})
+
+	_, response, err := secretsManagerClient.UpdateSecretVersionMetadataWithContext(context, updateSecretVersionMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// setCertificateAttributes sets the attributes that are parsed from the PEM-encoded certificate,
+// such as the fingerprints and the subject. They are left unset when there is no certificate yet.
+func setCertificateAttributes(d *schema.ResourceData, certificate *string) error {
+	if certificate == nil || *certificate == "" {
+		return nil
+	}
+	block, _ := pem.Decode([]byte(*certificate))
+	if block == nil {
+		log.Printf("[WARN] The certificate of %s is not PEM-encoded", d.Id())
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		log.Printf("[WARN] Error parsing the certificate of %s: %s", d.Id(), err)
+		return nil
+	}
+
+	subjectAltNames := []string{}
+	subjectAltNames = append(subjectAltNames, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		subjectAltNames = append(subjectAltNames, ip.String())
+	}
+	subjectAltNames = append(subjectAltNames, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		subjectAltNames = append(subjectAltNames, uri.String())
+	}
+
+	sha1Fingerprint := sha1.Sum(cert.Raw)
+	sha256Fingerprint := sha256.Sum256(cert.Raw)
+
+	if err = d.Set("sha1_fingerprint", formatFingerprint(sha1Fingerprint[:])); err != nil {
+		return fmt.Errorf("Error setting sha1_fingerprint: %s", err)
+	}
+	if err = d.Set("sha256_fingerprint", formatFingerprint(sha256Fingerprint[:])); err != nil {
+		return fmt.Errorf("Error setting sha256_fingerprint: %s", err)
+	}
+	if err = d.Set("subject", cert.Subject.String()); err != nil {
+		return fmt.Errorf("Error setting subject: %s", err)
+	}
+	if err = d.Set("subject_alt_names", subjectAltNames); err != nil {
+		return fmt.Errorf("Error setting subject_alt_names: %s", err)
+	}
+	if err = d.Set("days_until_expiry", int(time.Until(cert.NotAfter).Hours()/24)); err != nil {
+		return fmt.Errorf("Error setting days_until_expiry: %s", err)
+	}
+	return nil
+}
+
+// formatFingerprint formats a fingerprint as colon-separated uppercase hexadecimal pairs.
+// daysUntilExpiry returns the number of whole days until an expiration date, or nil if there is none.
+func daysUntilExpiry(expirationDate *strfmt.DateTime) interface{} {
+	if expirationDate == nil {
+		return nil
+	}
+	return int(time.Until(time.Time(*expirationDate)).Hours() / 24)
+}
+
+func formatFingerprint(fingerprint []byte) string {
+	pairs := make([]string, len(fingerprint))
+	for i, b := range fingerprint {
+		pairs[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(pairs, ":")
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"testing"
+)
+
+func TestParseSecretTTL(t *testing.T) {
+	testCases := []struct {
+		ttl        string
+		seconds    int
+		normalized string
+		valid      bool
+	}{
+		{"7776000", 7776000, "7776000", true},
+		{"90d", 7776000, "7776000", true},
+		{"1.5h", 5400, "5400", true},
+		{"59s", 59, "59", true},
+		{"1500ms", 0, "1500ms", false},
+		{"abc", 0, "abc", false},
+		{"xd", 0, "xd", false},
+	}
+	for _, tc := range testCases {
+		seconds, err := parseSecretTTL(tc.ttl)
+		if tc.valid && err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.ttl, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%s: expected an error, got %d seconds", tc.ttl, seconds)
+		}
+		if seconds != tc.seconds {
+			t.Errorf("%s: expected %d seconds, got %d", tc.ttl, tc.seconds, seconds)
+		}
+		if got := normalizeSecretTTL(tc.ttl); got != tc.normalized {
+			t.Errorf("%s: expected %q once normalized, got %q", tc.ttl, tc.normalized, got)
+		}
+	}
+}
+
+func TestSuppressEquivalentSecretTTL(t *testing.T) {
+	testCases := []struct {
+		old, new string
+		expected bool
+	}{
+		{"7776000", "90d", true},
+		{"5400", "1.5h", true},
+		{"59", "59s", true},
+		{"60", "59s", false},
+		{"1500ms", "1500ms", false},
+		{"abc", "abc", false},
+		{"", "90d", false},
+	}
+	for _, tc := range testCases {
+		if got := suppressEquivalentSecretTTL("ttl", tc.old, tc.new, nil); got != tc.expected {
+			t.Errorf("%q to %q: expected %t, got %t", tc.old, tc.new, tc.expected, got)
+		}
+	}
+}
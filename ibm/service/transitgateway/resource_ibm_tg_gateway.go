@@ -221,7 +221,7 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
@@ -278,7 +278,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 
 	tgw, response, err := client.GetTransitGateway(tgOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -445,7 +445,7 @@ func resourceIBMTransitGatewayExists(d *schema.ResourceData, meta interface{}) (
 	}
 	_, response, err := client.GetTransitGateway(tgOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return false, nil
 		}
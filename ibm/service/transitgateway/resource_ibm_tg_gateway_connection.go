@@ -4,6 +4,7 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -39,6 +40,7 @@ const (
 	tgRemoteTunnelIp                    = "remote_tunnel_ip"
 	tgZone                              = "zone"
 	tgMtu                               = "mtu"
+	tgPrefixFiltersDefault              = "prefix_filters_default"
 )
 
 func ResourceIBMTransitGatewayConnection() *schema.Resource {
@@ -56,6 +58,8 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceIBMTransitGatewayConnectionValidateGreTunnel,
+
 		Schema: map[string]*schema.Schema{
 			tgGatewayId: {
 				Type:        schema.TypeString,
@@ -151,6 +155,23 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 				ForceNew:    true,
 				Description: "Location of GRE tunnel. This field only applies to network type 'gre_tunnel' and 'unbound_gre_tunnel' connections.",
 			},
+			tgLocalBgpAsn: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The local network BGP ASN. This field only applies to network type 'gre_tunnel' and 'unbound_gre_tunnel' connections.",
+			},
+			tgMtu: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "GRE tunnel MTU. This field only applies to network type 'gre_tunnel' and 'unbound_gre_tunnel' connections.",
+			},
+			tgPrefixFiltersDefault: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_tg_connection", tgPrefixFiltersDefault),
+				Description:  "Default setting of permit or deny which applies to any routes that don't match a specified filter. This field does not apply to network type 'gre_tunnel' and 'unbound_gre_tunnel' connections.",
+			},
 			tgCreatedAt: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -199,11 +220,46 @@ func ResourceIBMTransitGatewayConnectionValidator() *validate.ResourceValidator
 			Regexp:                     `^([a-zA-Z]|[a-zA-Z][-_a-zA-Z0-9]*[a-zA-Z0-9])$`,
 			MinValueLength:             1,
 			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 tgPrefixFiltersDefault,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "permit, deny"})
 
 	ibmTransitGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_tg_connection", Schema: validateSchema}
 
 	return &ibmTransitGatewayConnectionResourceValidator
 }
+
+// resourceIBMTransitGatewayConnectionValidateGreTunnel checks that a new GRE tunnel connection sets the
+// tunnel endpoints, the API rejects the connection otherwise
+func resourceIBMTransitGatewayConnectionValidateGreTunnel(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown(tgNetworkType) {
+		return nil
+	}
+	networkType := diff.Get(tgNetworkType).(string)
+	required := []string{tgLocalGatewayIp, tgLocalTunnelIp, tgRemoteGatewayIp, tgRemoteTunnelIp, tgZone}
+	switch networkType {
+	case "gre_tunnel":
+		required = append(required, tgBaseConnectionId)
+	case "unbound_gre_tunnel":
+		required = append(required, tgBaseNetworkType)
+	default:
+		return nil
+	}
+	for _, key := range required {
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		if _, ok := diff.GetOk(key); !ok {
+			return fmt.Errorf("[ERROR] %s is required for network type %s", key, networkType)
+		}
+	}
+	return nil
+}
+
 func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
@@ -264,6 +320,10 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 		zoneIdentity.Name = &zoneName
 		createTransitGatewayConnectionOptions.SetZone(zoneIdentity)
 	}
+	if _, ok := d.GetOk(tgPrefixFiltersDefault); ok {
+		prefixFiltersDefault := d.Get(tgPrefixFiltersDefault).(string)
+		createTransitGatewayConnectionOptions.SetPrefixFiltersDefault(prefixFiltersDefault)
+	}
 
 	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
 	if err != nil {
@@ -342,7 +402,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	getTransitGatewayConnectionOptions.SetID(ID)
 	instance, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -373,6 +433,39 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	if instance.RequestStatus != nil {
 		d.Set(tgRequestStatus, *instance.RequestStatus)
 	}
+	if instance.BaseConnectionID != nil {
+		d.Set(tgBaseConnectionId, *instance.BaseConnectionID)
+	}
+	if instance.BaseNetworkType != nil {
+		d.Set(tgBaseNetworkType, *instance.BaseNetworkType)
+	}
+	if instance.LocalBgpAsn != nil {
+		d.Set(tgLocalBgpAsn, *instance.LocalBgpAsn)
+	}
+	if instance.LocalGatewayIp != nil {
+		d.Set(tgLocalGatewayIp, *instance.LocalGatewayIp)
+	}
+	if instance.LocalTunnelIp != nil {
+		d.Set(tgLocalTunnelIp, *instance.LocalTunnelIp)
+	}
+	if instance.RemoteBgpAsn != nil {
+		d.Set(tgRemoteBgpAsn, *instance.RemoteBgpAsn)
+	}
+	if instance.RemoteGatewayIp != nil {
+		d.Set(tgRemoteGatewayIp, *instance.RemoteGatewayIp)
+	}
+	if instance.RemoteTunnelIp != nil {
+		d.Set(tgRemoteTunnelIp, *instance.RemoteTunnelIp)
+	}
+	if instance.Zone != nil && instance.Zone.Name != nil {
+		d.Set(tgZone, *instance.Zone.Name)
+	}
+	if instance.Mtu != nil {
+		d.Set(tgMtu, *instance.Mtu)
+	}
+	if instance.PrefixFiltersDefault != nil {
+		d.Set(tgPrefixFiltersDefault, *instance.PrefixFiltersDefault)
+	}
 	d.Set(tgConnectionId, *instance.ID)
 	d.Set(tgGatewayId, gatewayId)
 	getTransitGatewayOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
@@ -420,6 +513,12 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 			updateTransitGatewayConnectionOptions.Name = &name
 		}
 	}
+	if d.HasChange(tgPrefixFiltersDefault) {
+		if _, ok := d.GetOk(tgPrefixFiltersDefault); ok {
+			prefixFiltersDefault := d.Get(tgPrefixFiltersDefault).(string)
+			updateTransitGatewayConnectionOptions.PrefixFiltersDefault = &prefixFiltersDefault
+		}
+	}
 
 	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
 	if err != nil {
@@ -527,7 +626,7 @@ func resourceIBMTransitGatewayConnectionExists(d *schema.ResourceData, meta inte
 	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return false, nil
 		}
@@ -161,7 +161,7 @@ func resourceIBMTransitGatewayConnectionPrefixFilterRead(d *schema.ResourceData,
 	getTransitGatewayConnectionPrefixFilterOptionsModel.SetFilterID(filterId)
 	prefixFilter, response, err := client.GetTransitGatewayConnectionPrefixFilter(getTransitGatewayConnectionPrefixFilterOptionsModel)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -170,6 +170,7 @@ func resourceIBMTransitGatewayConnectionPrefixFilterRead(d *schema.ResourceData,
 
 	d.Set(tgPrefixFilterId, *prefixFilter.ID)
 	d.Set(tgCreatedAt, prefixFilter.CreatedAt.String())
+	d.Set(tgAction, prefixFilter.Action)
 	d.Set(tgPrefix, prefixFilter.Prefix)
 
 	if prefixFilter.UpdatedAt != nil {
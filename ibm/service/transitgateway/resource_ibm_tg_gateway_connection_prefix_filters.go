@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMTransitGatewayConnectionPrefixFilters manages the complete, ordered list of prefix
+// filters of a connection. Filters that are not in the configuration are removed on apply.
+func ResourceIBMTransitGatewayConnectionPrefixFilters() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMTransitGatewayConnectionPrefixFiltersCreate,
+		Read:     resourceIBMTransitGatewayConnectionPrefixFiltersRead,
+		Delete:   resourceIBMTransitGatewayConnectionPrefixFiltersDelete,
+		Update:   resourceIBMTransitGatewayConnectionPrefixFiltersUpdate,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			tgGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Transit Gateway identifier",
+			},
+			tgConnectionId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Transit Gateway Connection identifier",
+			},
+			tgPrefixFilters: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Ordered list of prefix filters. Filters are evaluated in the order of the list",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Transit Gateway Connection Prefix Filter identifier",
+						},
+						tgAction: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_tg_connection_prefix_filters", tgAction),
+							Description:  "Whether to permit or deny the prefix filter",
+						},
+						tgGe: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "IP Prefix GE",
+						},
+						tgLe: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "IP Prefix LE",
+						},
+						tgPrefix: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IP Prefix",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMTransitGatewayConnectionPrefixFiltersValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	actionValues := "permit, deny"
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 tgAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              actionValues})
+
+	ibmTransitGatewayConnectionPrefixFiltersResourceValidator := validate.ResourceValidator{ResourceName: "ibm_tg_connection_prefix_filters", Schema: validateSchema}
+
+	return &ibmTransitGatewayConnectionPrefixFiltersResourceValidator
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFiltersCreate(d *schema.ResourceData, meta interface{}) error {
+	gatewayId := d.Get(tgGatewayId).(string)
+	connectionId := d.Get(tgConnectionId).(string)
+
+	err := resourceIBMTransitGatewayConnectionPrefixFiltersReplace(gatewayId, connectionId, expandTransitGatewayConnectionPrefixFilters(d.Get(tgPrefixFilters).([]interface{})), meta)
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", gatewayId, connectionId))
+
+	return resourceIBMTransitGatewayConnectionPrefixFiltersRead(d, meta)
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFiltersRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) != 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of gatewayID/connectionID", d.Id())
+	}
+	gatewayId := parts[0]
+	connectionId := parts[1]
+
+	listPrefixFiltersOptions := &transitgatewayapisv1.ListTransitGatewayConnectionPrefixFiltersOptions{}
+	listPrefixFiltersOptions.SetTransitGatewayID(gatewayId)
+	listPrefixFiltersOptions.SetID(connectionId)
+	listPrefixFilters, response, err := client.ListTransitGatewayConnectionPrefixFilters(listPrefixFiltersOptions)
+	if err != nil {
+		if flex.IsResourceNotFound(response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error while listing transit gateway connection prefix filters (%s): %s\n%s", connectionId, err, response)
+	}
+
+	// The API returns the filters in evaluation order
+	prefixFilters := make([]map[string]interface{}, 0, len(listPrefixFilters.PrefixFilters))
+	for _, prefixFilter := range listPrefixFilters.PrefixFilters {
+		tgPrefixFilter := map[string]interface{}{}
+		tgPrefixFilter[tgID] = prefixFilter.ID
+		tgPrefixFilter[tgAction] = prefixFilter.Action
+		tgPrefixFilter[tgPrefix] = prefixFilter.Prefix
+		if prefixFilter.Ge != nil {
+			tgPrefixFilter[tgGe] = prefixFilter.Ge
+		}
+		if prefixFilter.Le != nil {
+			tgPrefixFilter[tgLe] = prefixFilter.Le
+		}
+		prefixFilters = append(prefixFilters, tgPrefixFilter)
+	}
+
+	d.Set(tgGatewayId, gatewayId)
+	d.Set(tgConnectionId, connectionId)
+	d.Set(tgPrefixFilters, prefixFilters)
+
+	return nil
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFiltersUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange(tgPrefixFilters) {
+		gatewayId := d.Get(tgGatewayId).(string)
+		connectionId := d.Get(tgConnectionId).(string)
+
+		err := resourceIBMTransitGatewayConnectionPrefixFiltersReplace(gatewayId, connectionId, expandTransitGatewayConnectionPrefixFilters(d.Get(tgPrefixFilters).([]interface{})), meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMTransitGatewayConnectionPrefixFiltersRead(d, meta)
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFiltersDelete(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) != 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of gatewayID/connectionID", d.Id())
+	}
+
+	err = resourceIBMTransitGatewayConnectionPrefixFiltersReplace(parts[0], parts[1], []transitgatewayapisv1.PrefixFilterPut{}, meta)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFiltersReplace(gatewayId, connectionId string, prefixFilters []transitgatewayapisv1.PrefixFilterPut, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+
+	replacePrefixFilterOptions := &transitgatewayapisv1.ReplaceTransitGatewayConnectionPrefixFilterOptions{}
+	replacePrefixFilterOptions.SetTransitGatewayID(gatewayId)
+	replacePrefixFilterOptions.SetID(connectionId)
+	replacePrefixFilterOptions.PrefixFilters = prefixFilters
+
+	_, response, err := client.ReplaceTransitGatewayConnectionPrefixFilter(replacePrefixFilterOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 && len(prefixFilters) == 0 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error replacing Transit Gateway Connection Prefix Filters (%s): %s\n%s", connectionId, err, response)
+	}
+	return nil
+}
+
+func expandTransitGatewayConnectionPrefixFilters(prefixFilters []interface{}) []transitgatewayapisv1.PrefixFilterPut {
+	result := make([]transitgatewayapisv1.PrefixFilterPut, 0, len(prefixFilters))
+	for _, v := range prefixFilters {
+		prefixFilter := v.(map[string]interface{})
+		action := prefixFilter[tgAction].(string)
+		prefix := prefixFilter[tgPrefix].(string)
+		prefixFilterPut := transitgatewayapisv1.PrefixFilterPut{
+			Action: &action,
+			Prefix: &prefix,
+		}
+		if ge, ok := prefixFilter[tgGe].(int); ok && ge != 0 {
+			ge64 := int64(ge)
+			prefixFilterPut.Ge = &ge64
+		}
+		if le, ok := prefixFilter[tgLe].(int); ok && le != 0 {
+			le64 := int64(le)
+			prefixFilterPut.Le = &le64
+		}
+		result = append(result, prefixFilterPut)
+	}
+	return result
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMTransitGatewayConnectionPrefixFilters_basic(t *testing.T) {
+	randNum := acctest.RandIntRange(10, 100)
+	gatewayName := fmt.Sprintf("gateway-name-%d", randNum)
+	location := fmt.Sprintf("us-south")
+	connectionName := fmt.Sprintf("connection-name-%d", randNum)
+	name := "ibm_tg_connection_prefix_filters.test_tg_prefix_filters"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMTransitGatewayConnectionPrefixFiltersDestroy,
+		Steps: []resource.TestStep{
+			// Create test case
+			{
+				Config: testAccCheckIBMTransitGatewayConnectionPrefixFiltersListConfig(gatewayName, location, connectionName, "deny", "10.0.0.0/16", "10.0.0.0/8"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_tg_connection", "prefix_filters_default", "deny"),
+					resource.TestCheckResourceAttr(name, "prefix_filters.#", "2"),
+					resource.TestCheckResourceAttr(name, "prefix_filters.0.prefix", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(name, "prefix_filters.1.prefix", "10.0.0.0/8"),
+					resource.TestCheckResourceAttrSet(name, "prefix_filters.0.id"),
+				),
+			},
+			// Reordering the list reorders the filters
+			{
+				Config: testAccCheckIBMTransitGatewayConnectionPrefixFiltersListConfig(gatewayName, location, connectionName, "permit", "10.0.0.0/8", "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_tg_connection", "prefix_filters_default", "permit"),
+					resource.TestCheckResourceAttr(name, "prefix_filters.#", "2"),
+					resource.TestCheckResourceAttr(name, "prefix_filters.0.prefix", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(name, "prefix_filters.1.prefix", "10.0.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	},
+	)
+}
+
+func testAccCheckIBMTransitGatewayConnectionPrefixFiltersListConfig(gatewayName, location, connectionName, defaultAction, firstPrefix, secondPrefix string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_tg_gateway" "test_tg_gateway" {
+		name="%s"
+		location="%s"
+		global=true
+	}
+
+	resource "ibm_tg_connection" "test_tg_connection"{
+		gateway = ibm_tg_gateway.test_tg_gateway.id
+		network_type = "classic"
+		name = "%s"
+		prefix_filters_default = "%s"
+	}
+
+	resource "ibm_tg_connection_prefix_filters" "test_tg_prefix_filters" {
+		gateway = ibm_tg_gateway.test_tg_gateway.id
+		connection_id = ibm_tg_connection.test_tg_connection.connection_id
+		prefix_filters {
+			action = "permit"
+			prefix = "%s"
+		}
+		prefix_filters {
+			action = "deny"
+			prefix = "%s"
+			le = 32
+		}
+	}
+	`, gatewayName, location, connectionName, defaultAction, firstPrefix, secondPrefix)
+}
+
+func testAccCheckIBMTransitGatewayConnectionPrefixFiltersDestroy(s *terraform.State) error {
+	client, err := transitgatewayClient(acc.TestAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_tg_connection_prefix_filters" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		listPrefixFiltersOptions := &transitgatewayapisv1.ListTransitGatewayConnectionPrefixFiltersOptions{}
+		listPrefixFiltersOptions.SetTransitGatewayID(parts[0])
+		listPrefixFiltersOptions.SetID(parts[1])
+
+		prefixFilters, _, err := client.ListTransitGatewayConnectionPrefixFilters(listPrefixFiltersOptions)
+		if err == nil && len(prefixFilters.PrefixFilters) > 0 {
+			return fmt.Errorf(" transit gateway connection prefix filters still exist: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -64,6 +64,9 @@ func TestAccIBMTransitGatewayConnection_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMTransitGatewayConnectionExists("ibm_tg_connection.test_ibm_tg_gre_connection", tgConnection),
 					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_gre_connection", "name", tgSecondConnectionName),
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_gre_connection", "zone", "us-south-1"),
+					resource.TestCheckResourceAttrSet("ibm_tg_connection.test_ibm_tg_gre_connection", "local_bgp_asn"),
+					resource.TestCheckResourceAttrSet("ibm_tg_connection.test_ibm_tg_gre_connection", "mtu"),
 				),
 			},
 			// tg unbound gre test
@@ -73,6 +76,8 @@ func TestAccIBMTransitGatewayConnection_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMTransitGatewayConnectionExists("ibm_tg_connection.test_ibm_tg_unbound_gre_connection", tgConnection),
 					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_unbound_gre_connection", "base_network_type", "classic"),
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_unbound_gre_connection", "remote_tunnel_ip", "192.168.101.2"),
+					resource.TestCheckResourceAttrSet("ibm_tg_connection.test_ibm_tg_unbound_gre_connection", "local_bgp_asn"),
 				),
 			},
 			// tg directlink test
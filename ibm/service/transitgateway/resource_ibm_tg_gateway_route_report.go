@@ -234,7 +234,7 @@ func resourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta inter
 	getTransitGatewayRouteReportOptions.SetID(ID)
 	instance, response, err := client.GetTransitGatewayRouteReport(getTransitGatewayRouteReportOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
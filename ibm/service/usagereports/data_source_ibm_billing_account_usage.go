@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+func DataSourceIBMBillingAccountUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMBillingAccountUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the account. Defaults to the account of the API key.",
+			},
+			"billingmonth": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The billing month for which the usage report is requested. Format is yyyy-mm.",
+			},
+			"pricing_country": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The target country pricing that should be used.",
+			},
+			"currency_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currency for the cost fields in the resources, plans and metrics.",
+			},
+			"billable_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total billable charges for all the resources in the account.",
+			},
+			"non_billable_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total non-billable charges for all the resources in the account.",
+			},
+			"resources": dataSourceIBMBillingUsageResourcesSchema(),
+		},
+	}
+}
+
+// dataSourceIBMBillingUsageResourcesSchema is the per resource usage shared by the usage data sources.
+func dataSourceIBMBillingUsageResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The usage of each resource.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the resource.",
+				},
+				"resource_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the resource.",
+				},
+				"billable_cost": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The billable charges of the resource.",
+				},
+				"billable_rated_cost": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The pre-discounted billable charges of the resource.",
+				},
+				"non_billable_cost": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The non-billable charges of the resource.",
+				},
+				"non_billable_rated_cost": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The pre-discounted non-billable charges of the resource.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMBillingAccountUsageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, err := dataSourceIBMBillingUsageAccountID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	billingmonth := d.Get("billingmonth").(string)
+
+	getAccountUsageOptions := &usagereportsv4.GetAccountUsageOptions{}
+	getAccountUsageOptions.SetAccountID(accountID)
+	getAccountUsageOptions.SetBillingmonth(billingmonth)
+	getAccountUsageOptions.SetNames(true)
+
+	accountUsage, response, err := usageReportsClient.GetAccountUsageWithContext(context, getAccountUsageOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("GetAccountUsageWithContext", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, billingmonth))
+
+	if err = d.Set("account_id", accountUsage.AccountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("pricing_country", accountUsage.PricingCountry); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting pricing_country: %s", err))
+	}
+	if err = d.Set("currency_code", accountUsage.CurrencyCode); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting currency_code: %s", err))
+	}
+	if err = dataSourceIBMBillingUsageSetResources(d, accountUsage.Resources); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataSourceIBMBillingUsageAccountID(d *schema.ResourceData, meta interface{}) (string, error) {
+	if accountID, ok := d.GetOk("account_id"); ok {
+		return accountID.(string), nil
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error getting userDetails %s", err)
+	}
+	return userDetails.UserAccount, nil
+}
+
+func dataSourceIBMBillingUsageSetResources(d *schema.ResourceData, resources []usagereportsv4.Resource) error {
+	billableCost := 0.0
+	nonBillableCost := 0.0
+	resourceList := make([]map[string]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		if resource.BillableCost != nil {
+			billableCost += *resource.BillableCost
+		}
+		if resource.NonBillableCost != nil {
+			nonBillableCost += *resource.NonBillableCost
+		}
+		resourceList = append(resourceList, map[string]interface{}{
+			"resource_id":             resource.ResourceID,
+			"resource_name":           resource.ResourceName,
+			"billable_cost":           resource.BillableCost,
+			"billable_rated_cost":     resource.BillableRatedCost,
+			"non_billable_cost":       resource.NonBillableCost,
+			"non_billable_rated_cost": resource.NonBillableRatedCost,
+		})
+	}
+
+	if err := d.Set("billable_cost", billableCost); err != nil {
+		return fmt.Errorf("[ERROR] Error setting billable_cost: %s", err)
+	}
+	if err := d.Set("non_billable_cost", nonBillableCost); err != nil {
+		return fmt.Errorf("[ERROR] Error setting non_billable_cost: %s", err)
+	}
+	if err := d.Set("resources", resourceList); err != nil {
+		return fmt.Errorf("[ERROR] Error setting resources: %s", err)
+	}
+	return nil
+}
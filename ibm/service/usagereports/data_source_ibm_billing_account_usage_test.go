@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMBillingAccountUsageDataSourceBasic(t *testing.T) {
+	billingmonth := time.Now().AddDate(0, -1, 0).Format("2006-01")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMBillingAccountUsageDataSourceConfigBasic(billingmonth),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.usage", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.usage", "account_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.usage", "currency_code"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.usage", "billable_cost"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.usage", "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMBillingAccountUsageDataSourceConfigBasic(billingmonth string) string {
+	return fmt.Sprintf(`
+		data "ibm_billing_account_usage" "usage" {
+			billingmonth = "%s"
+		}
+	`, billingmonth)
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+func DataSourceIBMBillingResourceGroupUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMBillingResourceGroupUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the account. Defaults to the account of the API key.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the resource group.",
+			},
+			"billingmonth": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The billing month for which the usage report is requested. Format is yyyy-mm.",
+			},
+			"resource_group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the resource group.",
+			},
+			"pricing_country": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The target country pricing that should be used.",
+			},
+			"currency_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currency for the cost fields in the resources, plans and metrics.",
+			},
+			"billable_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total billable charges for all the resources in the resource group.",
+			},
+			"non_billable_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total non-billable charges for all the resources in the resource group.",
+			},
+			"resources": dataSourceIBMBillingUsageResourcesSchema(),
+		},
+	}
+}
+
+func dataSourceIBMBillingResourceGroupUsageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, err := dataSourceIBMBillingUsageAccountID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resourceGroupID := d.Get("resource_group_id").(string)
+	billingmonth := d.Get("billingmonth").(string)
+
+	getResourceGroupUsageOptions := &usagereportsv4.GetResourceGroupUsageOptions{}
+	getResourceGroupUsageOptions.SetAccountID(accountID)
+	getResourceGroupUsageOptions.SetResourceGroupID(resourceGroupID)
+	getResourceGroupUsageOptions.SetBillingmonth(billingmonth)
+	getResourceGroupUsageOptions.SetNames(true)
+
+	resourceGroupUsage, response, err := usageReportsClient.GetResourceGroupUsageWithContext(context, getResourceGroupUsageOptions)
+	if err != nil {
+		return flex.ServiceErrorDiags("GetResourceGroupUsageWithContext", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", accountID, resourceGroupID, billingmonth))
+
+	if err = d.Set("account_id", resourceGroupUsage.AccountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("resource_group_name", resourceGroupUsage.ResourceGroupName); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group_name: %s", err))
+	}
+	if err = d.Set("pricing_country", resourceGroupUsage.PricingCountry); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting pricing_country: %s", err))
+	}
+	if err = d.Set("currency_code", resourceGroupUsage.CurrencyCode); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting currency_code: %s", err))
+	}
+	if err = dataSourceIBMBillingUsageSetResources(d, resourceGroupUsage.Resources); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMBillingResourceGroupUsageDataSourceBasic(t *testing.T) {
+	billingmonth := time.Now().AddDate(0, -1, 0).Format("2006-01")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMBillingResourceGroupUsageDataSourceConfigBasic(billingmonth),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_billing_resource_group_usage.usage", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_resource_group_usage.usage", "resource_group_name"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_resource_group_usage.usage", "currency_code"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_resource_group_usage.usage", "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMBillingResourceGroupUsageDataSourceConfigBasic(billingmonth string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "group" {
+			is_default = "true"
+		}
+
+		data "ibm_billing_resource_group_usage" "usage" {
+			resource_group_id = data.ibm_resource_group.group.id
+			billingmonth      = "%s"
+		}
+	`, billingmonth)
+}
@@ -6,7 +6,6 @@ package vpc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -167,8 +166,7 @@ func dataSourceIBMIsBackupPolicyRead(context context.Context, d *schema.Resource
 		getBackupPolicyOptions.SetID(id)
 		backupPolicyInfo, response, err := sess.GetBackupPolicyWithContext(context, getBackupPolicyOptions)
 		if err != nil {
-			log.Printf("[DEBUG] GetBackupPolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetBackupPolicyWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("GetBackupPolicyWithContext", err, response)
 		}
 		backupPolicy = backupPolicyInfo
 
@@ -184,8 +182,7 @@ func dataSourceIBMIsBackupPolicyRead(context context.Context, d *schema.Resource
 			}
 			backupPolicyCollection, response, err := sess.ListBackupPoliciesWithContext(context, listBackupPoliciesOptions)
 			if err != nil {
-				log.Printf("[DEBUG] ListBackupPoliciesWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListBackupPoliciesWithContext failed %s\n%s", err, response))
+				return flex.ServiceErrorDiags("ListBackupPoliciesWithContext", err, response)
 			}
 			if backupPolicyCollection != nil && *backupPolicyCollection.TotalCount == int64(0) {
 				break
@@ -6,7 +6,6 @@ package vpc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -245,8 +244,7 @@ func dataSourceIBMIsBackupPolicyJobRead(context context.Context, d *schema.Resou
 
 	backupPolicyJob, response, err := vpcClient.GetBackupPolicyJobWithContext(context, getBackupPolicyJobOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetBackupPolicyJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetBackupPolicyJobWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetBackupPolicyJobWithContext", err, response)
 	}
 
 	d.SetId(*backupPolicyJob.ID)
@@ -132,6 +132,37 @@ func DataSourceIBMIsBareMetalServer() *schema.Resource {
 				Computed:    true,
 				Description: "The URL for this bare metal server",
 			},
+			isBareMetalServerEnableSecureBoot: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether secure boot is enabled. If enabled, the image must support secure boot or the server will fail to boot.",
+			},
+			isBareMetalServerTPM: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The trusted platform module of the bare metal server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isBareMetalServerTPMEnabled: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the trusted platform module is enabled.",
+						},
+						isBareMetalServerTPMMode: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The trusted platform module mode.",
+						},
+						isBareMetalServerTPMSupportedModes: {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The supported trusted platform module modes.",
+						},
+					},
+				},
+			},
 			isBareMetalServerMemory: {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -499,6 +530,12 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 	if err = d.Set(isBareMetalServerMemory, bms.Memory); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting memory: %s", err))
 	}
+	if err = d.Set(isBareMetalServerEnableSecureBoot, bms.EnableSecureBoot); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enable_secure_boot: %s", err))
+	}
+	if err = d.Set(isBareMetalServerTPM, resourceIBMIsBareMetalServerTrustedPlatformModuleToMap(bms.TrustedPlatformModule)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting trusted_platform_module: %s", err))
+	}
 	if err = d.Set(isBareMetalServerName, *bms.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
 	}
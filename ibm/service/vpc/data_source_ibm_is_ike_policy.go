@@ -196,8 +196,7 @@ func dataSourceIBMIsIkePolicyRead(context context.Context, d *schema.ResourceDat
 
 		ikePolicy1, response, err := vpcClient.GetIkePolicyWithContext(context, getIkePolicyOptions)
 		if err != nil {
-			log.Printf("[DEBUG] GetIkePolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetIkePolicyWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("GetIkePolicyWithContext", err, response)
 		}
 		ikePolicy = ikePolicy1
 	}
@@ -222,8 +222,7 @@ func dataSourceIBMIsLbPoolRead(context context.Context, d *schema.ResourceData,
 
 		loadBalancerPoolInfo, response, err := sess.GetLoadBalancerPoolWithContext(context, getLoadBalancerPoolOptions)
 		if err != nil {
-			log.Printf("[DEBUG] GetLoadBalancerPoolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetLoadBalancerPoolWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("GetLoadBalancerPoolWithContext", err, response)
 		}
 		loadBalancerPool = loadBalancerPoolInfo
 
@@ -234,8 +233,7 @@ func dataSourceIBMIsLbPoolRead(context context.Context, d *schema.ResourceData,
 
 		loadBalancerPoolCollection, response, err := sess.ListLoadBalancerPoolsWithContext(context, listLoadBalancerPoolsOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ListLoadBalancerPoolsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolsWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListLoadBalancerPoolsWithContext", err, response)
 		}
 
 		name := v.(string)
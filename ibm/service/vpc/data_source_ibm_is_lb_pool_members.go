@@ -6,12 +6,12 @@ package vpc
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -139,8 +139,7 @@ func dataSourceIBMIsLbPoolMembersRead(context context.Context, d *schema.Resourc
 
 	loadBalancerPoolMemberCollection, response, err := sess.ListLoadBalancerPoolMembersWithContext(context, listLoadBalancerPoolMembersOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListLoadBalancerPoolMembersWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolMembersWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListLoadBalancerPoolMembersWithContext", err, response)
 	}
 
 	d.SetId(dataSourceIBMIsLbPoolMembersID(d))
@@ -6,12 +6,12 @@ package vpc
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -224,8 +224,7 @@ func dataSourceIBMIsLbPoolsRead(context context.Context, d *schema.ResourceData,
 
 	loadBalancerPoolCollection, response, err := sess.ListLoadBalancerPoolsWithContext(context, listLoadBalancerPoolsOptions)
 	if err != nil {
-		log.Printf("[DEBUG] ListLoadBalancerPoolsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolsWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("ListLoadBalancerPoolsWithContext", err, response)
 	}
 	if err = d.Set("lb", d.Get("lb").(string)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting lb: %s", err))
@@ -6,7 +6,6 @@ package vpc
 import (
 	"context"
 	"fmt"
-	"log"
 	"reflect"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -141,8 +140,7 @@ func dataSourceIBMIsSecurityGroupRuleRead(context context.Context, d *schema.Res
 
 	securityGroupRuleIntf, response, err := vpcClient.GetSecurityGroupRuleWithContext(context, getSecurityGroupRuleOptions)
 	if err != nil || securityGroupRuleIntf == nil {
-		log.Printf("[DEBUG] GetSecurityGroupRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecurityGroupRuleWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetSecurityGroupRuleWithContext", err, response)
 	}
 
 	switch reflect.TypeOf(securityGroupRuleIntf).String() {
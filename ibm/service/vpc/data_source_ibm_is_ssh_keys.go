@@ -140,8 +140,7 @@ func dataSourceIBMIsSshKeysRead(context context.Context, d *schema.ResourceData,
 
 		keyCollection, response, err := vpcClient.ListKeysWithContext(context, listKeysOptions)
 		if err != nil || keyCollection == nil {
-			log.Printf("[DEBUG] ListKeysWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListKeysWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListKeysWithContext", err, response)
 		}
 
 		start = flex.GetNext(keyCollection.Next)
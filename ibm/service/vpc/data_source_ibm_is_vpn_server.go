@@ -389,8 +389,7 @@ func dataSourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceDat
 		getVPNServerOptions.SetID(v.(string))
 		vpnServerInfo, response, err := sess.GetVPNServerWithContext(context, getVPNServerOptions)
 		if err != nil {
-			log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("GetVPNServerWithContext", err, response)
 		}
 		vpnServer = vpnServerInfo
 	} else if v, ok := d.GetOk("name"); ok {
@@ -406,8 +405,7 @@ func dataSourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceDat
 			}
 			vpnServerCollection, response, err := sess.ListVPNServersWithContext(context, listVPNServersOptions)
 			if err != nil {
-				log.Printf("[DEBUG] ListVPNServersWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServersWithContext failed %s\n%s", err, response))
+				return flex.ServiceErrorDiags("ListVPNServersWithContext", err, response)
 			}
 			start = flex.GetNext(vpnServerCollection.Next)
 			allrecs = append(allrecs, vpnServerCollection.VPNServers...)
@@ -6,7 +6,6 @@ package vpc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -115,8 +114,7 @@ func dataSourceIBMIsVPNServerClientRead(context context.Context, d *schema.Resou
 
 	vpnServerClient, response, err := sess.GetVPNServerClientWithContext(context, getVPNServerClientOptions)
 	if err != nil {
-		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerClientWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVPNServerClientWithContext", err, response)
 	}
 
 	d.SetId(*vpnServerClient.ID)
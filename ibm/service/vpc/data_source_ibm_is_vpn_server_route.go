@@ -92,8 +92,7 @@ func dataSourceIBMIsVPNServerRouteRead(context context.Context, d *schema.Resour
 
 		vpnServerRouteInfo, response, err := sess.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
 		if err != nil {
-			log.Printf("[DEBUG] GetVPNServerRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerRouteWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("GetVPNServerRouteWithContext", err, response)
 		}
 		vpnServerRoute = vpnServerRouteInfo
 	} else if v, ok := d.GetOk("name"); ok {
@@ -111,8 +110,7 @@ func dataSourceIBMIsVPNServerRouteRead(context context.Context, d *schema.Resour
 			}
 			vpnServerRouteCollection, response, err := sess.ListVPNServerRoutesWithContext(context, listVPNServerRoutesOptions)
 			if err != nil {
-				log.Printf("[DEBUG] ListVPNServerRoutesWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServerRoutesWithContext failed %s\n%s", err, response))
+				return flex.ServiceErrorDiags("ListVPNServerRoutesWithContext", err, response)
 			}
 			start = flex.GetNext(vpnServerRouteCollection.Next)
 			allrecs = append(allrecs, vpnServerRouteCollection.Routes...)
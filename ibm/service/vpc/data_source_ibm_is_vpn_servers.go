@@ -407,8 +407,7 @@ func dataSourceIBMIsVPNServersRead(context context.Context, d *schema.ResourceDa
 		}
 		vpnServerCollection, response, err := sess.ListVPNServersWithContext(context, listVPNServersOptions)
 		if err != nil {
-			log.Printf("[DEBUG] ListVPNServersWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServersWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("ListVPNServersWithContext", err, response)
 		}
 		start = flex.GetNext(vpnServerCollection.Next)
 		allrecs = append(allrecs, vpnServerCollection.VPNServers...)
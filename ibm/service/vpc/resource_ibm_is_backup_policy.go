@@ -6,7 +6,6 @@ package vpc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -158,8 +157,7 @@ func resourceIBMIsBackupPolicyCreate(context context.Context, d *schema.Resource
 
 	backupPolicy, response, err := vpcClient.CreateBackupPolicyWithContext(context, createBackupPolicyOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateBackupPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateBackupPolicyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateBackupPolicyWithContext", err, response)
 	}
 
 	d.SetId(*backupPolicy.ID)
@@ -179,12 +177,11 @@ func resourceIBMIsBackupPolicyRead(context context.Context, d *schema.ResourceDa
 
 	backupPolicy, response, err := vpcClient.GetBackupPolicyWithContext(context, getBackupPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetBackupPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetBackupPolicyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetBackupPolicyWithContext", err, response)
 	}
 
 	if backupPolicy.MatchResourceTypes != nil {
@@ -273,8 +270,7 @@ func resourceIBMIsBackupPolicyUpdate(context context.Context, d *schema.Resource
 		updateBackupPolicyOptions.BackupPolicyPatch, _ = patchVals.AsPatch()
 		_, response, err := vpcClient.UpdateBackupPolicyWithContext(context, updateBackupPolicyOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateBackupPolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateBackupPolicyWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateBackupPolicyWithContext", err, response)
 		}
 	}
 
@@ -291,8 +287,7 @@ func resourceIBMIsBackupPolicyDelete(context context.Context, d *schema.Resource
 	deleteBackupPolicyOptions.SetIfMatch(d.Get("version").(string))
 	_, response, err := vpcClient.DeleteBackupPolicyWithContext(context, deleteBackupPolicyOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteBackupPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteBackupPolicyWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteBackupPolicyWithContext", err, response)
 	}
 	d.SetId("")
 	return nil
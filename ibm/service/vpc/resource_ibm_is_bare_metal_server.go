@@ -78,6 +78,11 @@ const (
 	isBareMetalServerAccessTags              = "access_tags"
 	isBareMetalServerUserTagType             = "user"
 	isBareMetalServerAccessTagType           = "access"
+	isBareMetalServerEnableSecureBoot        = "enable_secure_boot"
+	isBareMetalServerTPM                     = "trusted_platform_module"
+	isBareMetalServerTPMEnabled              = "enabled"
+	isBareMetalServerTPMMode                 = "mode"
+	isBareMetalServerTPMSupportedModes       = "supported_modes"
 )
 
 func ResourceIBMIsBareMetalServer() *schema.Resource {
@@ -128,6 +133,42 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", isBareMetalServerAction),
 				Description:  "This restart/start/stops a bare metal server.",
 			},
+			isBareMetalServerEnableSecureBoot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether secure boot is enabled. If enabled, the image must support secure boot or the server will fail to boot. The server is stopped and started again to update it.",
+			},
+			isBareMetalServerTPM: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The trusted platform module of the bare metal server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isBareMetalServerTPMEnabled: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the trusted platform module is enabled.",
+						},
+						isBareMetalServerTPMMode: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", isBareMetalServerTPMMode),
+							Description:  "The trusted platform module mode to use, it must be one of the supported_trusted_platform_module_modes of the profile. The server is stopped and started again to update it.",
+						},
+						isBareMetalServerTPMSupportedModes: {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The supported trusted platform module modes.",
+						},
+					},
+				},
+			},
 			isBareMetalServerBandwidth: {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -612,6 +653,14 @@ func ResourceIBMIsBareMetalServerValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              bareMetalServerActions})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBareMetalServerTPMMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disabled, tpm_2"})
+
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 "accesstag",
@@ -1050,6 +1099,18 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 		}
 	}
 
+	if enableSecureBoot, ok := d.GetOkExists(isBareMetalServerEnableSecureBoot); ok {
+		enableSecureBootBool := enableSecureBoot.(bool)
+		options.EnableSecureBoot = &enableSecureBootBool
+	}
+
+	if mode, ok := d.GetOk("trusted_platform_module.0.mode"); ok {
+		modeStr := mode.(string)
+		options.TrustedPlatformModule = &vpcv1.BareMetalServerTrustedPlatformModulePrototype{
+			Mode: &modeStr,
+		}
+	}
+
 	bms, response, err := sess.CreateBareMetalServerWithContext(context, options)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[DEBUG] Create bare metal server err %s\n%s", err, response))
@@ -1061,7 +1122,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
+	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isBareMetalServerTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerUserTagType)
 		if err != nil {
@@ -1069,7 +1130,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 				"[ERROR] Error on create of resource bare metal server (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isBareMetalServerAccessTags); ok {
+	if _, ok := d.GetOk(isBareMetalServerAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isBareMetalServerAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerAccessTagType)
 		if err != nil {
@@ -1101,7 +1162,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 	}
 	bms, response, err := sess.GetBareMetalServerWithContext(context, options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -1142,6 +1203,10 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 	d.Set(isBareMetalServerHref, *bms.Href)
 	d.Set(isBareMetalServerMemory, *bms.Memory)
 	d.Set(isBareMetalServerName, *bms.Name)
+	d.Set(isBareMetalServerEnableSecureBoot, bms.EnableSecureBoot)
+	if err = d.Set(isBareMetalServerTPM, resourceIBMIsBareMetalServerTrustedPlatformModuleToMap(bms.TrustedPlatformModule)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting trusted_platform_module: %s", err)
+	}
 
 	// get initialization
 	getBmsInitialization := &vpcv1.GetBareMetalServerInitializationOptions{
@@ -1149,7 +1214,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 	}
 	bmsinitialization, response, err := sess.GetBareMetalServerInitializationWithContext(context, getBmsInitialization)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -1438,7 +1503,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			}
 			bms, response, err := sess.GetBareMetalServerWithContext(context, options)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if flex.IsResourceNotFound(response) {
 					d.SetId("")
 					return nil
 				}
@@ -2037,6 +2102,31 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 		bmsPatchModel.Name = &nameStr
 	}
+	// The secure boot and the trusted platform module can only be updated when the server is stopped
+	stopped := false
+	if d.HasChange(isBareMetalServerEnableSecureBoot) {
+		flag = true
+		stopped = true
+		enableSecureBoot := d.Get(isBareMetalServerEnableSecureBoot).(bool)
+		bmsPatchModel.EnableSecureBoot = &enableSecureBoot
+	}
+	if d.HasChange("trusted_platform_module.0.mode") {
+		if mode, ok := d.GetOk("trusted_platform_module.0.mode"); ok {
+			flag = true
+			stopped = true
+			modeStr := mode.(string)
+			bmsPatchModel.TrustedPlatformModule = &vpcv1.BareMetalServerTrustedPlatformModulePatch{
+				Mode: &modeStr,
+			}
+		}
+	}
+	wasRunning := d.Get(isBareMetalServerStatus).(string) == isBareMetalServerStatusRunning
+	if stopped {
+		err = resourceStopServerIfRunning(id, "hard", d, context, sess)
+		if err != nil {
+			return err
+		}
+	}
 	if flag {
 		bmsPatch, err := bmsPatchModel.AsPatch()
 		if err != nil {
@@ -2048,6 +2138,12 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			return fmt.Errorf("[ERROR] Error updating Bare Metal Server: %s\n%s", err, response)
 		}
 	}
+	if stopped && wasRunning {
+		err = resourceStartServerIfStopped(id, "hard", d, context, sess)
+		if err != nil {
+			return err
+		}
+	}
 
 	if d.HasChange(isBareMetalServerAction) {
 		action := ""
@@ -2350,6 +2446,19 @@ func resourceIBMBMSNicSet(v interface{}) int {
 	return conns.String(buf.String())
 }
 
+func resourceIBMIsBareMetalServerTrustedPlatformModuleToMap(tpm *vpcv1.BareMetalServerTrustedPlatformModule) []map[string]interface{} {
+	if tpm == nil {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{
+		{
+			isBareMetalServerTPMEnabled:        tpm.Enabled,
+			isBareMetalServerTPMMode:           tpm.Mode,
+			isBareMetalServerTPMSupportedModes: tpm.SupportedModes,
+		},
+	}
+}
+
 func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData, context context.Context, sess *vpcv1.VpcV1) error {
 	getBmsOptions := &vpcv1.GetBareMetalServerOptions{
 		ID: &id,
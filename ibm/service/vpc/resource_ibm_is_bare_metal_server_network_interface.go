@@ -580,7 +580,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceRead(context context.Context, d
 
 	nicIntf, response, err := sess.GetBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil || nicIntf == nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -363,7 +363,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatRead(context context.
 		//if original nic is not present, try fetching nic without server id
 		nicIntf, response, err = findNicsWithoutBMS(context, d, sess, nicID)
 		// response here can be either nil or not nil and if it returns 404 means nic is deleted
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -447,7 +447,7 @@ func resourceIbmIsDedicatedHostCreate(context context.Context, d *schema.Resourc
 	}
 
 	d.SetId(*dedicatedHost.ID)
-	if _, ok := d.GetOk(isDedicatedHostAccessTags); ok {
+	if _, ok := d.GetOk(isDedicatedHostAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isDedicatedHostAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *dedicatedHost.CRN, "", isDedicatedHostAccessTagType)
 		if err != nil {
@@ -475,7 +475,7 @@ func resourceIbmIsDedicatedHostRead(context context.Context, d *schema.ResourceD
 
 	dedicatedHost, response, err := vpcClient.GetDedicatedHostWithContext(context, getDedicatedHostOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -682,7 +682,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 
 	dedicatedHost, response, err := vpcClient.GetDedicatedHostWithContext(context, getDedicatedHostOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -376,7 +376,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
+	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isUserTagType)
 		if err != nil {
@@ -385,7 +385,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		}
 	}
 
-	if _, ok := d.GetOk(isFloatingIPAccessTags); ok {
+	if _, ok := d.GetOk(isFloatingIPAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isFloatingIPAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isAccessTagType)
 		if err != nil {
@@ -416,7 +416,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	floatingip, response, err := sess.GetFloatingIP(getFloatingIPOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
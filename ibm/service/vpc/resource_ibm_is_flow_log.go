@@ -263,7 +263,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
+	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
@@ -271,7 +271,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 				"Error on create of resource vpc flow log (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isFlowLogAccessTags); ok {
+	if _, ok := d.GetOk(isFlowLogAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isFlowLogAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
 		if err != nil {
@@ -262,7 +262,7 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	ike, response, err := sess.GetIkePolicy(getikepoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -368,7 +368,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetIkePolicy(getikepoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
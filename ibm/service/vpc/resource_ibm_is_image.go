@@ -322,7 +322,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
@@ -330,7 +330,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isImageAccessTags); ok {
+	if _, ok := d.GetOk(isImageAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isImageAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
@@ -419,7 +419,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
@@ -427,7 +427,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isImageAccessTags); ok {
+	if _, ok := d.GetOk(isImageAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isImageAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
@@ -563,7 +563,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	image, response, err := sess.GetImage(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -146,7 +146,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				}
 				instance, response, err := instanceC.GetInstance(getinsOptions)
 				if err != nil {
-					if response != nil && response.StatusCode == 404 {
+					if flex.IsResourceNotFound(response) {
 						d.SetId("")
 						return nil, nil
 					}
@@ -1438,7 +1438,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
@@ -1446,7 +1446,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
+	if _, ok := d.GetOk(isInstanceAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
@@ -1811,7 +1811,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2181,7 +2181,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2189,7 +2189,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
+	if _, ok := d.GetOk(isInstanceAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
@@ -2555,7 +2555,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
@@ -2563,7 +2563,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
+	if _, ok := d.GetOk(isInstanceAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
@@ -2751,7 +2751,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	instance, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -3752,7 +3752,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if flex.IsResourceNotFound(response) {
 				d.SetId("")
 				return nil
 			}
@@ -3867,7 +3867,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -34,6 +35,7 @@ func ResourceIBMISInstanceAction() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -151,12 +153,12 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response))
 	}
 	if actiontype == "stop" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
+		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), instanceId, d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	} else if actiontype == "start" || actiontype == "reboot" {
-		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
+		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutCreate), instanceId, d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -178,7 +180,7 @@ func resourceIBMISInstanceActionRead(context context.Context, d *schema.Resource
 	}
 	instance, response, err := sess.GetInstance(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -231,6 +233,10 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 		InstanceID: &id,
 		Type:       &actiontype,
 	}
+	if instanceActionForceIntf, ok := d.GetOk(isInstanceActionForce); ok {
+		force := instanceActionForceIntf.(bool)
+		createinsactoptions.Force = &force
+	}
 	_, response, err = sess.CreateInstanceAction(createinsactoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -283,7 +283,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupUserTagType)
 		if err != nil {
@@ -292,7 +292,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if _, ok := d.GetOk(isInstanceGroupAccessTags); ok {
+	if _, ok := d.GetOk(isInstanceGroupAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isInstanceGroupAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupAccessTagType)
 		if err != nil {
@@ -404,7 +404,7 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 	instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 	if err != nil || instanceGroup == nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -528,7 +528,7 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	deleteInstanceGroupOptions := vpcv1.DeleteInstanceGroupOptions{ID: &instanceGroupID}
 	response, Err := sess.DeleteInstanceGroup(&deleteInstanceGroupOptions)
 	if Err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
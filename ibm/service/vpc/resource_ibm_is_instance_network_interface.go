@@ -343,8 +343,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 
 	networkInterface, response, err := vpcClient.CreateInstanceNetworkInterfaceWithContext(context, createInstanceNetworkInterfaceOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateInstanceNetworkInterfaceWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createInstanceNetworkInterfaceOptions.InstanceID, *networkInterface.ID))
@@ -402,12 +401,11 @@ func resourceIBMIsInstanceNetworkInterfaceRead(context context.Context, d *schem
 
 	networkInterface, response, err := vpcClient.GetInstanceNetworkInterfaceWithContext(context, getInstanceNetworkInterfaceOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetInstanceNetworkInterfaceWithContext", err, response)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", parts[0], *networkInterface.ID))
 	d.Set("network_interface", *networkInterface.ID)
@@ -627,8 +625,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 		updateInstanceNetworkInterfaceOptions.NetworkInterfacePatch, _ = patchVals.AsPatch()
 		_, response, err := vpcClient.UpdateInstanceNetworkInterfaceWithContext(context, updateInstanceNetworkInterfaceOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateInstanceNetworkInterfaceWithContext", err, response)
 		}
 	}
 
@@ -653,7 +650,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 			}
 			floatingip, response, err := vpcClient.GetFloatingIP(getFloatingIPOptions)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if flex.IsResourceNotFound(response) {
 					d.SetId("")
 					return nil
 				}
@@ -719,8 +716,7 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 
 	response, err := vpcClient.DeleteInstanceNetworkInterfaceWithContext(context, deleteInstanceNetworkInterfaceOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteInstanceNetworkInterfaceWithContext", err, response)
 	}
 
 	_, err = isWaitForNetworkInterfaceDelete(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -156,7 +157,7 @@ func resourceIBMISInstanceNetworkInterfaceFloatingIpRead(context context.Context
 
 	fip, response, err := sess.GetInstanceNetworkInterfaceFloatingIPWithContext(context, options)
 	if err != nil || fip == nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -257,7 +257,7 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	ipSec, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -364,7 +364,7 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
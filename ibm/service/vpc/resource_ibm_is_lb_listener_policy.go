@@ -838,7 +838,7 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 	//Getting lb listener policy
 	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -929,7 +929,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	//Getting lb listener policy
 	policy, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -961,7 +961,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 			//Getting lb listener policy rule
 			rule, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if flex.IsResourceNotFound(response) {
 					d.SetId("")
 					return nil
 				}
@@ -472,7 +472,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
+	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isUserTagType)
 		if err != nil {
@@ -480,7 +480,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 				"Error on create of resource network acl (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isNetworkACLAccessTags); ok {
+	if _, ok := d.GetOk(isNetworkACLAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isNetworkACLAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isAccessTagType)
 		if err != nil {
@@ -510,7 +510,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	nwacl, response, err := sess.GetNetworkACL(getNetworkAclOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -727,7 +727,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -203,7 +203,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if _, ok := d.GetOk(isPlacementGroupAccessTags); ok {
+	if _, ok := d.GetOk(isPlacementGroupAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isAccessTagType)
 		if err != nil {
@@ -227,7 +227,7 @@ func resourceIbmIsPlacementGroupRead(context context.Context, d *schema.Resource
 
 	placementGroup, response, err := vpcClient.GetPlacementGroupWithContext(context, getPlacementGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
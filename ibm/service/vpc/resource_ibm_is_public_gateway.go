@@ -253,7 +253,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
 		if err != nil {
@@ -262,7 +262,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if _, ok := d.GetOk(isPublicGatewayAccessTags); ok {
+	if _, ok := d.GetOk(isPublicGatewayAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isPublicGatewayAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
 		if err != nil {
@@ -318,7 +318,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 	}
 	publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -445,7 +445,7 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 	}
 	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
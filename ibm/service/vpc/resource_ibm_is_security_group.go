@@ -206,7 +206,7 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
+	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isUserTagType)
 		if err != nil {
@@ -214,7 +214,7 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 				"Error while creating Security Group tags : %s\n%s", *sg.ID, err)
 		}
 	}
-	if _, ok := d.GetOk(isSecurityGroupAccessTags); ok {
+	if _, ok := d.GetOk(isSecurityGroupAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isSecurityGroupAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isAccessTagType)
 		if err != nil {
@@ -237,7 +237,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 	}
 	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -426,7 +426,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 	}
 	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
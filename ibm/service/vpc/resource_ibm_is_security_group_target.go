@@ -153,7 +153,7 @@ func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface
 
 	data, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
 	if err != nil || data == nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -189,7 +189,7 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 	}
 	sgt, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -235,7 +235,7 @@ func resourceIBMISSecurityGroupTargetExists(d *schema.ResourceData, meta interfa
 
 	_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return false, nil
 		}
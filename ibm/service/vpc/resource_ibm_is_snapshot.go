@@ -341,7 +341,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if _, ok := d.GetOk(isSnapshotAccessTags); ok {
+	if _, ok := d.GetOk(isSnapshotAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshot.CRN, "", isAccessTagType)
 		if err != nil {
@@ -406,7 +406,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -505,7 +505,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 	}
 	_, response, err := sess.GetSnapshot(getSnapshotOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -753,7 +753,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetSnapshot(getSnapshotOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
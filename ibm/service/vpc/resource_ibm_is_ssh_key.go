@@ -222,7 +222,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	log.Printf("[INFO] Key : %s", *key.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
+	if _, ok := d.GetOk(isKeyTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
 		if err != nil {
@@ -231,7 +231,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 		}
 	}
 
-	if _, ok := d.GetOk(isKeyAccessTags); ok {
+	if _, ok := d.GetOk(isKeyAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isKeyAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
 		if err != nil {
@@ -263,7 +263,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	key, response, err := sess.GetKey(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
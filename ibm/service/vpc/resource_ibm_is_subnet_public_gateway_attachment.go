@@ -158,7 +158,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentRead(context context.Context, d *
 	pg, response, err := sess.GetSubnetPublicGatewayWithContext(context, getSubnetPublicGatewayOptionsModel)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -234,7 +234,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentDelete(context context.Context, d
 	}
 	_, response, err := sess.GetSubnetWithContext(context, getSubnetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
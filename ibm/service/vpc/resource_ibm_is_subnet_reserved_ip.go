@@ -344,7 +344,7 @@ func get(d *schema.ResourceData, meta interface{}) (*vpcv1.ReservedIP, error) {
 	options := sess.NewGetSubnetReservedIPOptions(subnetID, reservedIPID)
 	rip, response, err := sess.GetSubnetReservedIP(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil, nil
 		}
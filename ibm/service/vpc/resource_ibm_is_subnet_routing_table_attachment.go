@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -166,7 +167,7 @@ func resourceIBMISSubnetRoutingTableAttachmentRead(context context.Context, d *s
 	subRT, response, err := sess.GetSubnetRoutingTableWithContext(context, getSubnetRoutingTableOptionsModel)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -248,7 +249,7 @@ func resourceIBMISSubnetRoutingTableAttachmentDelete(context context.Context, d
 	}
 	subnet, response, err := sess.GetSubnetWithContext(context, getSubnetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -262,7 +263,7 @@ func resourceIBMISSubnetRoutingTableAttachmentDelete(context context.Context, d
 	}
 	vpc, response, err := sess.GetVPCWithContext(context, getvpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
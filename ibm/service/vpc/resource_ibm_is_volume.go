@@ -463,7 +463,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		return err
 	}
 
-	if _, ok := d.GetOk(isVolumeAccessTags); ok {
+	if _, ok := d.GetOk(isVolumeAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isVolumeAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
 		if err != nil {
@@ -494,7 +494,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	vol, response, err := sess.GetVolume(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -633,7 +633,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 	}
 	_, response, err := sess.GetVolume(optionsget)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -733,7 +733,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		vol, response, err := sess.GetVolume(getvolumeoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if flex.IsResourceNotFound(response) {
 				d.SetId("")
 				return nil
 			}
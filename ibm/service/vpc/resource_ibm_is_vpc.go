@@ -527,7 +527,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
+	if _, ok := d.GetOk(isVPCTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
 		if err != nil {
@@ -535,7 +535,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 				"Error on create of resource vpc (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isVPCAccessTags); ok {
+	if _, ok := d.GetOk(isVPCAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isVPCAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
 		if err != nil {
@@ -598,7 +598,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	vpc, response, err := sess.GetVPC(getvpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -932,7 +932,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetVPC(getVpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
@@ -363,7 +363,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isVPNGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
 		if err != nil {
@@ -372,7 +372,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 		}
 	}
 
-	if _, ok := d.GetOk(isVPNGatewayAccessTags); ok {
+	if _, ok := d.GetOk(isVPNGatewayAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isVPNGatewayAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isAccessTagType)
 		if err != nil {
@@ -438,7 +438,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
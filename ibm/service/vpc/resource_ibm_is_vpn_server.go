@@ -507,8 +507,7 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 
 	vpnServer, response, err := sess.CreateVPNServerWithContext(context, createVPNServerOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateVPNServerWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateVPNServerWithContext", err, response)
 	}
 
 	d.SetId(*vpnServer.ID)
@@ -518,7 +517,7 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("[ERROR] VPNServer failed %s\n", err))
 	}
 
-	if _, ok := d.GetOk(isVPNServerAccessTags); ok {
+	if _, ok := d.GetOk(isVPNServerAccessTags); ok || flex.HasDefaultTags(meta, "access") {
 		oldList, newList := d.GetChange(isVPNServerAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
 		if err != nil {
@@ -572,12 +571,11 @@ func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData,
 
 	vpnServer, response, err := sess.GetVPNServerWithContext(context, getVPNServerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVPNServerWithContext", err, response)
 	}
 
 	if err = d.Set("vpn_server", d.Id()); err != nil {
@@ -877,12 +875,11 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 	getVPNServerOptions.SetID(d.Id())
 	vpnServer, response, err := sess.GetVPNServerWithContext(context, getVPNServerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVPNServerWithContext", err, response)
 	}
 	eTag := response.Headers.Get("ETag") // Getting Etag from the response headers.
 
@@ -913,8 +910,7 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 		updateVPNServerOptions.VPNServerPatch, _ = patchVals.AsPatch()
 		_, response, err := sess.UpdateVPNServerWithContext(context, updateVPNServerOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateVPNServerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateVPNServerWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateVPNServerWithContext", err, response)
 		}
 		_, err = isWaitForVPNServerStable(context, sess, d, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -938,8 +934,7 @@ func resourceIBMIsVPNServerDelete(context context.Context, d *schema.ResourceDat
 
 	response, err := sess.DeleteVPNServerWithContext(context, deleteVPNServerOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteVPNServerWithContext", err, response)
 	}
 
 	_, err = isWaitForVPNServerDeleted(context, sess, d)
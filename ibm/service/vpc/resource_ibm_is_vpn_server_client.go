@@ -6,7 +6,6 @@ package vpc
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -69,12 +68,11 @@ func resourceIBMIsVPNServerClientDisconnect(context context.Context, d *schema.R
 
 	_, response, err := sess.GetVPNServerClientWithContext(context, getVPNServerClientOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerClientWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVPNServerClientWithContext", err, response)
 	}
 
 	var flag bool
@@ -90,8 +88,7 @@ func resourceIBMIsVPNServerClientDisconnect(context context.Context, d *schema.R
 
 		response, err := sess.DisconnectVPNClientWithContext(context, disconnectVPNServerRouteOptions)
 		if err != nil {
-			log.Printf("[DEBUG] DisconnectVPNClientWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] DisconnectVPNClientWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("DisconnectVPNClientWithContext", err, response)
 		}
 
 		if err = d.Set("status_code", response.StatusCode); err != nil {
@@ -112,8 +109,7 @@ func resourceIBMIsVPNServerClientDisconnect(context context.Context, d *schema.R
 
 		response, err := sess.DeleteVPNServerClientWithContext(context, deleteVPNServerClientOptions)
 		if err != nil {
-			log.Printf("[DEBUG] DeleteVPNServerClientWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerClientWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("DeleteVPNServerClientWithContext", err, response)
 		}
 
 		if err = d.Set("status_code", response.StatusCode); err != nil {
@@ -156,12 +152,11 @@ func resourceIBMIsVPNServerClientDelete(context context.Context, d *schema.Resou
 
 	_, response, err := sess.GetVPNServerClientWithContext(context, getVPNServerClientOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerClientWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVPNServerClientWithContext", err, response)
 	}
 
 	deleteVPNServerClientOptions := &vpcv1.DeleteVPNServerClientOptions{}
@@ -170,8 +165,7 @@ func resourceIBMIsVPNServerClientDelete(context context.Context, d *schema.Resou
 
 	response, err = sess.DeleteVPNServerClientWithContext(context, deleteVPNServerClientOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteVPNServerClientWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerClientWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteVPNServerClientWithContext", err, response)
 	}
 
 	d.SetId("")
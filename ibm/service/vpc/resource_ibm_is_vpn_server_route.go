@@ -152,8 +152,7 @@ func resourceIBMIsVPNServerRouteCreate(context context.Context, d *schema.Resour
 
 	vpnServerRoute, response, err := sess.CreateVPNServerRouteWithContext(context, createVPNServerRouteOptions)
 	if err != nil {
-		log.Printf("[DEBUG] CreateVPNServerRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateVPNServerRouteWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("CreateVPNServerRouteWithContext", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createVPNServerRouteOptions.VPNServerID, *vpnServerRoute.ID))
@@ -219,12 +218,11 @@ func resourceIBMIsVPNServerRouteRead(context context.Context, d *schema.Resource
 
 	vpnServerRoute, response, err := sess.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if flex.IsResourceNotFound(response) {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetVPNServerRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerRouteWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("GetVPNServerRouteWithContext", err, response)
 	}
 
 	if err = d.Set("vpn_server", getVPNServerRouteOptions.VPNServerID); err != nil {
@@ -288,8 +286,7 @@ func resourceIBMIsVPNServerRouteUpdate(context context.Context, d *schema.Resour
 		updateVPNServerRouteOptions.VPNServerRoutePatch, _ = patchVals.AsPatch()
 		_, response, err := sess.UpdateVPNServerRouteWithContext(context, updateVPNServerRouteOptions)
 		if err != nil {
-			log.Printf("[DEBUG] UpdateVPNServerRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateVPNServerRouteWithContext failed %s\n%s", err, response))
+			return flex.ServiceErrorDiags("UpdateVPNServerRouteWithContext", err, response)
 		}
 		_, err = isWaitForVPNServerRouteStable(context, sess, d, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -318,8 +315,7 @@ func resourceIBMIsVPNServerRouteDelete(context context.Context, d *schema.Resour
 
 	response, err := sess.DeleteVPNServerRouteWithContext(context, deleteVPNServerRouteOptions)
 	if err != nil {
-		log.Printf("[DEBUG] DeleteVPNServerRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerRouteWithContext failed %s\n%s", err, response))
+		return flex.ServiceErrorDiags("DeleteVPNServerRouteWithContext", err, response)
 	}
 	_, err = isWaitForVPNServerRouteDeleted(context, sess, d)
 	if err != nil {